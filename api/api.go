@@ -17,6 +17,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/common"
 	"github.com/b582q9/go-textile-sapien/core"
 	ipfsutil "github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	m "github.com/b582q9/go-textile-sapien/mill"
 	"github.com/b582q9/go-textile-sapien/pb"
 	limit "github.com/gin-contrib/size"
@@ -96,6 +97,7 @@ func (a *Api) Run() {
 	conf := a.Node.Config()
 
 	// middleware setup
+	router.Use(metrics.Gin("api"))
 
 	// Add the CORS middleware
 	// Merges the API HTTPHeaders (from config/init) into blank/default CORS configuration
@@ -144,11 +146,13 @@ func (a *Api) Run() {
 			account.GET("", a.accountGet)
 			account.GET("/seed", a.accountSeed)
 			account.GET("/address", a.accountAddress)
+			account.GET("/card", a.accountCard)
 		}
 
 		profile := v0.Group("/profile")
 		{
 			profile.GET("", a.getProfile)
+			profile.GET("/:address", a.resolveProfile)
 			profile.POST("/name", a.setName)
 			profile.POST("/avatar", a.setAvatar)
 		}
@@ -159,6 +163,9 @@ func (a *Api) Run() {
 			contacts.GET("", a.lsContacts)
 			contacts.GET("/:address", a.getContacts)
 			contacts.DELETE("/:address", a.rmContacts)
+			contacts.GET("/:address/safety", a.contactSafetyNumber)
+			contacts.PUT("/:address/verify", a.verifyContact)
+			contacts.DELETE("/:address/verify", a.unverifyContact)
 			contacts.POST("/search", a.searchContacts)
 		}
 
@@ -167,8 +174,10 @@ func (a *Api) Run() {
 			mills.POST("/schema", a.schemaMill)
 			mills.POST("/blob", a.blobMill)
 			mills.POST("/image/resize", a.imageResizeMill)
+			mills.POST("/image/crop", a.imageCropMill)
 			mills.POST("/image/exif", a.imageExifMill)
 			mills.POST("/json", a.jsonMill)
+			mills.POST("/pipeline", a.pipelineMill)
 		}
 
 		threads := v0.Group("/threads")
@@ -176,12 +185,19 @@ func (a *Api) Run() {
 			threads.POST("", a.addThreads)
 			threads.PUT(":id", a.addOrUpdateThreads)
 			threads.PUT(":id/name", a.renameThreads)
+			threads.PUT(":id/schema", a.updateThreadSchema)
+			threads.POST("/:id/remill", a.remillThread)
+			threads.PUT(":id/web", a.publishThreadWeb)
+			threads.DELETE("/:id/web", a.unpublishThreadWeb)
 			threads.GET("", a.lsThreads)
 			threads.GET("/:id", a.getThreads)
 			threads.GET("/:id/peers", a.peersThreads)
 			threads.DELETE("/:id", a.rmThreads)
 			threads.POST("/:id/messages", a.addThreadMessages)
 			threads.POST("/:id/files", a.addThreadFiles)
+			threads.POST("/:id/reads", a.addThreadReads)
+			threads.GET("/:id/reads", a.lsThreadReads)
+			threads.POST("/:id/typing", a.addThreadTyping)
 		}
 
 		snapshots := v0.Group("/snapshots")
@@ -226,6 +242,14 @@ func (a *Api) Run() {
 					likes.POST("", a.addBlockLikes)
 					likes.GET("", a.lsBlockLikes)
 				}
+
+				block.GET("/reaction", a.getBlockReaction)
+				reactions := block.Group("/reactions")
+				{
+					reactions.POST("", a.addBlockReactions)
+					reactions.GET("", a.lsBlockReactions)
+					reactions.DELETE("", a.rmBlockReactions)
+				}
 			}
 		}
 
@@ -292,12 +316,35 @@ func (a *Api) Run() {
 			invites.POST("/:id/ignore", a.ignoreInvites)
 		}
 
+		blocklist := v0.Group("/blocklist")
+		{
+			blocklist.GET("", a.lsBlocklist)
+			blocklist.PUT("/:address", a.addBlocklist)
+			blocklist.DELETE("/:address", a.rmBlocklist)
+			blocklist.POST("/publish", a.publishBlocklist)
+		}
+
 		notifs := v0.Group("/notifications")
 		{
 			notifs.GET("", a.lsNotifications)
 			notifs.POST("/:id/read", a.readNotifications)
 		}
 
+		audit := v0.Group("/audit")
+		{
+			audit.GET("", a.lsAudit)
+			audit.GET("/export", a.exportAudit)
+			audit.GET("/verify", a.verifyAudit)
+		}
+
+		queues := v0.Group("/queues")
+		{
+			queues.GET("", a.lsQueues)
+			queues.GET("/:id", a.getQueues)
+			queues.POST("/:id/retry", a.retryQueues)
+			queues.DELETE("/:id", a.rmQueues)
+		}
+
 		cafes := v0.Group("/cafes")
 		{
 			cafes.POST("", a.addCafes)
@@ -312,6 +359,7 @@ func (a *Api) Run() {
 			tokens.POST("", a.createTokens)
 			tokens.GET("", a.lsTokens)
 			tokens.GET("/:token", a.validateTokens)
+			tokens.GET("/:token/usage", a.usageTokens)
 			tokens.DELETE("/:token", a.rmTokens)
 		}
 
@@ -435,7 +483,7 @@ func (a *Api) readOpts(g *gin.Context) (map[string]string, error) {
 	for _, o := range strings.Split(header, ",") {
 		opt := strings.TrimSpace(o)
 		if opt != "" {
-			parts := strings.Split(opt, "=")
+			parts := strings.SplitN(opt, "=", 2)
 			if len(parts) == 2 {
 				v, err := url.PathUnescape(parts[1])
 				if err != nil {
@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 
+	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/gin-gonic/gin"
 )
 
@@ -26,6 +27,7 @@ func (a *Api) accountGet(g *gin.Context) {
 // @Success 200 {string} string "seed"
 // @Router /account/seed [get]
 func (a *Api) accountSeed(g *gin.Context) {
+	a.Node.Audit(core.AuditSeedRead, a.Node.Account().Address(), nil)
 	g.String(http.StatusOK, a.Node.Account().Seed())
 }
 
@@ -39,3 +41,21 @@ func (a *Api) accountSeed(g *gin.Context) {
 func (a *Api) accountAddress(g *gin.Context) {
 	g.String(http.StatusOK, a.Node.Account().Address())
 }
+
+// accountCard godoc
+// @Summary Show signed account contact card
+// @Description Shows the local peer's account info as a contact card signed by the account key
+// @Tags account
+// @Produce application/json
+// @Success 200 {object} pb.Contact "contact"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /account/card [get]
+func (a *Api) accountCard(g *gin.Context) {
+	card, err := a.Node.ContactCard()
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, card)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// lsAudit godoc
+// @Summary List audit log entries
+// @Description Lists security-relevant actions recorded on this node, newest first
+// @Tags audit
+// @Produce application/json
+// @Param X-Textile-Opts header string false "actor: Peer ID or account address, action: Action or action prefix, e.g., cafe, target: Action target, result: ok or error, since: RFC3339 date, until: RFC3339 date, limit: Max entries to return" default(actor=,action=,target=,result=,since=,until=,limit=100)
+// @Success 200 {object} pb.AuditEntryList "entries"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /audit [get]
+func (a *Api) lsAudit(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	query := audit.Query{
+		Actor:  opts["actor"],
+		Action: opts["action"],
+		Target: opts["target"],
+		Limit:  100,
+	}
+	if opts["result"] != "" {
+		res, err := audit.ParseResult(opts["result"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		query.Result = &res
+	}
+	if opts["since"] != "" {
+		query.Since, err = time.Parse(time.RFC3339, opts["since"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if opts["until"] != "" {
+		query.Until, err = time.Parse(time.RFC3339, opts["until"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if opts["limit"] != "" {
+		query.Limit, err = strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	list, err := a.Node.AuditEntries(query)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, list)
+}
+
+// exportAudit godoc
+// @Summary Export the audit log
+// @Description Streams the raw audit log as hash-chained JSON lines
+// @Tags audit
+// @Produce application/x-ndjson
+// @Success 200 {string} string "entries"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /audit/export [get]
+func (a *Api) exportAudit(g *gin.Context) {
+	g.Header("Content-Type", "application/x-ndjson")
+	g.Header("Content-Disposition", "attachment; filename=audit.jsonl")
+	g.Status(http.StatusOK)
+	if err := a.Node.ExportAudit(g.Writer); err != nil {
+		a.abort500(g, err)
+		return
+	}
+}
+
+// verifyAudit godoc
+// @Summary Verify the audit log
+// @Description Walks the audit log hash chain, reporting the number of entries or where the chain is broken
+// @Tags audit
+// @Produce text/plain
+// @Success 200 {string} string "count"
+// @Failure 409 {string} string "Conflict"
+// @Router /audit/verify [get]
+func (a *Api) verifyAudit(g *gin.Context) {
+	count, err := a.Node.VerifyAudit()
+	if err != nil {
+		g.String(http.StatusConflict, err.Error())
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(count))
+}
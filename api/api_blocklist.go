@@ -0,0 +1,79 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsBlocklist godoc
+// @Summary List blocked accounts
+// @Description Lists all blocked account addresses
+// @Tags blocklist
+// @Produce application/json
+// @Success 200 {object} pb.BlockedAccountList "blocked accounts"
+// @Router /blocklist [get]
+func (a *Api) lsBlocklist(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.Node.BlockedAccounts())
+}
+
+// addBlocklist godoc
+// @Summary Block an account
+// @Description Adds an account address to the blocklist. Envelopes, invites, cafe
+// @Description messages, notifications and feed items from the account are dropped.
+// @Tags blocklist
+// @Param address path string true "address"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocklist/{address} [put]
+func (a *Api) addBlocklist(g *gin.Context) {
+	if err := a.Node.BlockAccount(g.Param("address")); err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// rmBlocklist godoc
+// @Summary Unblock an account
+// @Description Removes an account address from the blocklist
+// @Tags blocklist
+// @Param address path string true "address"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Router /blocklist/{address} [delete]
+func (a *Api) rmBlocklist(g *gin.Context) {
+	if err := a.Node.UnblockAccount(g.Param("address")); err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// publishBlocklist godoc
+// @Summary Publish the blocklist
+// @Description Publishes the blocklist to account peers via the account thread
+// @Tags blocklist
+// @Produce application/json
+// @Success 201 {object} pb.Block "block"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocklist/publish [post]
+func (a *Api) publishBlocklist(g *gin.Context) {
+	hash, err := a.Node.PublishBlocklist()
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	block, err := a.Node.BlockView(hash.B58String())
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, block)
+}
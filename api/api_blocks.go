@@ -55,7 +55,9 @@ func getFile(files *pb.Files, indexStr string, path string) (*pb.FileIndex, erro
 	}
 
 	// index
-	f = files.Files[index]
+	if index >= 0 && index < len(files.Files) {
+		f = files.Files[index]
+	}
 	if f == nil {
 		return nil, fmt.Errorf("failed to get the file at index %d, did not exist", index), http.StatusNotFound
 	}
@@ -67,7 +69,8 @@ func getFile(files *pb.Files, indexStr string, path string) (*pb.FileIndex, erro
 			return nil, fmt.Errorf("failed to get the file at index %d, no file content", index), http.StatusNotFound
 		}
 	} else {
-		fi := f.Links[path]
+		// files added under an older schema version may not have the link
+		fi = f.Links[path]
 		if fi == nil {
 			return nil, fmt.Errorf("failed to get the file at index %d path %s, did not exist", index, path), http.StatusNotFound
 		}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/b582q9/go-textile-sapien/repo/config"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/gin-gonic/gin"
@@ -116,7 +117,9 @@ func (a *Api) patchConfig(g *gin.Context) {
 		return
 	}
 
-	if err := ioutil.WriteFile(configPath, jsn, 0666); err != nil {
+	err = ioutil.WriteFile(configPath, jsn, 0666)
+	a.Node.Audit(core.AuditConfigPatch, patchPaths(patch), err)
+	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -158,10 +161,25 @@ func (a *Api) setConfig(g *gin.Context) {
 		return
 	}
 
-	if err := ioutil.WriteFile(configPath, jsn, 0666); err != nil {
+	err = ioutil.WriteFile(configPath, jsn, 0666)
+	a.Node.Audit(core.AuditConfigSet, "/", err)
+	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
 
 	g.Writer.WriteHeader(http.StatusNoContent)
 }
+
+// patchPaths returns the config paths touched by a patch, e.g., "/Addresses/API,/Logs"
+func patchPaths(patch jsonpatch.Patch) string {
+	var paths []string
+	for _, op := range patch {
+		pth, err := op.Path()
+		if err != nil {
+			continue
+		}
+		paths = append(paths, pth)
+	}
+	return strings.Join(paths, ",")
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/b582q9/go-textile-sapien/keypair"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/gin-gonic/gin"
 )
@@ -103,6 +105,71 @@ func (a *Api) rmContacts(g *gin.Context) {
 	g.Status(http.StatusNoContent)
 }
 
+// contactSafetyNumber godoc
+// @Summary Show safety number
+// @Description Shows the safety number shared with a contact, which should be compared out of band
+// @Tags contacts
+// @Produce text/plain
+// @Param address path string true "address"
+// @Success 200 {string} string "safety number"
+// @Failure 400 {string} string "Bad Request"
+// @Router /contacts/{address}/safety [get]
+func (a *Api) contactSafetyNumber(g *gin.Context) {
+	num, err := a.Node.ContactSafetyNumber(g.Param("address"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g.String(http.StatusOK, keypair.FormatSafetyNumber(num))
+}
+
+// verifyContact godoc
+// @Summary Verify a contact
+// @Description Marks a known contact and its current peers as verified
+// @Tags contacts
+// @Param address path string true "address"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /contacts/{address}/verify [put]
+func (a *Api) verifyContact(g *gin.Context) {
+	err := a.Node.VerifyContact(g.Param("address"))
+	if err != nil {
+		if err == core.ErrContactNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// unverifyContact godoc
+// @Summary Unverify a contact
+// @Description Removes the verified state from a known contact
+// @Tags contacts
+// @Param address path string true "address"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /contacts/{address}/verify [delete]
+func (a *Api) unverifyContact(g *gin.Context) {
+	err := a.Node.UnverifyContact(g.Param("address"))
+	if err != nil {
+		if err == core.ErrContactNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
 // searchContacts godoc
 // @Summary Search for contacts
 // @Description Search for contacts known locally and on the network
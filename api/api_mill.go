@@ -1,12 +1,15 @@
 package api
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/b582q9/go-textile-sapien/core"
 	m "github.com/b582q9/go-textile-sapien/mill"
+	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/jsonpb"
 )
 
 // schemaMill godoc
@@ -89,7 +92,7 @@ func (a *Api) blobMill(g *gin.Context) {
 // @Accept multipart/form-data
 // @Produce application/json
 // @Param file formData file false "multipart/form-data file"
-// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width (required), quality: the requested JPEG image quality" default(plaintext=false,use="",quality=75,width=100)
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, width: the requested image width, height: the requested image height (width and/or height required), quality: the requested JPEG/WebP image quality, format: the output format (jpeg, png, gif or webp, defaults to the input format)" default(plaintext=false,use="",quality=75,width=100)
 // @Success 201 {object} pb.FileIndex "file"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -106,12 +109,14 @@ func (a *Api) imageResizeMill(g *gin.Context) {
 		},
 	}
 
-	// width is required
-	if opts["width"] == "" {
-		g.String(http.StatusBadRequest, "missing width")
+	// width and/or height is required
+	if opts["width"] == "" && opts["height"] == "" {
+		g.String(http.StatusBadRequest, "missing width or height")
 		return
 	}
 	mill.Opts.Width = opts["width"]
+	mill.Opts.Height = opts["height"]
+	mill.Opts.Format = opts["format"]
 
 	// quality defaults to 75
 	if opts["quality"] != "" {
@@ -135,6 +140,48 @@ func (a *Api) imageResizeMill(g *gin.Context) {
 	pbJSON(g, http.StatusCreated, added)
 }
 
+// imageCropMill godoc
+// @Summary Crop an image
+// @Description Takes an input image, and crops it to a fixed aspect ratio (optionally encrypting output),
+// @Description before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, aspect: the crop aspect ratio, e.g., 16:9, square: whether to crop a square thumbnail (aspect or square required), strategy: center or entropy, width: the max output width, quality: the requested JPEG/WebP image quality, format: the output format (jpeg, png, gif or webp, defaults to the input format)" default(plaintext=false,use="",square=true,strategy=entropy,quality=75,width=100)
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/image/crop [post]
+func (a *Api) imageCropMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill, err := m.New("/image/crop", opts)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.Node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
 // imageExifMill godoc
 // @Summary Extract EXIF data from image
 // @Description Takes an input image, and extracts its EXIF data (optionally encrypting output),
@@ -238,3 +285,78 @@ func (a *Api) jsonMill(g *gin.Context) {
 
 	pbJSON(g, http.StatusCreated, added)
 }
+
+// pipelineMill godoc
+// @Summary Run a mill pipeline
+// @Description Takes an input file, and runs it through an ordered list of mills, skipping steps
+// @Description whose condition does not hold (optionally encrypting output), before adding to IPFS,
+// @Description and returns a file object. Conditions may test the result meta of earlier files.
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string true "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS, steps: JSON list of pipeline steps (required), links: JSON object of earlier link names to file hashes tested by conditions" default(plaintext=false,use="",steps=[],links={})
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/pipeline [post]
+func (a *Api) pipelineMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(opts["steps"]), &raw); err != nil {
+		g.String(http.StatusBadRequest, "invalid steps: "+err.Error())
+		return
+	}
+	steps := make([]*pb.MillStep, len(raw))
+	for i, r := range raw {
+		steps[i] = new(pb.MillStep)
+		if err := jsonpb.UnmarshalString(string(r), steps[i]); err != nil {
+			g.String(http.StatusBadRequest, "invalid steps: "+err.Error())
+			return
+		}
+	}
+
+	links := make(map[string]map[string]interface{})
+	if opts["links"] != "" {
+		var hashes map[string]string
+		if err := json.Unmarshal([]byte(opts["links"]), &hashes); err != nil {
+			g.String(http.StatusBadRequest, "invalid links: "+err.Error())
+			return
+		}
+		for name, hash := range hashes {
+			file, err := a.Node.FileMeta(hash)
+			if err != nil {
+				g.String(http.StatusBadRequest, err.Error())
+				return
+			}
+			links[name] = pb.ToMap(file.Meta)
+		}
+	}
+
+	mill, err := m.NewPipeline(steps, links)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := a.Node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
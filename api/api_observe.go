@@ -8,13 +8,17 @@ import (
 	"github.com/b582q9/go-textile-sapien/core"
 	pb "github.com/b582q9/go-textile-sapien/pb"
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
 )
 
 // getThreadsObserve godoc
 // @Summary Observe thread updates
 // @Description Observes updates in a thread or all threads. An update is generated
 // @Description when a new block is added to a thread. There are several update types:
-// @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE
+// @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE.
+// @Description Read markers (READ) and typing indicators (TYPING) are only included when
+// @Description explicitly requested, with a ThreadRead or ThreadTyping payload.
 // @Tags observe
 // @Produce application/json
 // @Param thread path string false "thread id, omit to stream all events"
@@ -33,12 +37,60 @@ func (a *Api) getThreadsObserve(g *gin.Context) {
 	types := strings.Split(strings.TrimSpace(strings.ToUpper(opts["type"])), "|")
 	threadId := g.Param("id")
 
+	var reads, typing bool
+	for _, t := range types {
+		switch t {
+		case "READ":
+			reads = true
+		case "TYPING":
+			typing = true
+		}
+	}
+
 	listener := a.Node.ThreadUpdateListener()
+	presence := a.Node.ThreadPresenceListener()
 	g.Stream(func(w io.Writer) bool {
 		select {
 		case <-g.Request.Context().Done():
 			return false
 
+		case value, ok := <-presence.Ch:
+			if !ok {
+				return false
+			}
+
+			var item *pb.FeedItem
+			var err error
+			switch v := value.(type) {
+			case *pb.ThreadRead:
+				if reads {
+					item, err = presenceFeedItem(v.Thread, v.Block, v)
+				}
+			case *pb.ThreadTyping:
+				if typing {
+					item, err = presenceFeedItem(v.Thread, "", v)
+				}
+			}
+			if err != nil {
+				log.Error(err.Error())
+				break
+			}
+			if item == nil || (threadId != "" && item.Thread != threadId) {
+				break
+			}
+
+			str, err := pbMarshaler.MarshalToString(item)
+			if err != nil {
+				g.String(http.StatusBadRequest, err.Error())
+				break
+			}
+			if opts["events"] == "true" {
+				g.SSEvent("presence", str)
+			} else {
+				g.Data(http.StatusOK, "application/json", []byte(str))
+				g.Writer.Write([]byte("\n"))
+			}
+
 		case value, ok := <-listener.Ch:
 			if !ok {
 				return false
@@ -79,4 +131,21 @@ func (a *Api) getThreadsObserve(g *gin.Context) {
 	})
 
 	listener.Close()
+	presence.Close()
+}
+
+// presenceFeedItem wraps a read marker or typing indicator as a feed item
+func presenceFeedItem(thread string, block string, msg proto.Message) (*pb.FeedItem, error) {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FeedItem{
+		Block:  block,
+		Thread: thread,
+		Payload: &any.Any{
+			TypeUrl: "/" + proto.MessageName(msg),
+			Value:   value,
+		},
+	}, nil
 }
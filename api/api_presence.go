@@ -0,0 +1,100 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/gin-gonic/gin"
+)
+
+// addThreadReads godoc
+// @Summary Mark a thread as read
+// @Description Moves the local last-read pointer up to a block, defaulting to the
+// @Description latest block. The position is synced to account peers via the account
+// @Description thread and a read marker is published to thread peers.
+// @Tags threads
+// @Param id path string true "thread id"
+// @Param X-Textile-Args header string false "block id"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/reads [post]
+func (a *Api) addThreadReads(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	var block string
+	if len(args) > 0 {
+		block = args[0]
+	}
+
+	err = a.Node.MarkThreadRead(g.Param("id"), block)
+	if err != nil {
+		if err == core.ErrThreadNotFound || err == core.ErrBlockNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	g.Status(http.StatusNoContent)
+}
+
+// lsThreadReads godoc
+// @Summary List thread read pointers
+// @Description Lists the last-read pointer of each peer in a thread
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 200 {object} pb.ThreadReadList "reads"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/reads [get]
+func (a *Api) lsThreadReads(g *gin.Context) {
+	reads, err := a.Node.ThreadReads(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, reads)
+}
+
+// addThreadTyping godoc
+// @Summary Send a typing indicator
+// @Description Publishes a typing indicator to thread peers
+// @Tags threads
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "typing: Whether or not typing is in progress" default(typing="true")
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/typing [post]
+func (a *Api) addThreadTyping(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	typing, err := strconv.ParseBool(opts["typing"])
+	if err != nil {
+		typing = true
+	}
+
+	err = a.Node.SendThreadTyping(g.Param("id"), typing)
+	if err != nil {
+		if err == core.ErrThreadNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 
+	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,6 +24,30 @@ func (a *Api) getProfile(g *gin.Context) {
 	pbJSON(g, http.StatusOK, profile)
 }
 
+// resolveProfile godoc
+// @Summary Resolve an account profile
+// @Description Resolves the public ipns profile of an account by address, verifying
+// @Description its signature. The profile includes the heads of web published threads.
+// @Tags profile
+// @Produce application/json
+// @Param address path string true "account address"
+// @Success 200 {object} pb.Profile "profile"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /profile/{address} [get]
+func (a *Api) resolveProfile(g *gin.Context) {
+	profile, err := a.Node.ResolveProfile(g.Param("address"))
+	if err != nil {
+		if err == core.ErrProfileNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	pbJSON(g, http.StatusOK, profile)
+}
+
 // setName godoc
 // @Summary Set display name
 // @Description Sets public profile display name to given string
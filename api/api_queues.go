@@ -0,0 +1,152 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/gin-gonic/gin"
+)
+
+// lsQueues godoc
+// @Summary List queued items
+// @Description Lists pending and failed items in the block outbox, cafe outbox and cafe inbox,
+// @Description including their target peer or cafe, attempts, last error and age
+// @Tags queues
+// @Produce application/json
+// @Param X-Textile-Opts header string false "queue: Limit to block_outbox, cafe_outbox or cafe_inbox, failed: Only list failed items" default(queue=,failed=false)
+// @Success 200 {object} pb.QueueItemList "queue items"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /queues [get]
+func (a *Api) lsQueues(g *gin.Context) {
+	failed, queues, ok := a.readQueueOpts(g)
+	if !ok {
+		return
+	}
+
+	pbJSON(g, http.StatusOK, a.Node.QueueItems(failed, queues...))
+}
+
+// getQueues godoc
+// @Summary Get a queued item
+// @Description Gets a queued item by ID
+// @Tags queues
+// @Produce application/json
+// @Param id path string true "queue item id"
+// @Success 200 {object} pb.QueueItem "queue item"
+// @Failure 404 {string} string "Not Found"
+// @Router /queues/{id} [get]
+func (a *Api) getQueues(g *gin.Context) {
+	item, err := a.Node.QueueItem(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, item)
+}
+
+// retryQueues godoc
+// @Summary Retry queued items
+// @Description Resets the attempts of a queued item by ID and flushes its queue.
+// @Description Use 'all' to retry all failed items.
+// @Tags queues
+// @Produce application/json
+// @Param id path string true "queue item id"
+// @Param X-Textile-Opts header string false "queue: Limit 'all' to block_outbox, cafe_outbox or cafe_inbox" default(queue=)
+// @Success 200 {string} string "count"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /queues/{id}/retry [post]
+func (a *Api) retryQueues(g *gin.Context) {
+	id := g.Param("id")
+	if id != "all" {
+		if err := a.Node.RetryQueueItem(id); err != nil {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		g.String(http.StatusOK, "1")
+		return
+	}
+
+	_, queues, ok := a.readQueueOpts(g)
+	if !ok {
+		return
+	}
+	count, err := a.Node.RetryQueues(queues...)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(count))
+}
+
+// rmQueues godoc
+// @Summary Remove queued items
+// @Description Drops a queued item by ID. Use 'all' to purge queues.
+// @Tags queues
+// @Produce application/json
+// @Param id path string true "queue item id"
+// @Param X-Textile-Opts header string false "queue: Limit 'all' to block_outbox, cafe_outbox or cafe_inbox, failed: Only purge failed items" default(queue=,failed=false)
+// @Success 200 {string} string "count"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /queues/{id} [delete]
+func (a *Api) rmQueues(g *gin.Context) {
+	id := g.Param("id")
+	if id != "all" {
+		if err := a.Node.RemoveQueueItem(id); err != nil {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		g.String(http.StatusOK, "1")
+		return
+	}
+
+	failed, queues, ok := a.readQueueOpts(g)
+	if !ok {
+		return
+	}
+	count, err := a.Node.PurgeQueues(failed, queues...)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(count))
+}
+
+// readQueueOpts reads the queue and failed options, writing an error response if invalid
+func (a *Api) readQueueOpts(g *gin.Context) (bool, []pb.QueueItem_Queue, bool) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return false, nil, false
+	}
+
+	var failed bool
+	if opts["failed"] != "" {
+		failed, err = strconv.ParseBool(opts["failed"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return false, nil, false
+		}
+	}
+
+	var queues []pb.QueueItem_Queue
+	if opts["queue"] != "" {
+		queue, err := core.ParseQueue(opts["queue"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return false, nil, false
+		}
+		queues = append(queues, queue)
+	}
+
+	return failed, queues, true
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/gin-gonic/gin"
+)
+
+// addBlockReactions godoc
+// @Summary Add a reaction
+// @Description Adds an emoji reaction to a thread block
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "emoji or :shortcode:"
+// @Success 201 {object} pb.Reaction "reaction"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [post]
+func (a *Api) addBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing reaction")
+		return
+	}
+
+	thread, err, code := getBlockThread(a.Node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	hash, err := thread.AddReaction(id, args[0])
+	if err != nil {
+		if err == core.ErrInvalidReaction {
+			g.String(http.StatusBadRequest, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	reaction, err := a.Node.Reaction(hash.B58String())
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, reaction)
+}
+
+// lsBlockReactions godoc
+// @Summary List reactions
+// @Description Lists active reactions on a thread block, including legacy likes
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.ReactionList "reactions"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [get]
+func (a *Api) lsBlockReactions(g *gin.Context) {
+	reactions, err := a.Node.Reactions(g.Param("id"))
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, reactions)
+}
+
+// rmBlockReactions godoc
+// @Summary Remove a reaction
+// @Description Adds an un-react block removing own reactions with the given emoji
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "emoji or :shortcode:"
+// @Success 201 {object} pb.Block "block"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [delete]
+func (a *Api) rmBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing reaction")
+		return
+	}
+
+	thread, err, code := getBlockThread(a.Node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	hash, err := thread.AddUnreact(id, args[0])
+	if err != nil {
+		if err == core.ErrInvalidReaction {
+			g.String(http.StatusBadRequest, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	block, err, code := getBlock(a.Node, hash.B58String())
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, block)
+}
+
+// getBlockReaction godoc
+// @Summary Get thread reaction
+// @Description Gets a thread reaction by block ID
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.Reaction "reaction"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/reaction [get]
+func (a *Api) getBlockReaction(g *gin.Context) {
+	info, err := a.Node.Reaction(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, info)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"crypto/rand"
 	"net/http"
+	"strconv"
 
 	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/b582q9/go-textile-sapien/pb"
@@ -148,6 +149,113 @@ func (a *Api) renameThreads(g *gin.Context) {
 	g.Status(http.StatusNoContent)
 }
 
+// updateThreadSchema godoc
+// @Summary Update a thread schema
+// @Description Records a new schema on-chain. Files added before the update are still
+// @Description validated against the schema that was current when they were added.
+// @Description Only initiators can update a thread schema.
+// @Tags threads
+// @Param id path string true "id"
+// @Param X-Textile-Args header string true "schema id"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/schema [put]
+func (a *Api) updateThreadSchema(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing schema id")
+		return
+	}
+
+	if _, err := a.Node.UpdateThreadSchema(g.Param("id"), args[0]); err != nil {
+		if err == core.ErrThreadNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	g.Status(http.StatusNoContent)
+}
+
+// publishThreadWeb godoc
+// @Summary Publish a thread to the gateway
+// @Description Renders a read only or public thread as a web page (with RSS and Atom feeds)
+// @Description at the gateway's /threads/{id}. Content is decrypted by this node, keys are never served.
+// @Tags threads
+// @Param id path string true "id"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/web [put]
+func (a *Api) publishThreadWeb(g *gin.Context) {
+	if err := a.Node.PublishThreadWeb(g.Param("id")); err != nil {
+		if err == core.ErrThreadNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// unpublishThreadWeb godoc
+// @Summary Unpublish a thread from the gateway
+// @Description Removes a thread's web page from the gateway
+// @Tags threads
+// @Param id path string true "id"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/web [delete]
+func (a *Api) unpublishThreadWeb(g *gin.Context) {
+	if err := a.Node.UnpublishThreadWeb(g.Param("id")); err != nil {
+		if err == core.ErrThreadNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// remillThread godoc
+// @Summary Re-mill thread files
+// @Description Regenerates links added to the thread schema since its files were added,
+// @Description e.g., a new image size. Regenerated files are only indexed locally.
+// @Tags threads
+// @Produce text/plain
+// @Param id path string true "id"
+// @Success 200 {string} string "number of updated file blocks"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/remill [post]
+func (a *Api) remillThread(g *gin.Context) {
+	count, err := a.Node.RemillThread(g.Param("id"))
+	if err != nil {
+		if err == core.ErrThreadNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			g.String(http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	g.String(http.StatusOK, strconv.Itoa(count))
+}
+
 // lsThreads godoc
 // @Summary Lists info on all threads
 // @Description Lists all local threads, returning a ThreadList object
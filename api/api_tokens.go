@@ -2,7 +2,10 @@ package api
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/gin-gonic/gin"
 )
 
@@ -13,10 +16,11 @@ import (
 // @Description token. If the 'store' option is set to false, the token is generated, but not
 // @Description stored in the local Cafe db. Alternatively, an existing token can be added using
 // @Description by specifying the 'token' option.
-// @Description Tokens allow other peers to register with a Cafe peer.
+// @Description Tokens allow other peers to register with a Cafe peer. Clients inherit the
+// @Description storage and thread quotas of the token they registered with.
 // @Tags tokens
 // @Produce application/json
-// @Param X-Textile-Opts header string false "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db" default(token=,store="true")
+// @Param X-Textile-Opts header string false "token: Use existing token, rather than creating a new one, store: Whether to store the added/generated token to the local db, label: A name for the token, expiry: How long the token can be used to register, e.g., 720h, max_clients: Maximum number of clients, storage_quota: Bytes each client may store, thread_quota: Threads each client may store" default(token=,store="true",label=,expiry=,max_clients=0,storage_quota=0,thread_quota=0)
 // @Success 201 {string} string "token"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
@@ -27,7 +31,12 @@ func (a *Api) createTokens(g *gin.Context) {
 		a.abort500(g, err)
 		return
 	}
-	token, err := a.Node.CreateCafeToken(opts["token"], opts["store"] == "true")
+	limits, err := tokenOptions(opts)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := a.Node.CreateCafeToken(opts["token"], opts["store"] == "true", limits)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -37,13 +46,25 @@ func (a *Api) createTokens(g *gin.Context) {
 
 // lsTokens godoc
 // @Summary List local tokens
-// @Description List info about all stored cafe tokens
+// @Description List info about all stored cafe tokens, or their usage
 // @Tags tokens
 // @Produce application/json
+// @Param X-Textile-Opts header string false "usage: Whether to list the clients each token admitted and what they store" default(usage="false")
 // @Success 200 {array} string "tokens"
+// @Success 200 {object} pb.CafeTokenUsageList "usage"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /tokens [get]
 func (a *Api) lsTokens(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if opts["usage"] == "true" {
+		pbJSON(g, http.StatusOK, a.Node.CafeTokenUsages())
+		return
+	}
+
 	tokens, err := a.Node.CafeTokens()
 	if err != nil {
 		a.abort500(g, err)
@@ -74,19 +95,83 @@ func (a *Api) validateTokens(g *gin.Context) {
 	g.String(http.StatusOK, "ok")
 }
 
+// usageTokens godoc
+// @Summary Show token usage
+// @Description Shows the clients a cafe token admitted and what they store
+// @Tags tokens
+// @Produce application/json
+// @Param token path string true "token"
+// @Success 200 {object} pb.CafeTokenUsage "usage"
+// @Failure 404 {string} string "Not Found"
+// @Router /tokens/{id}/usage [get]
+func (a *Api) usageTokens(g *gin.Context) {
+	usage, err := a.Node.CafeTokenUsage(g.Param("token"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+	pbJSON(g, http.StatusOK, usage)
+}
+
 // rmTokens godoc
 // @Summary Removes a cafe token
-// @Description Removes an existing cafe token
+// @Description Removes an existing cafe token. The clients it admitted keep their sessions,
+// @Description unless the cascade option expires them or purges the clients and their data.
 // @Tags tokens
 // @Param token path string true "token"
+// @Param X-Textile-Opts header string false "cascade: expire or purge the clients the token admitted" default(cascade=)
 // @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /tokens/{id} [delete]
 func (a *Api) rmTokens(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	cascade, err := core.ParseCafeTokenCascade(opts["cascade"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
 	token := g.Param("token")
-	if err := a.Node.RemoveCafeToken(token); err != nil {
+	if err := a.Node.RemoveCafeToken(token, cascade); err != nil {
 		a.abort500(g, err)
 		return
 	}
 	g.Status(http.StatusNoContent)
 }
+
+// tokenOptions parses token limits from request options
+func tokenOptions(opts map[string]string) (core.CafeTokenOptions, error) {
+	limits := core.CafeTokenOptions{Label: opts["label"]}
+	var err error
+	if opts["expiry"] != "" {
+		expiry, err := time.ParseDuration(opts["expiry"])
+		if err != nil {
+			return limits, err
+		}
+		limits.Expiry = time.Now().Add(expiry)
+	}
+	if opts["max_clients"] != "" {
+		limits.MaxClients, err = strconv.Atoi(opts["max_clients"])
+		if err != nil {
+			return limits, err
+		}
+	}
+	if opts["storage_quota"] != "" {
+		limits.StorageQuota, err = strconv.ParseInt(opts["storage_quota"], 10, 64)
+		if err != nil {
+			return limits, err
+		}
+	}
+	if opts["thread_quota"] != "" {
+		limits.ThreadQuota, err = strconv.Atoi(opts["thread_quota"])
+		if err != nil {
+			return limits, err
+		}
+	}
+	return limits, nil
+}
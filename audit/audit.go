@@ -0,0 +1,292 @@
+// Package audit is an append-only log of security-relevant actions.
+// Entries are stored as JSON lines, each including the hash of the one before it,
+// so edits, removals and reordering can be detected by walking the chain.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("tex-audit")
+
+// maxLineSize is the largest entry that can be read back
+const maxLineSize = 1024 * 1024
+
+// ErrClosed indicates the log has been closed
+var ErrClosed = fmt.Errorf("audit log is closed")
+
+// ErrInvalidResult indicates a result filter is unknown
+var ErrInvalidResult = fmt.Errorf("result must be one of ok or error")
+
+// BrokenChainError describes where the chain no longer verifies
+type BrokenChainError struct {
+	Seq    int64
+	Reason string
+}
+
+func (e *BrokenChainError) Error() string {
+	return fmt.Sprintf("audit log is broken at entry %d: %s", e.Seq, e.Reason)
+}
+
+// Query filters log entries
+type Query struct {
+	Actor  string
+	Action string // matches the action or actions below it, e.g., "cafe" matches "cafe.register"
+	Target string
+	Result *pb.AuditEntry_Result
+	Since  time.Time
+	Until  time.Time
+	Limit  int // newest entries first, all if <= 0
+}
+
+// ParseResult returns the result with the given name, e.g., "ok"
+func ParseResult(name string) (pb.AuditEntry_Result, error) {
+	res, ok := pb.AuditEntry_Result_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, ErrInvalidResult
+	}
+	return pb.AuditEntry_Result(res), nil
+}
+
+// Log is an append-only, hash-chained JSON lines file
+type Log struct {
+	path string
+	file *os.File
+	seq  int64
+	last string
+	lock sync.Mutex
+}
+
+// Open opens or creates the log at path and loads the head of the chain.
+// A damaged log is still opened so new actions are recorded, Verify reports the damage.
+func Open(path string) (*Log, error) {
+	l := &Log{path: path}
+	err := l.each(func(entry *pb.AuditEntry) error {
+		l.seq = entry.Seq
+		l.last = entry.Hash
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*BrokenChainError); !ok {
+			return nil, err
+		}
+		log.Warningf("opening damaged audit log: %s", err)
+	}
+
+	l.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Close closes the log
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// Append adds an entry for an action, which failed if err is not nil
+func (l *Log) Append(actor string, action string, target string, err error) (*pb.AuditEntry, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return nil, ErrClosed
+	}
+
+	entry := &pb.AuditEntry{
+		Seq:    l.seq + 1,
+		Date:   ptypes.TimestampNow(),
+		Actor:  actor,
+		Action: action,
+		Target: target,
+		Prev:   l.last,
+	}
+	if err != nil {
+		entry.Result = pb.AuditEntry_ERROR
+		entry.Error = err.Error()
+	}
+	entry.Hash = Hash(entry)
+
+	line, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(entry)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := l.file.WriteString(line + "\n"); err != nil {
+		return nil, err
+	}
+	if err := l.file.Sync(); err != nil {
+		return nil, err
+	}
+
+	l.seq = entry.Seq
+	l.last = entry.Hash
+	return entry, nil
+}
+
+// List returns entries matching the query, newest first
+func (l *Log) List(query Query) (*pb.AuditEntryList, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	items := make([]*pb.AuditEntry, 0)
+	err := l.each(func(entry *pb.AuditEntry) error {
+		if match(entry, query) {
+			items = append(items, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	if query.Limit > 0 && len(items) > query.Limit {
+		items = items[:query.Limit]
+	}
+	return &pb.AuditEntryList{Items: items}, nil
+}
+
+// Verify walks the chain, returning the number of entries or a BrokenChainError
+func (l *Log) Verify() (int, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var count int
+	var prev string
+	err := l.each(func(entry *pb.AuditEntry) error {
+		seq := int64(count + 1)
+		if entry.Seq != seq {
+			return &BrokenChainError{Seq: seq, Reason: "out of sequence"}
+		}
+		if entry.Prev != prev {
+			return &BrokenChainError{Seq: seq, Reason: "previous hash does not match"}
+		}
+		if Hash(entry) != entry.Hash {
+			return &BrokenChainError{Seq: seq, Reason: "hash does not match contents"}
+		}
+		count++
+		prev = entry.Hash
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	if int64(count) != l.seq || prev != l.last {
+		return count, &BrokenChainError{Seq: int64(count), Reason: "entries were removed"}
+	}
+	return count, nil
+}
+
+// Export writes the raw log to w
+func (l *Log) Export(w io.Writer) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	file, err := os.Open(l.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// Hash returns the hex encoded sha256 of an entry's contents and previous hash
+func Hash(entry *pb.AuditEntry) string {
+	var date string
+	if entry.Date != nil {
+		date = strconv.FormatInt(util.ProtoNanos(entry.Date), 10)
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		strconv.FormatInt(entry.Seq, 10),
+		date,
+		entry.Actor,
+		entry.Action,
+		entry.Target,
+		entry.Result.String(),
+		entry.Error,
+		entry.Prev,
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// each calls fn with each entry in order, stopping at the first error
+func (l *Log) each(fn func(*pb.AuditEntry) error) error {
+	file, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 4096), maxLineSize)
+	var line int64
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		entry := new(pb.AuditEntry)
+		err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), entry)
+		if err != nil {
+			return &BrokenChainError{Seq: line, Reason: err.Error()}
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// match returns whether or not an entry matches a query
+func match(entry *pb.AuditEntry, query Query) bool {
+	if query.Actor != "" && entry.Actor != query.Actor {
+		return false
+	}
+	if query.Action != "" && entry.Action != query.Action &&
+		!strings.HasPrefix(entry.Action, query.Action+".") {
+		return false
+	}
+	if query.Target != "" && entry.Target != query.Target {
+		return false
+	}
+	if query.Result != nil && entry.Result != *query.Result {
+		return false
+	}
+	date := util.ProtoTime(entry.Date)
+	if !query.Since.IsZero() && date.Before(query.Since) {
+		return false
+	}
+	if !query.Until.IsZero() && date.After(query.Until) {
+		return false
+	}
+	return true
+}
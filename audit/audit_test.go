@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+)
+
+func setupLog(t *testing.T) (*Log, string) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pth := filepath.Join(dir, "audit.jsonl")
+	l, err := Open(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := []struct {
+		actor  string
+		action string
+		target string
+		err    error
+	}{
+		{"P1", "cafe.register", "A1", nil},
+		{"P2", "cafe.register", "A2", fmt.Errorf("forbidden")},
+		{"A0", "token.create", "T1", nil},
+		{"P1", "cafe.deregister", "P1", nil},
+	}
+	for _, e := range entries {
+		if _, err := l.Append(e.actor, e.action, e.target, e.err); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return l, pth
+}
+
+func TestLog_List(t *testing.T) {
+	l, pth := setupLog(t)
+	defer os.RemoveAll(filepath.Dir(pth))
+	defer l.Close()
+
+	list, err := l.List(Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(list.Items))
+	}
+	if list.Items[0].Seq != 4 {
+		t.Fatal("entries should be newest first")
+	}
+
+	list, _ = l.List(Query{Action: "cafe"})
+	if len(list.Items) != 3 {
+		t.Fatalf("expected 3 cafe entries, got %d", len(list.Items))
+	}
+	list, _ = l.List(Query{Actor: "P1"})
+	if len(list.Items) != 2 {
+		t.Fatalf("expected 2 entries by P1, got %d", len(list.Items))
+	}
+	failed := pb.AuditEntry_ERROR
+	list, _ = l.List(Query{Result: &failed})
+	if len(list.Items) != 1 || list.Items[0].Error != "forbidden" {
+		t.Fatal("expected 1 failed entry")
+	}
+	list, _ = l.List(Query{Until: time.Now().Add(-time.Hour)})
+	if len(list.Items) != 0 {
+		t.Fatal("expected no entries before an hour ago")
+	}
+	list, _ = l.List(Query{Limit: 1})
+	if len(list.Items) != 1 {
+		t.Fatal("expected limit to apply")
+	}
+}
+
+func TestLog_Reopen(t *testing.T) {
+	l, pth := setupLog(t)
+	defer os.RemoveAll(filepath.Dir(pth))
+	_ = l.Close()
+
+	l, err := Open(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	entry, err := l.Append("A0", "thread.remove", "T1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Seq != 5 {
+		t.Fatal("reopened log should continue the chain")
+	}
+	count, err := l.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Fatalf("expected 5 verified entries, got %d", count)
+	}
+}
+
+func TestLog_Tamper(t *testing.T) {
+	l, pth := setupLog(t)
+	defer os.RemoveAll(filepath.Dir(pth))
+	_ = l.Close()
+
+	// rewrite a failed registration as a success
+	raw, err := ioutil.ReadFile(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(raw), "\n")
+	lines[1] = strings.Replace(lines[1], `"result":"ERROR",`, "", 1)
+	err = ioutil.WriteFile(pth, []byte(strings.Join(lines, "\n")), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err = Open(pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	count, err := l.Verify()
+	broken, ok := err.(*BrokenChainError)
+	if !ok {
+		t.Fatalf("expected broken chain, got %v", err)
+	}
+	if broken.Seq != 2 || count != 1 {
+		t.Fatalf("expected chain to break at entry 2, got %d", broken.Seq)
+	}
+}
+
+func TestLog_Export(t *testing.T) {
+	l, pth := setupLog(t)
+	defer os.RemoveAll(filepath.Dir(pth))
+	defer l.Close()
+
+	var buf bytes.Buffer
+	if err := l.Export(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(buf.String(), "\n") != 4 {
+		t.Fatal("export should include every entry")
+	}
+}
@@ -44,6 +44,15 @@ func AccountAddress() error {
 	return nil
 }
 
+func AccountCard() error {
+	res, err := executeJsonCmd(http.MethodGet, "account/card", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func AccountSync(wait int) error {
 	results := handleSearchStream("snapshots/search", params{
 		opts: map[string]string{
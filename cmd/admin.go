@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// adminAPI is a cafe admin api address and the token it requires
+type adminAPI struct {
+	addr  string
+	token string
+}
+
+func AdminClientList(admin adminAPI, query string, limit int) error {
+	opts := url.Values{}
+	opts.Set("q", query)
+	opts.Set("limit", strconv.Itoa(limit))
+
+	res, err := executeAdminCmd(admin, http.MethodGet, "clients", opts, new(pb.CafeClientList))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminClientGet(admin adminAPI, id string, limit int) error {
+	opts := url.Values{}
+	opts.Set("limit", strconv.Itoa(limit))
+
+	res, err := executeAdminCmd(admin, http.MethodGet, "clients/"+id, opts, new(pb.CafeClientInfo))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminClientExpire(admin adminAPI, id string) error {
+	res, err := executeAdminCmd(admin, http.MethodPost, "clients/"+id+"/expire", nil, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminClientPurge(admin adminAPI, id string) error {
+	res, err := executeAdminCmd(admin, http.MethodDelete, "clients/"+id, nil, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminBanList(admin adminAPI) error {
+	res, err := executeAdminCmd(admin, http.MethodGet, "bans", nil, new(pb.CafeBanList))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminBan(admin adminAPI, address string, reason string) error {
+	opts := url.Values{}
+	opts.Set("reason", reason)
+
+	res, err := executeAdminCmd(admin, http.MethodPut, "bans/"+address, opts, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminUnban(admin adminAPI, address string) error {
+	res, err := executeAdminCmd(admin, http.MethodDelete, "bans/"+address, nil, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminTokenList(admin adminAPI) error {
+	res, err := executeAdminCmd(admin, http.MethodGet, "tokens", nil, new(pb.CafeTokenList))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminTokenUsage(admin adminAPI) error {
+	res, err := executeAdminCmd(admin, http.MethodGet, "tokens/usage", nil, new(pb.CafeTokenUsageList))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AdminTokenRevoke(admin adminAPI, id string, cascade string) error {
+	opts := url.Values{}
+	if cascade != "" {
+		opts.Set("cascade", cascade)
+	}
+
+	res, err := executeAdminCmd(admin, http.MethodDelete, "tokens/"+id, opts, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+// executeAdminCmd sends a request to a cafe admin api, rendering the response into target
+func executeAdminCmd(admin adminAPI, meth method, pth string, query url.Values, target proto.Message) (string, error) {
+	apiURL := fmt.Sprintf("%s/api/v1/admin/%s", strings.TrimRight(admin.addr, "/"), pth)
+	if len(query) > 0 {
+		apiURL += "?" + query.Encode()
+	}
+	if *logDebug {
+		fmt.Println(apiURL)
+	}
+	req, err := http.NewRequest(string(meth), apiURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+admin.token)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case res.StatusCode == http.StatusUnauthorized:
+		return "", fmt.Errorf("error: missing admin token")
+	case res.StatusCode == http.StatusForbidden:
+		return "", fmt.Errorf("error: invalid admin token")
+	case res.StatusCode == http.StatusNotFound && len(data) == 0:
+		return "", fmt.Errorf("error: admin api is not enabled on this cafe")
+	case res.StatusCode >= 400:
+		return "", fmt.Errorf(string(data))
+	}
+
+	if target == nil || len(data) == 0 {
+		return "", nil
+	}
+	if err := pbUnmarshaler.Unmarshal(bytes.NewReader(data), target); err != nil {
+		return "", err
+	}
+	return pbMarshaler.MarshalToString(target)
+}
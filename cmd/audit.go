@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+func AuditList(actor string, action string, target string, result string, since string, until string, limit int) error {
+	res, err := executeJsonCmd(http.MethodGet, "audit", params{
+		opts: map[string]string{
+			"actor":  actor,
+			"action": action,
+			"target": target,
+			"result": result,
+			"since":  since,
+			"until":  until,
+			"limit":  strconv.Itoa(limit),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func AuditExport() error {
+	return executeBlobCmd(http.MethodGet, "audit/export", params{})
+}
+
+func AuditVerify() error {
+	res, _, err := request(http.MethodGet, "audit/verify", params{})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := util.UnmarshalString(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= 400 {
+		return fmt.Errorf(body)
+	}
+	output(fmt.Sprintf("verified %s entries", body))
+	return nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"net/http"
+)
+
+func BlocklistList() error {
+	res, err := executeJsonCmd(http.MethodGet, "blocklist", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func BlocklistAdd(address string) error {
+	res, err := executeStringCmd(http.MethodPut, "blocklist/"+address, params{})
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		output("blocked " + address)
+	} else {
+		output(res)
+	}
+	return nil
+}
+
+func BlocklistRemove(address string) error {
+	res, err := executeStringCmd(http.MethodDelete, "blocklist/"+address, params{})
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		output("unblocked " + address)
+	} else {
+		output(res)
+	}
+	return nil
+}
+
+func BlocklistPublish() error {
+	res, err := executeJsonCmd(http.MethodPost, "blocklist/publish", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -58,6 +58,11 @@ func Chat(threadID string) error {
 					}
 					println(Cyan(payload.User.Name) + "  " + Grey(payload.Body))
 					last = false
+
+					// let the sender know we've seen it
+					if err := threadRead(threadID, update.Block); err != nil {
+						fmt.Println(err.Error())
+					}
 				}
 			}
 		}
@@ -158,6 +158,10 @@ func Run() error {
 	accountAddressCmd := accountCmd.Command("address", "Shows the local peer's account address")
 	cmds[accountAddressCmd.FullCommand()] = AccountAddress
 
+	// account card
+	accountCardCmd := accountCmd.Command("card", "Shows the local peer's account info as a contact card signed by the account key")
+	cmds[accountCardCmd.FullCommand()] = AccountCard
+
 	// account sync
 	accountSyncCmd := accountCmd.Command("sync", "Syncs the local account peer with other peers found on the network")
 	accountSyncWait := accountSyncCmd.Flag("wait", "Stops searching after 'wait' seconds have elapsed (max 30s)").Default("2").Int()
@@ -167,6 +171,113 @@ func Run() error {
 
 	// ================================
 
+	// admin
+	adminCmd := appCmd.Command("admin", "Manage the clients, bans and tokens of a cafe via its admin API")
+	adminAddr := adminCmd.Flag("cafe", "Cafe API address").Envar("CAFE_ADMIN_API").Default("http://127.0.0.1:40601").String()
+	adminToken := adminCmd.Flag("token", "Cafe admin token").Envar("CAFE_ADMIN_TOKEN").Required().String()
+	admin := func() adminAPI {
+		return adminAPI{addr: *adminAddr, token: *adminToken}
+	}
+
+	// admin clients
+	adminClientsCmd := adminCmd.Command("clients", "Lists registered clients, most recently seen first").Alias("ls")
+	adminClientsQuery := adminClientsCmd.Flag("query", "Only list clients whose peer ID or account address contains this").Short('q').String()
+	adminClientsLimit := adminClientsCmd.Flag("limit", "List page size").Short('l').Default("100").Int()
+	cmds[adminClientsCmd.FullCommand()] = func() error {
+		return AdminClientList(admin(), *adminClientsQuery, *adminClientsLimit)
+	}
+
+	// admin client
+	adminClientCmd := adminCmd.Command("client", "Shows a client's last seen date, stored threads and waiting messages")
+	adminClientId := adminClientCmd.Arg("id", "Client peer ID").Required().String()
+	adminClientLimit := adminClientCmd.Flag("limit", "Max number of messages to show").Short('l').Default("100").Int()
+	cmds[adminClientCmd.FullCommand()] = func() error {
+		return AdminClientGet(admin(), *adminClientId, *adminClientLimit)
+	}
+
+	// admin expire
+	adminExpireCmd := adminCmd.Command("expire", "Expires all sessions of a client, which must register again")
+	adminExpireId := adminExpireCmd.Arg("id", "Client peer ID").Required().String()
+	cmds[adminExpireCmd.FullCommand()] = func() error {
+		return AdminClientExpire(admin(), *adminExpireId)
+	}
+
+	// admin purge
+	adminPurgeCmd := adminCmd.Command("purge", "Deletes a client with its thread snapshots and messages")
+	adminPurgeId := adminPurgeCmd.Arg("id", "Client peer ID").Required().String()
+	cmds[adminPurgeCmd.FullCommand()] = func() error {
+		return AdminClientPurge(admin(), *adminPurgeId)
+	}
+
+	// admin bans
+	adminBansCmd := adminCmd.Command("bans", "Lists banned accounts")
+	cmds[adminBansCmd.FullCommand()] = func() error {
+		return AdminBanList(admin())
+	}
+
+	// admin ban
+	adminBanCmd := adminCmd.Command("ban", "Bans an account from registering and expires the sessions of its clients")
+	adminBanAddress := adminBanCmd.Arg("address", "Account address").Required().String()
+	adminBanReason := adminBanCmd.Flag("reason", "Reason for the ban, e.g., an abuse report reference").Short('r').String()
+	cmds[adminBanCmd.FullCommand()] = func() error {
+		return AdminBan(admin(), *adminBanAddress, *adminBanReason)
+	}
+
+	// admin unban
+	adminUnbanCmd := adminCmd.Command("unban", "Lifts an account ban")
+	adminUnbanAddress := adminUnbanCmd.Arg("address", "Account address").Required().String()
+	cmds[adminUnbanCmd.FullCommand()] = func() error {
+		return AdminUnban(admin(), *adminUnbanAddress)
+	}
+
+	// admin tokens
+	adminTokensCmd := adminCmd.Command("tokens", "Lists cafe tokens with their labels and limits")
+	cmds[adminTokensCmd.FullCommand()] = func() error {
+		return AdminTokenList(admin())
+	}
+
+	// admin usage
+	adminUsageCmd := adminCmd.Command("usage", "Lists the clients each cafe token admitted and what they store")
+	cmds[adminUsageCmd.FullCommand()] = func() error {
+		return AdminTokenUsage(admin())
+	}
+
+	// admin revoke
+	adminRevokeCmd := adminCmd.Command("revoke", "Revokes a cafe token by ID so it can no longer be used to register")
+	adminRevokeId := adminRevokeCmd.Arg("id", "Token ID").Required().String()
+	adminRevokeCascade := adminRevokeCmd.Flag("cascade", "Expire the sessions of, or purge, the clients the token admitted").Enum("expire", "purge")
+	cmds[adminRevokeCmd.FullCommand()] = func() error {
+		return AdminTokenRevoke(admin(), *adminRevokeId, *adminRevokeCascade)
+	}
+
+	// ================================
+
+	// audit
+	auditCmd := appCmd.Command("audit", "Inspect the tamper-evident log of security-relevant actions taken on this node")
+
+	// audit list
+	auditListCmd := auditCmd.Command("list", "Lists audit log entries, newest first").Alias("ls").Default()
+	auditListActor := auditListCmd.Flag("actor", "Only list actions by this peer ID or account address").String()
+	auditListAction := auditListCmd.Flag("action", "Only list this action or actions below it, e.g., cafe or cafe.register").Short('a').String()
+	auditListTarget := auditListCmd.Flag("target", "Only list actions on this target").Short('t').String()
+	auditListResult := auditListCmd.Flag("result", "Only list actions with this result, one of ok or error").String()
+	auditListSince := auditListCmd.Flag("since", "Only list actions at or after this RFC3339 date").String()
+	auditListUntil := auditListCmd.Flag("until", "Only list actions at or before this RFC3339 date").String()
+	auditListLimit := auditListCmd.Flag("limit", "List page size").Short('l').Default("100").Int()
+	cmds[auditListCmd.FullCommand()] = func() error {
+		return AuditList(*auditListActor, *auditListAction, *auditListTarget, *auditListResult, *auditListSince, *auditListUntil, *auditListLimit)
+	}
+
+	// audit export
+	auditExportCmd := auditCmd.Command("export", "Writes the raw hash-chained JSON lines log to stdout")
+	cmds[auditExportCmd.FullCommand()] = AuditExport
+
+	// audit verify
+	auditVerifyCmd := auditCmd.Command("verify", "Walks the log's hash chain to check that it has not been modified")
+	cmds[auditVerifyCmd.FullCommand()] = AuditVerify
+
+	// ================================
+
 	// block
 	blockCmd := appCmd.Command("block", "Threads are composed of an append-only log of blocks, use these commands to manage them").Alias("blocks")
 
@@ -185,6 +296,33 @@ func Run() error {
 
 	// ================================
 
+	// blocklist
+	blocklistCmd := appCmd.Command("blocklist", "Manage blocked accounts, whose envelopes, invites, notifications and feed items are dropped")
+
+	// blocklist list
+	blocklistListCmd := blocklistCmd.Command("list", "Lists blocked accounts").Alias("ls").Default()
+	cmds[blocklistListCmd.FullCommand()] = BlocklistList
+
+	// blocklist add
+	blocklistAddCmd := blocklistCmd.Command("add", "Blocks an account")
+	blocklistAddAddress := blocklistAddCmd.Arg("address", "Account Address").Required().String()
+	cmds[blocklistAddCmd.FullCommand()] = func() error {
+		return BlocklistAdd(*blocklistAddAddress)
+	}
+
+	// blocklist remove
+	blocklistRemoveCmd := blocklistCmd.Command("remove", "Unblocks an account").Alias("rm")
+	blocklistRemoveAddress := blocklistRemoveCmd.Arg("address", "Account Address").Required().String()
+	cmds[blocklistRemoveCmd.FullCommand()] = func() error {
+		return BlocklistRemove(*blocklistRemoveAddress)
+	}
+
+	// blocklist publish
+	blocklistPublishCmd := blocklistCmd.Command("publish", "Publishes the blocklist to account peers via the account thread")
+	cmds[blocklistPublishCmd.FullCommand()] = BlocklistPublish
+
+	// ================================
+
 	// bots
 	botsCmd := appCmd.Command("bots", "Commands to manage bots").Alias("bot")
 
@@ -341,6 +479,34 @@ An access token is required to register, and should be obtained separately from
 	cmds[contactSearchCmd.FullCommand()] = func() error {
 		return ContactSearch(*contactSearchName, *contactSearchAddress, *contactSearchLocal, *contactSearchRemote, *contactSearchLimit, *contactSearchWait)
 	}
+
+	// contact import
+	contactImportCmd := contactCmd.Command("import", "Imports a signed contact card, as produced by 'textile account card'")
+	contactImportPath := contactImportCmd.Arg("path", "Path to a contact card JSON file").Required().String()
+	cmds[contactImportCmd.FullCommand()] = func() error {
+		return ContactImport(*contactImportPath)
+	}
+
+	// contact safety
+	contactSafetyCmd := contactCmd.Command("safety", "Shows the safety number shared with a contact, compare it out of band before verifying")
+	contactSafetyAddress := contactSafetyCmd.Arg("address", "Account Address").Required().String()
+	cmds[contactSafetyCmd.FullCommand()] = func() error {
+		return ContactSafety(*contactSafetyAddress)
+	}
+
+	// contact verify
+	contactVerifyCmd := contactCmd.Command("verify", "Marks a known contact and its current peers as verified")
+	contactVerifyAddress := contactVerifyCmd.Arg("address", "Account Address").Required().String()
+	cmds[contactVerifyCmd.FullCommand()] = func() error {
+		return ContactVerify(*contactVerifyAddress)
+	}
+
+	// contact unverify
+	contactUnverifyCmd := contactCmd.Command("unverify", "Removes the verified state from a known contact")
+	contactUnverifyAddress := contactUnverifyCmd.Arg("address", "Account Address").Required().String()
+	cmds[contactUnverifyCmd.FullCommand()] = func() error {
+		return ContactUnverify(*contactUnverifyAddress)
+	}
 	// @todo why not make this part of `textile contact list`?
 	// @todo perhaps our `list` and `get` commands can be merged
 
@@ -444,16 +610,21 @@ Stacks may include:
 	initAccountSeed := initCmd.Arg("account-seed", "The account seed to use, if you do not have one, refer to: textile wallet --help").Required().String()
 	initPin := initCmd.Flag("pin", "Specify a pin for datastore encryption").Short('p').String()
 	initIpfsServerMode := initCmd.Flag("server", "Apply IPFS server profile").Bool()
+	initLANMode := initCmd.Flag("lan", "Apply LAN-only profile for direct messaging via mDNS w/o internet access").Bool()
 	initIpfsSwarmPorts := initCmd.Flag("swarm-ports", "Set the swarm ports (TCP,WS). A random TCP port is chosen by default").String()
 	initLogFiles := initCmd.Flag("log-files", "If true, writes logs to rolling files, if false, writes logs to stdout").Default("false").Bool()
 	initApiBindAddr := initCmd.Flag("api-bind-addr", "Set the local API address").Default("127.0.0.1:40600").String()
 	initCafeApiBindAddr := initCmd.Flag("cafe-bind-addr", "Set the cafe REST API address").Default("0.0.0.0:40601").String()
 	initGatewayBindAddr := initCmd.Flag("gateway-bind-addr", "Set the IPFS gateway address").Default("127.0.0.1:5050").String()
 	initProfilingBindAddr := initCmd.Flag("profile-bind-addr", "Set the profiling address").Default("127.0.0.1:6060").String()
+	initMetricsBindAddr := initCmd.Flag("metrics-bind-addr", "Set the prometheus metrics address").Default("127.0.0.1:40602").String()
+	initNoMetrics := initCmd.Flag("no-metrics", "Disable the prometheus metrics endpoint").Bool()
 	initCafe := initCmd.Flag("cafe", "Open the p2p cafe service for other peers").Bool()
 	initCafeOpen := initCmd.Flag("cafe-open", "Open the p2p cafe service for other peers").Hidden().Bool() // hidden alias
 	initCafeURL := initCmd.Flag("cafe-url", "Specify a custom URL of this cafe, e.g., https://mycafe.com").Envar("CAFE_HOST_URL").String()
 	initCafeNeighborURL := initCmd.Flag("cafe-neighbor-url", "Specify the URL of a secondary cafe. Must return cafe info, e.g., via a Gateway: https://my-gateway.yolo.com/cafe, or a cafe API: https://my-cafe.yolo.com").Envar("CAFE_HOST_NEIGHBOR_URL").String()
+	initCafeNeighbors := initCmd.Flag("cafe-neighbor", "Specify the peer id or multiaddr of a cafe which mirrors client data with this cafe, e.g., /ip4/1.2.3.4/tcp/4001/ipfs/<peer id>. The neighbor must list this cafe too. Can be used multiple times.").Strings()
+	initCafeAdminToken := initCmd.Flag("cafe-admin-token", "Enable the cafe admin API with this token. Only a bcrypt hash is stored.").Envar("CAFE_HOST_ADMIN_TOKEN").String()
 	cmds[initCmd.FullCommand()] = func() error {
 		kp, err := keypair.Parse(*initAccountSeed)
 		if err != nil {
@@ -486,13 +657,18 @@ Stacks may include:
 			CafeApiAddr:     *initCafeApiBindAddr,
 			GatewayAddr:     *initGatewayBindAddr,
 			ProfilingAddr:   *initProfilingBindAddr,
+			MetricsAddr:     *initMetricsBindAddr,
+			DisableMetrics:  *initNoMetrics,
 			IsMobile:        false,
 			IsServer:        *initIpfsServerMode,
+			IsLAN:           *initLANMode,
 			LogToDisk:       *initLogFiles,
 			Debug:           *logDebug,
 			CafeOpen:        *initCafe || *initCafeOpen,
 			CafeURL:         *initCafeURL,
 			CafeNeighborURL: *initCafeNeighborURL,
+			CafeNeighbors:   *initCafeNeighbors,
+			CafeAdminToken:  *initCafeAdminToken,
 		}
 
 		return InitCommand(config)
@@ -624,6 +800,41 @@ There are two types of invites, direct account-to-account and external:
 
 	// ================================
 
+	// reaction
+	reactionCmd := appCmd.Command("reaction", `Reactions are emoji added as blocks in a thread, which target another block. Likes are listed as 👍 reactions.`).Alias("reactions")
+
+	// reaction add
+	reactionAddCmd := reactionCmd.Command("add", "Attach an emoji reaction to a block")
+	reactionAddBlockID := reactionAddCmd.Arg("block", "Block ID to react to").Required().String()
+	reactionAddEmoji := reactionAddCmd.Arg("emoji", "A single emoji or :shortcode:").Required().String()
+	cmds[reactionAddCmd.FullCommand()] = func() error {
+		return ReactionAdd(*reactionAddBlockID, *reactionAddEmoji)
+	}
+
+	// reaction list
+	reactionListCmd := reactionCmd.Command("list", "Get active reactions that are attached to a block").Alias("ls").Default()
+	reactionListBlockID := reactionListCmd.Arg("block", "Block ID").Required().String()
+	cmds[reactionListCmd.FullCommand()] = func() error {
+		return ReactionList(*reactionListBlockID)
+	}
+
+	// reaction get
+	reactionGetCmd := reactionCmd.Command("get", "Get a reaction by its own Block ID")
+	reactionGetReactionID := reactionGetCmd.Arg("reaction-block", "Reaction Block ID").Required().String()
+	cmds[reactionGetCmd.FullCommand()] = func() error {
+		return ReactionGet(*reactionGetReactionID)
+	}
+
+	// reaction remove
+	reactionRemoveCmd := reactionCmd.Command("remove", "Remove your reaction with the given emoji from a block").Alias("rm")
+	reactionRemoveBlockID := reactionRemoveCmd.Arg("block", "Block ID").Required().String()
+	reactionRemoveEmoji := reactionRemoveCmd.Arg("emoji", "A single emoji or :shortcode:").Required().String()
+	cmds[reactionRemoveCmd.FullCommand()] = func() error {
+		return ReactionRemove(*reactionRemoveBlockID, *reactionRemoveEmoji)
+	}
+
+	// ================================
+
 	// log
 	logCmd := appCmd.Command("log", `List or change the verbosity of one or all subsystems log output. Textile logs piggyback on the IPFS event logs.`).Alias("logs")
 	logSubsystem := logCmd.Flag("subsystem", "The subsystem logging identifier, omit for all").Short('s').String()
@@ -669,14 +880,65 @@ There are two types of invites, direct account-to-account and external:
 	migrateRepo := migrateCmd.Flag("repo", "Specify a custom path to the repo directory").Short('r').String()
 	migrateBaseRepo := migrateCmd.Flag("base-repo", "Specify a custom path to the base repo directory").Short('b').String()
 	migrateAccountAddress := migrateCmd.Flag("account-address", "Specify an existing account address").Short('a').String()
+	migrateDryRun := migrateCmd.Flag("dry-run", "List pending migrations without applying them").Bool()
+	migrateRollback := migrateCmd.Flag("rollback", "Restore the datastore snapshot taken before the last migration").Bool()
 	cmds[migrateCmd.FullCommand()] = func() error {
 		repo, err := getRepo(*migrateRepo, *migrateBaseRepo, *migrateAccountAddress)
 		if err != nil {
 			return err
 		}
+		if *migrateDryRun {
+			return MigrateDryRun(repo)
+		}
+		if *migrateRollback {
+			return MigrateRollback(repo)
+		}
 		return Migrate(repo, *appPassword)
 	}
 
+	// ================================
+
+	// repo
+	repoCmd := appCmd.Command("repo", "Manage the node repository while the daemon is not running")
+	repoRepo := repoCmd.Flag("repo", "Specify a custom path to the repo directory").Short('r').String()
+	repoBaseRepo := repoCmd.Flag("base-repo", "Specify a custom path to the base repo directory").Short('b').String()
+	repoAccountAddress := repoCmd.Flag("account-address", "Specify an existing account address").Short('a').String()
+
+	// repo pin
+	repoPinCmd := repoCmd.Command("pin", "Manage the pin used for datastore encryption. The current pin is read from --password.")
+
+	// repo pin set
+	repoPinSetCmd := repoPinCmd.Command("set", "Encrypts an unencrypted datastore with a new pin")
+	repoPinSetPin := repoPinSetCmd.Arg("pin", "The new pin").Required().String()
+	cmds[repoPinSetCmd.FullCommand()] = func() error {
+		repo, err := getRepo(*repoRepo, *repoBaseRepo, *repoAccountAddress)
+		if err != nil {
+			return err
+		}
+		return RepoPinChange(repo, "", *repoPinSetPin)
+	}
+
+	// repo pin change
+	repoPinChangeCmd := repoPinCmd.Command("change", "Re-encrypts the datastore with a new pin")
+	repoPinChangePin := repoPinChangeCmd.Arg("pin", "The new pin").Required().String()
+	cmds[repoPinChangeCmd.FullCommand()] = func() error {
+		repo, err := getRepo(*repoRepo, *repoBaseRepo, *repoAccountAddress)
+		if err != nil {
+			return err
+		}
+		return RepoPinChange(repo, *appPassword, *repoPinChangePin)
+	}
+
+	// repo pin remove
+	repoPinRemoveCmd := repoPinCmd.Command("remove", "Removes datastore encryption").Alias("rm")
+	cmds[repoPinRemoveCmd.FullCommand()] = func() error {
+		repo, err := getRepo(*repoRepo, *repoBaseRepo, *repoAccountAddress)
+		if err != nil {
+			return err
+		}
+		return RepoPinChange(repo, *appPassword, "")
+	}
+
 	// ================================
 	// Notifications are local-only, and most block updates generate them
 	// E.g. https://github.com/b582q9/go-textile-sapien/blob/72a910879b5b8135d3cf65c5348beeb5aa4226a0/core/threads_service.go#L395
@@ -736,6 +998,13 @@ There are two types of invites, direct account-to-account and external:
 		return ProfileSet(*profileSetName, *profileSetAvatar)
 	}
 
+	// profile resolve
+	profileResolveCmd := profileCmd.Command("resolve", "Resolves the public profile of an account, which is published to IPNS and lists web published threads")
+	profileResolveAddress := profileResolveCmd.Arg("address", "Account address").Required().String()
+	cmds[profileResolveCmd.FullCommand()] = func() error {
+		return ProfileResolve(*profileResolveAddress)
+	}
+
 	// ================================
 
 	// observe
@@ -746,6 +1015,45 @@ There are two types of invites, direct account-to-account and external:
 		return ObserveCommand(*observeThreadID, *observeType)
 	}
 
+	// ================================
+	// Queues hold outbound block messages, outbound cafe requests and inbound cafe messages
+	// Items which run out of attempts are marked failed and generate a notification
+
+	// queue
+	queueCmd := appCmd.Command("queue", "Inspect, retry and purge queued block and cafe messages").Alias("queues")
+
+	// queue list
+	queueListCmd := queueCmd.Command("list", "Lists pending and failed queue items").Alias("ls").Default()
+	queueListQueue := queueListCmd.Flag("queue", "Only list items in block_outbox, cafe_outbox or cafe_inbox").Short('q').String()
+	queueListFailed := queueListCmd.Flag("failed", "Only list failed items").Bool()
+	cmds[queueListCmd.FullCommand()] = func() error {
+		return QueueList(*queueListQueue, *queueListFailed)
+	}
+
+	// queue get
+	queueGetCmd := queueCmd.Command("get", "Gets a queue item")
+	queueGetID := queueGetCmd.Arg("id", "Queue item ID").Required().String()
+	cmds[queueGetCmd.FullCommand()] = func() error {
+		return QueueGet(*queueGetID)
+	}
+
+	// queue retry
+	queueRetryCmd := queueCmd.Command("retry", "Resets a queue item's attempts and flushes its queue")
+	queueRetryID := queueRetryCmd.Arg("id", "Queue item ID, set to [all] to retry all failed items").Required().String()
+	queueRetryQueue := queueRetryCmd.Flag("queue", "Limit [all] to block_outbox, cafe_outbox or cafe_inbox").Short('q').String()
+	cmds[queueRetryCmd.FullCommand()] = func() error {
+		return QueueRetry(*queueRetryID, *queueRetryQueue)
+	}
+
+	// queue remove
+	queueRemoveCmd := queueCmd.Command("remove", "Drops a queue item").Alias("rm").Alias("purge")
+	queueRemoveID := queueRemoveCmd.Arg("id", "Queue item ID, set to [all] to purge queues").Required().String()
+	queueRemoveQueue := queueRemoveCmd.Flag("queue", "Limit [all] to block_outbox, cafe_outbox or cafe_inbox").Short('q').String()
+	queueRemoveFailed := queueRemoveCmd.Flag("failed", "Limit [all] to failed items").Bool()
+	cmds[queueRemoveCmd.FullCommand()] = func() error {
+		return QueueRemove(*queueRemoveID, *queueRemoveQueue, *queueRemoveFailed)
+	}
+
 	// ================================
 
 	// summary
@@ -815,6 +1123,21 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 		return ThreadPeer(*threadPeerThreadID)
 	}
 
+	// thread read
+	threadReadCmd := threadCmd.Command("read", "Marks a thread as read up to a block, syncing the position to account peers and publishing a read marker to thread peers")
+	threadReadThreadID := threadReadCmd.Arg("thread", "Thread ID").Required().String()
+	threadReadBlockID := threadReadCmd.Arg("block", "Block ID of the last read block, defaults to the latest block").String()
+	cmds[threadReadCmd.FullCommand()] = func() error {
+		return ThreadRead(*threadReadThreadID, *threadReadBlockID)
+	}
+
+	// thread reads
+	threadReadsCmd := threadCmd.Command("reads", "Lists the last-read pointer of each peer in a thread")
+	threadReadsThreadID := threadReadsCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadReadsCmd.FullCommand()] = func() error {
+		return ThreadReads(*threadReadsThreadID)
+	}
+
 	// thread rename
 	threadRenameCmd := threadCmd.Command("rename", "Renames a thread. Only the initiator of a thread can rename it.").Alias("mv")
 	threadRenameThreadID := threadRenameCmd.Arg("thread", "Thread ID").Required().String()
@@ -823,6 +1146,29 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 		return ThreadRename(*threadRenameName, *threadRenameThreadID)
 	}
 
+	// thread schema
+	threadSchemaCmd := threadCmd.Command("schema", "Records a new schema for a thread. Files added before the update are still validated against the schema that was current when they were added. Only the initiator of a thread can update its schema.")
+	threadSchemaThreadID := threadSchemaCmd.Arg("thread", "Thread ID").Required().String()
+	threadSchemaSchemaID := threadSchemaCmd.Arg("schema", "Schema ID").Required().String()
+	cmds[threadSchemaCmd.FullCommand()] = func() error {
+		return ThreadSchema(*threadSchemaThreadID, *threadSchemaSchemaID)
+	}
+
+	// thread remill
+	threadRemillCmd := threadCmd.Command("remill", "Regenerates links added to the thread schema since its files were added, e.g., a new image size. Regenerated files are only indexed locally.")
+	threadRemillThreadID := threadRemillCmd.Arg("thread", "Thread ID").Required().String()
+	cmds[threadRemillCmd.FullCommand()] = func() error {
+		return ThreadRemill(*threadRemillThreadID)
+	}
+
+	// thread web
+	threadWebCmd := threadCmd.Command("web", "Publishes a read only or public thread as a web page (with RSS and Atom feeds) at the gateway's /threads/<id>. Content is decrypted by this node, keys are never served.")
+	threadWebThreadID := threadWebCmd.Arg("thread", "Thread ID").Required().String()
+	threadWebUnpublish := threadWebCmd.Flag("unpublish", "Remove the thread's web page").Bool()
+	cmds[threadWebCmd.FullCommand()] = func() error {
+		return ThreadWeb(*threadWebThreadID, *threadWebUnpublish)
+	}
+
 	// thread abandon
 	threadAbandonCmd := threadCmd.Command("abandon", "Abandon a thread. If no one is else remains participating, the thread dissipates.").Alias("unsubscribe").Alias("leave").Alias("remove").Alias("rm")
 	threadAbandonThreadID := threadAbandonCmd.Arg("thread", "Thread ID").Required().String()
@@ -874,8 +1220,19 @@ The response contains a base58 encoded version of the random bytes token.`).Alia
 	tokenCreateNoStore := tokenCreateCmd.Flag("no-store", "If used instead of token, the token is generated but not stored in the local cafe database").Short('n').Bool()
 	// @todo at some point remove --no-store, if no one is using it
 	tokenCreateToken := tokenCreateCmd.Flag("token", "If used instead of no-store, use this existing token rather than creating a new one").Short('t').String()
+	tokenCreateLabel := tokenCreateCmd.Flag("label", "A name for the token, i.e., the app it is issued to").Short('l').String()
+	tokenCreateExpiry := tokenCreateCmd.Flag("expiry", "How long the token can be used to register, e.g., 720h").Duration()
+	tokenCreateMaxClients := tokenCreateCmd.Flag("max-clients", "Maximum number of clients which can register with the token").Int()
+	tokenCreateStorageQuota := tokenCreateCmd.Flag("storage-quota", "Bytes each client registered with the token may store").Int64()
+	tokenCreateThreadQuota := tokenCreateCmd.Flag("thread-quota", "Threads each client registered with the token may store").Int()
 	cmds[tokenCreateCmd.FullCommand()] = func() error {
-		return TokenCreate(*tokenCreateToken, *tokenCreateNoStore)
+		return TokenCreate(*tokenCreateToken, *tokenCreateNoStore, tokenLimits{
+			label:        *tokenCreateLabel,
+			expiry:       *tokenCreateExpiry,
+			maxClients:   *tokenCreateMaxClients,
+			storageQuota: *tokenCreateStorageQuota,
+			threadQuota:  *tokenCreateThreadQuota,
+		})
 	}
 
 	// token list
@@ -884,6 +1241,13 @@ The response contains a base58 encoded version of the random bytes token.`).Alia
 		return TokenList()
 	}
 
+	// token usage
+	tokenUsageCmd := tokenCmd.Command("usage", "Show the clients a token admitted and what they store, or the usage of all tokens")
+	tokenUsageToken := tokenUsageCmd.Arg("token", "The token to show usage for").String()
+	cmds[tokenUsageCmd.FullCommand()] = func() error {
+		return TokenUsage(*tokenUsageToken)
+	}
+
 	// token validate
 	tokenValidateCmd := tokenCmd.Command("validate", "Check validity of existing cafe access token").Alias("valid")
 	tokenValidateToken := tokenValidateCmd.Arg("token", "The token to validate").Required().String()
@@ -894,8 +1258,9 @@ The response contains a base58 encoded version of the random bytes token.`).Alia
 	// token delete
 	tokenDeleteCmd := tokenCmd.Command("delete", "Removes an existing cafe token").Alias("del").Alias("remove").Alias("rm")
 	tokenDeleteToken := tokenDeleteCmd.Arg("token", "The token to delete").Required().String()
+	tokenDeleteCascade := tokenDeleteCmd.Flag("cascade", "Expire the sessions of, or purge, the clients the token admitted").Enum("expire", "purge")
 	cmds[tokenDeleteCmd.FullCommand()] = func() error {
-		return TokenRemove(*tokenDeleteToken)
+		return TokenRemove(*tokenDeleteToken, *tokenDeleteCascade)
 	}
 
 	// ================================
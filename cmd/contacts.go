@@ -3,11 +3,13 @@ package cmd
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/mitchellh/go-homedir"
 )
 
 var errMissingAddInfo = fmt.Errorf("missing name or account address")
@@ -131,3 +133,76 @@ func ContactSearch(name string, address string, local bool, remote bool, limit i
 
 	return nil
 }
+
+func ContactImport(pth string) error {
+	pth, err := homedir.Expand(pth)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(pth)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var card pb.Contact
+	if err := pbUnmarshaler.Unmarshal(file, &card); err != nil {
+		return err
+	}
+	if card.Sig == nil {
+		return fmt.Errorf("contact card is not signed")
+	}
+	data, err := pbMarshaler.MarshalToString(&card)
+	if err != nil {
+		return err
+	}
+
+	res, err := executeStringCmd(http.MethodPut, "contacts/"+card.Address, params{
+		payload: strings.NewReader(data),
+		ctype:   "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		output("imported " + card.Address)
+	} else {
+		output("error importing " + card.Address + ": " + res)
+	}
+	return nil
+}
+
+func ContactSafety(address string) error {
+	res, err := executeStringCmd(http.MethodGet, "contacts/"+address+"/safety", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ContactVerify(address string) error {
+	res, err := executeStringCmd(http.MethodPut, "contacts/"+address+"/verify", params{})
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		output("verified " + address)
+	} else {
+		output(res)
+	}
+	return nil
+}
+
+func ContactUnverify(address string) error {
+	res, err := executeStringCmd(http.MethodDelete, "contacts/"+address+"/verify", params{})
+	if err != nil {
+		return err
+	}
+	if res == "" {
+		output("unverified " + address)
+	} else {
+		output(res)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -16,6 +17,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/schema"
+	"github.com/golang/protobuf/jsonpb"
 	ipfspath "github.com/ipfs/go-path"
 	"github.com/mitchellh/go-homedir"
 )
@@ -252,7 +254,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 	dir := &pb.Directory{Files: make(map[string]*pb.FileIndex)}
 
 	// traverse the schema and collect generated files
-	if node.Mill != "" {
+	if node.Mill != "" || len(node.Pipeline) > 0 {
 		var res string
 		file := &pb.FileIndex{}
 
@@ -273,8 +275,10 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 			ctype = ct
 		}
 
+		mil := millPath(node.Mill, node.Pipeline, dir, mopts)
+
 		var err error
-		res, file, err = handleStep(node.Mill, reader, mopts, ctype)
+		res, file, err = handleStep(mil, reader, mopts, ctype)
 		if err != nil {
 			return nil, err
 		}
@@ -300,6 +304,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 			mopts := newMillOpts(step.Link.Opts)
 			mopts.setPlaintext(step.Link.Plaintext)
+			mil := millPath(step.Link.Mill, step.Link.Pipeline, dir, mopts)
 
 			if step.Link.Use == schema.FileTag {
 				if reader != nil {
@@ -320,7 +325,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 					}
 				}
 
-				res, file, err = handleStep(step.Link.Mill, reader, mopts, ctype)
+				res, file, err = handleStep(mil, reader, mopts, ctype)
 				if err != nil {
 					return nil, err
 				}
@@ -331,7 +336,7 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 				}
 				mopts.setUse(dir.Files[step.Link.Use].Hash)
 
-				res, err = executeJsonPbCmd(http.MethodPost, "mills"+step.Link.Mill, params{
+				res, err = executeJsonPbCmd(http.MethodPost, "mills"+mil, params{
 					opts: mopts.val,
 				}, file)
 				if err != nil {
@@ -390,6 +395,35 @@ func batchPaths(pths []string, size int) [][]string {
 	return batches
 }
 
+// millPath returns the mills endpoint for a schema mill or pipeline,
+// adding pipeline steps and the earlier files their conditions test to opts
+func millPath(mil string, pipeline []*pb.MillStep, dir *pb.Directory, opts millOpts) string {
+	if len(pipeline) == 0 {
+		return mil
+	}
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	steps := make([]string, 0, len(pipeline))
+	links := make(map[string]string)
+	for _, s := range pipeline {
+		str, err := marshaler.MarshalToString(s)
+		if err != nil {
+			continue
+		}
+		steps = append(steps, str)
+		if s.When != nil && s.When.Link != "" && dir.Files[s.When.Link] != nil {
+			links[s.When.Link] = dir.Files[s.When.Link].Hash
+		}
+	}
+	opts.val["steps"] = "[" + strings.Join(steps, ",") + "]"
+	if len(links) > 0 {
+		data, _ := json.Marshal(links)
+		opts.val["links"] = string(data)
+	}
+
+	return "/pipeline"
+}
+
 func handleStep(mil string, reader io.Reader, opts millOpts, ctype string) (string, *pb.FileIndex, error) {
 	var file pb.FileIndex
 
@@ -17,3 +17,32 @@ func Migrate(repoPath string, pinCode string) error {
 	fmt.Println("Repo was successfully migrated")
 	return nil
 }
+
+// MigrateDryRun lists the migrations that would be applied to the repo
+func MigrateDryRun(repoPath string) error {
+	steps, err := core.PendingRepoMigrations(repoPath)
+	if err != nil {
+		return fmt.Errorf("migrate repo: %s", err)
+	}
+	if len(steps) == 0 {
+		fmt.Println("Repo is up to date")
+		return nil
+	}
+	for _, step := range steps {
+		kind := "minor"
+		if step.Major {
+			kind = "major"
+		}
+		fmt.Printf("%d -> %d: %s (%s)\n", step.Version-1, step.Version, step.Name, kind)
+	}
+	return nil
+}
+
+// MigrateRollback restores the repo from the snapshot taken before the last migration
+func MigrateRollback(repoPath string) error {
+	if err := core.RollbackRepo(repoPath); err != nil {
+		return fmt.Errorf("rollback repo: %s", err)
+	}
+	fmt.Println("Repo was successfully rolled back")
+	return nil
+}
@@ -25,6 +25,15 @@ func getProfile() (string, *pb.Peer, error) {
 	return res, &profile, err
 }
 
+func ProfileResolve(address string) error {
+	res, err := executeJsonCmd(http.MethodGet, "profile/"+address, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func ProfileSet(name string, avatar string) error {
 	if name != "" {
 		res, err := executeStringCmd(http.MethodPost, "profile/name", params{args: []string{name}})
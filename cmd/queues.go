@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func QueueList(queue string, failed bool) error {
+	res, err := executeJsonCmd(http.MethodGet, "queues", params{
+		opts: map[string]string{
+			"queue":  queue,
+			"failed": strconv.FormatBool(failed),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func QueueGet(id string) error {
+	res, err := executeJsonCmd(http.MethodGet, "queues/"+id, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func QueueRetry(id string, queue string) error {
+	res, err := executeStringCmd(http.MethodPost, "queues/"+id+"/retry", params{
+		opts: map[string]string{
+			"queue": queue,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func QueueRemove(id string, queue string, failed bool) error {
+	res, err := executeStringCmd(http.MethodDelete, "queues/"+id, params{
+		opts: map[string]string{
+			"queue":  queue,
+			"failed": strconv.FormatBool(failed),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"net/http"
+)
+
+func ReactionAdd(blockID string, emoji string) error {
+	res, err := executeJsonCmd(http.MethodPost, "blocks/"+blockID+"/reactions", params{args: []string{emoji}}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ReactionList(blockID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "blocks/"+blockID+"/reactions", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ReactionGet(reactionID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "blocks/"+reactionID+"/reaction", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ReactionRemove(blockID string, emoji string) error {
+	res, err := executeJsonCmd(http.MethodDelete, "blocks/"+blockID+"/reactions", params{args: []string{emoji}}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
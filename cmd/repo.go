@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/core"
+)
+
+// RepoPinChange re-encrypts the datastore of a repo that is not running
+func RepoPinChange(repoPath string, oldPin string, newPin string) error {
+	if err := core.ChangeRepoPin(repoPath, oldPin, newPin); err != nil {
+		return fmt.Errorf("change pin: %s", err)
+	}
+	if newPin == "" {
+		fmt.Println("Datastore encryption was removed")
+	} else {
+		fmt.Println("Datastore pin was successfully changed")
+	}
+	return nil
+}
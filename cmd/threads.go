@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -97,6 +98,38 @@ func ThreadPeer(threadID string) error {
 	return nil
 }
 
+func ThreadRead(threadID string, blockID string) error {
+	if err := threadRead(threadID, blockID); err != nil {
+		return err
+	}
+	output("ok")
+	return nil
+}
+
+func threadRead(threadID string, blockID string) error {
+	var args []string
+	if blockID != "" {
+		args = []string{blockID}
+	}
+	res, err := executeStringCmd(http.MethodPost, "threads/"+threadID+"/reads", params{args: args})
+	if err != nil {
+		return err
+	}
+	if res != "" {
+		return fmt.Errorf(res)
+	}
+	return nil
+}
+
+func ThreadReads(threadID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "threads/"+threadID+"/reads", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func ThreadRename(name string, threadID string) error {
 	res, err := executeStringCmd(http.MethodPost, "threads/"+threadID+"/name", params{args: []string{name}})
 	if err != nil {
@@ -106,6 +139,37 @@ func ThreadRename(name string, threadID string) error {
 	return nil
 }
 
+func ThreadSchema(threadID string, schemaID string) error {
+	res, err := executeStringCmd(http.MethodPut, "threads/"+threadID+"/schema", params{args: []string{schemaID}})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadWeb(threadID string, unpublish bool) error {
+	meth := method(http.MethodPut)
+	if unpublish {
+		meth = http.MethodDelete
+	}
+	res, err := executeStringCmd(meth, "threads/"+threadID+"/web", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRemill(threadID string) error {
+	res, err := executeStringCmd(http.MethodPost, "threads/"+threadID+"/remill", params{})
+	if err != nil {
+		return err
+	}
+	output("updated " + res + " file blocks")
+	return nil
+}
+
 func ThreadAbandon(threadID string) error {
 	res, err := executeStringCmd(http.MethodDelete, "threads/"+threadID, params{})
 	if err != nil {
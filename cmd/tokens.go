@@ -3,12 +3,31 @@ package cmd
 import (
 	"net/http"
 	"strconv"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
 )
 
-func TokenCreate(token string, noStore bool) error {
+// tokenLimits are the limits a new token places on the clients it admits
+type tokenLimits struct {
+	label        string
+	expiry       time.Duration
+	maxClients   int
+	storageQuota int64
+	threadQuota  int
+}
+
+func TokenCreate(token string, noStore bool, limits tokenLimits) error {
 	opts := map[string]string{
-		"token": token,
-		"store": strconv.FormatBool(!noStore),
+		"token":         token,
+		"store":         strconv.FormatBool(!noStore),
+		"label":         limits.label,
+		"max_clients":   strconv.Itoa(limits.maxClients),
+		"storage_quota": strconv.FormatInt(limits.storageQuota, 10),
+		"thread_quota":  strconv.Itoa(limits.threadQuota),
+	}
+	if limits.expiry > 0 {
+		opts["expiry"] = limits.expiry.String()
 	}
 
 	res, err := executeStringCmd(http.MethodPost, "tokens", params{opts: opts})
@@ -28,6 +47,28 @@ func TokenList() error {
 	return nil
 }
 
+func TokenUsage(token string) error {
+	if token != "" {
+		var usage pb.CafeTokenUsage
+		res, err := executeJsonPbCmd(http.MethodGet, "tokens/"+token+"/usage", params{}, &usage)
+		if err != nil {
+			return err
+		}
+		output(res)
+		return nil
+	}
+
+	var list pb.CafeTokenUsageList
+	res, err := executeJsonPbCmd(http.MethodGet, "tokens", params{
+		opts: map[string]string{"usage": "true"},
+	}, &list)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func TokenValidate(token string) error {
 	res, err := executeStringCmd(http.MethodGet, "tokens/"+token, params{})
 	if err != nil {
@@ -37,8 +78,10 @@ func TokenValidate(token string) error {
 	return nil
 }
 
-func TokenRemove(token string) error {
-	res, err := executeStringCmd(http.MethodDelete, "tokens/"+token, params{})
+func TokenRemove(token string, cascade string) error {
+	res, err := executeStringCmd(http.MethodDelete, "tokens/"+token, params{
+		opts: map[string]string{"cascade": cascade},
+	})
 	if err != nil {
 		return err
 	}
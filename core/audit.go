@@ -0,0 +1,91 @@
+package core
+
+import (
+	"io"
+	"path/filepath"
+
+	"github.com/b582q9/go-textile-sapien/audit"
+	"github.com/b582q9/go-textile-sapien/pb"
+)
+
+// auditFile is the name of the audit log in the repo
+const auditFile = "audit.jsonl"
+
+// audited actions
+const (
+	AuditCafeRegister   = "cafe.register"
+	AuditCafeDeregister = "cafe.deregister"
+	AuditTokenCreate    = "token.create"
+	AuditTokenRemove    = "token.remove"
+	AuditSeedRead       = "account.seed.read"
+	AuditConfigPatch    = "config.patch"
+	AuditConfigSet      = "config.set"
+	AuditThreadRemove   = "thread.remove"
+	AuditInviteAccept   = "invite.accept"
+	AuditThreadWebOn    = "thread.web.publish"
+	AuditThreadWebOff   = "thread.web.unpublish"
+
+	AuditCafeClientExpire = "cafe.client.expire"
+	AuditCafeClientPurge  = "cafe.client.purge"
+	AuditCafeAccountBan   = "cafe.account.ban"
+	AuditCafeAccountUnban = "cafe.account.unban"
+	AuditCafeTokenRevoke  = "cafe.token.revoke"
+)
+
+// Audit records an action taken by the local account, which failed if err is not nil
+func (t *Textile) Audit(action string, target string, err error) {
+	t.auditAs(t.account.Address(), action, target, err)
+}
+
+// AuditEntries lists audit log entries matching the query, newest first
+func (t *Textile) AuditEntries(query audit.Query) (*pb.AuditEntryList, error) {
+	if t.auditLog == nil {
+		return nil, ErrStopped
+	}
+	return t.auditLog.List(query)
+}
+
+// VerifyAudit checks the audit log hash chain, returning the number of entries
+func (t *Textile) VerifyAudit() (int, error) {
+	if t.auditLog == nil {
+		return 0, ErrStopped
+	}
+	return t.auditLog.Verify()
+}
+
+// ExportAudit writes the raw audit log as JSON lines
+func (t *Textile) ExportAudit(w io.Writer) error {
+	if t.auditLog == nil {
+		return ErrStopped
+	}
+	return t.auditLog.Export(w)
+}
+
+// auditAs records an action taken by the given peer or account
+func (t *Textile) auditAs(actor string, action string, target string, err error) {
+	if t.auditLog == nil {
+		log.Warningf("audit log closed, dropping %s by %s", action, actor)
+		return
+	}
+	if _, err := t.auditLog.Append(actor, action, target, err); err != nil {
+		log.Errorf("error writing audit log: %s", err)
+	}
+}
+
+// openAudit opens the repo's audit log
+func (t *Textile) openAudit() error {
+	var err error
+	t.auditLog, err = audit.Open(filepath.Join(t.repoPath, auditFile))
+	return err
+}
+
+// closeAudit closes the repo's audit log
+func (t *Textile) closeAudit() {
+	if t.auditLog == nil {
+		return
+	}
+	if err := t.auditLog.Close(); err != nil {
+		log.Errorf("error closing audit log: %s", err)
+	}
+	t.auditLog = nil
+}
@@ -2,8 +2,10 @@ package core
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/repo"
 	"github.com/ipfs/go-ipfs/core"
@@ -112,7 +114,9 @@ func (q *BlockDownloads) handle(dl *pb.Block) error {
 // handleErr deletes or adds an attempt to a download processing error
 func (q *BlockDownloads) handleErr(herr error, dl *pb.Block) error {
 	var err error
-	if dl.Attempts+1 >= maxDownloadAttempts {
+	dropped := dl.Attempts+1 >= maxDownloadAttempts
+	metrics.BlockDownloadFailures.WithLabelValues(strconv.FormatBool(dropped)).Inc()
+	if dropped {
 		err = q.datastore.Blocks().Delete(dl.Id)
 	} else {
 		err = q.datastore.Blocks().AddAttempt(dl.Id)
@@ -1,11 +1,15 @@
 package core
 
 import (
+	"fmt"
+	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/b582q9/go-textile-sapien/ipfs"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/segmentio/ksuid"
@@ -15,13 +19,30 @@ import (
 // note: msgs from this group are batched to each receiver
 const blockFlushGroupSize = 16
 
+// blockOutMaxAttempts is the number of times a message can fail before being marked failed
+const blockOutMaxAttempts = 5
+
+// peerBackoffBase is the delay before retrying a peer after its first delivery failure
+const peerBackoffBase = time.Minute
+
+// peerBackoffMax caps the delay between attempts to reach an unreachable peer
+const peerBackoffMax = time.Hour * 12
+
+// errPeerNotOnLAN indicates a LAN mode recipient is not currently connected
+var errPeerNotOnLAN = fmt.Errorf("peer is not connected on the local network")
+
+// errPeerBackoff indicates a recipient w/o inboxes is waiting for its next scheduled attempt
+var errPeerBackoff = fmt.Errorf("peer is unreachable, waiting to retry")
+
 // BlockOutbox queues and processes outbound thread messages
 type BlockOutbox struct {
-	service    func() *ThreadsService
-	node       func() *core.IpfsNode
-	datastore  repo.Datastore
-	cafeOutbox *CafeOutbox
-	lock       sync.Mutex
+	service          func() *ThreadsService
+	node             func() *core.IpfsNode
+	datastore        repo.Datastore
+	cafeOutbox       *CafeOutbox
+	lan              bool
+	sendNotification func(*pb.Notification) error
+	lock             sync.Mutex
 }
 
 // NewBlockOutbox creates a new outbox queue
@@ -29,12 +50,16 @@ func NewBlockOutbox(
 	service func() *ThreadsService,
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
-	cafeOutbox *CafeOutbox) *BlockOutbox {
+	cafeOutbox *CafeOutbox,
+	lan bool,
+	sendNotification func(*pb.Notification) error) *BlockOutbox {
 	return &BlockOutbox{
-		service:    service,
-		node:       node,
-		datastore:  datastore,
-		cafeOutbox: cafeOutbox,
+		service:          service,
+		node:             node,
+		datastore:        datastore,
+		cafeOutbox:       cafeOutbox,
+		lan:              lan,
+		sendNotification: sendNotification,
 	}
 }
 
@@ -62,6 +87,35 @@ func (q *BlockOutbox) Flush() {
 	q.batch(q.datastore.BlockMessages().List("", blockFlushGroupSize))
 }
 
+// FlushPeer processes pending messages for a single peer,
+// e.g., when it has just connected
+func (q *BlockOutbox) FlushPeer(peerId string) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.service() == nil {
+		return
+	}
+
+	msgs := q.datastore.BlockMessages().ListByPeer(peerId)
+	if len(msgs) == 0 {
+		return
+	}
+	log.Debugf("flushing %d block messages for %s", len(msgs), peerId)
+
+	for _, msg := range msgs {
+		if err := q.handle(msg); err != nil {
+			if !deferred(err) {
+				q.handleErr(err, msg)
+			}
+			return
+		}
+		if err := q.datastore.BlockMessages().Delete(msg.Id); err != nil {
+			log.Errorf("failed to delete block message %s: %s", msg.Id, err)
+		}
+	}
+}
+
 // batch flushes a batch of messages
 func (q *BlockOutbox) batch(msgs []pb.BlockMessage) {
 	log.Debugf("handling %d block messages", len(msgs))
@@ -82,7 +136,9 @@ func (q *BlockOutbox) batch(msgs []pb.BlockMessage) {
 		go func(id string, msgs []pb.BlockMessage) {
 			for _, msg := range msgs {
 				if err := q.handle(msg); err != nil {
-					log.Warningf("error handling block message %s: %s", msg.Id, err)
+					if !deferred(err) {
+						q.handleErr(err, msg)
+					}
 					continue
 				}
 				toDelete = append(toDelete, msg.Id)
@@ -110,10 +166,30 @@ func (q *BlockOutbox) batch(msgs []pb.BlockMessage) {
 }
 
 // handle handles a single message
+// In LAN mode, messages are only sent directly and otherwise stay queued
+// until the recipient is seen on the local network.
+// Otherwise, pubsub attempts to unreachable peers back off exponentially,
+// with messages going to the peer's inboxes in the meantime.
 func (q *BlockOutbox) handle(msg pb.BlockMessage) error {
 	online := q.service().online
 	var connected bool
 	var err error
+	if q.lan {
+		if !online {
+			return errPeerNotOnLAN
+		}
+		connected, err = ipfs.SwarmConnected(q.node(), msg.Peer)
+		if err != nil {
+			return err
+		}
+		if !connected {
+			return errPeerNotOnLAN
+		}
+		log.Debugf("sending block message direct to %s on the local network", msg.Peer)
+		return q.service().SendMessage(nil, msg.Peer, msg.Env)
+	}
+
+	health := q.datastore.PeerHealth().Get(msg.Peer)
 	if online {
 		// 1) attempt to send the message directly to the recipient
 		connected, err = ipfs.SwarmConnected(q.node(), msg.Peer)
@@ -123,27 +199,114 @@ func (q *BlockOutbox) handle(msg pb.BlockMessage) error {
 		if connected {
 			log.Debugf("sending block message direct to %s", msg.Peer)
 			err = q.service().SendMessage(nil, msg.Peer, msg.Env)
+			if err == nil {
+				q.resetHealth(health)
+				return nil
+			}
 		}
-	}
 
-	if !connected || err != nil {
-		// 2) attempt to reach the peer via pubsub
-		if online {
+		// 2) attempt to reach the peer via pubsub, unless its circuit is open
+		if peerDue(health) {
 			log.Debugf("publishing block message to %s", msg.Peer)
 			err = q.service().SendPubSubMessage(msg)
+			if err == nil {
+				q.resetHealth(health)
+				return nil
+			}
+			q.addFailure(msg.Peer, health, err)
+		} else {
+			log.Debugf("skipping %s until %s", msg.Peer, util.ProtoTime(health.NextAttempt))
 		}
+	}
 
-		// 3) add offline inbox requests
-		if !online || err != nil {
-			contact := q.datastore.Peers().Get(msg.Peer)
-			if contact != nil && len(contact.Inboxes) > 0 {
-				log.Debugf("sending block message for %s to %s", msg.Peer, contact.Inboxes)
-				err = q.cafeOutbox.AddForInbox(msg.Peer, msg.Env, contact.Inboxes)
-				if err != nil {
-					return err
-				}
-			}
+	// 3) add offline inbox requests
+	contact := q.datastore.Peers().Get(msg.Peer)
+	if contact != nil && len(contact.Inboxes) > 0 {
+		log.Debugf("sending block message for %s to %s", msg.Peer, contact.Inboxes)
+		return q.cafeOutbox.AddForInbox(msg.Peer, msg.Env, contact.Inboxes)
+	}
+
+	// no fallback, wait for the next attempt
+	return errPeerBackoff
+}
+
+// resetHealth clears a peer's failures after a successful delivery
+func (q *BlockOutbox) resetHealth(health *pb.PeerHealth) {
+	if health == nil {
+		return
+	}
+	if err := q.datastore.PeerHealth().Delete(health.Peer); err != nil {
+		log.Errorf("error resetting health for %s: %s", health.Peer, err)
+	}
+}
+
+// addFailure records a delivery failure and schedules the next attempt to reach a peer
+func (q *BlockOutbox) addFailure(peerId string, health *pb.PeerHealth, ferr error) {
+	var failures int32 = 1
+	if health != nil {
+		failures = health.Failures + 1
+	}
+	now := time.Now()
+	next := now.Add(peerBackoff(failures))
+	log.Debugf("%s unreachable (%d failures), next attempt at %s", peerId, failures, next)
+
+	err := q.datastore.PeerHealth().AddOrUpdate(&pb.PeerHealth{
+		Peer:        peerId,
+		Failures:    failures,
+		LastError:   ferr.Error(),
+		LastAttempt: util.ProtoTs(now.UnixNano()),
+		NextAttempt: util.ProtoTs(next.UnixNano()),
+	})
+	if err != nil {
+		log.Errorf("error updating health for %s: %s", peerId, err)
+	}
+}
+
+// handleErr marks failed or adds an attempt to a message handling error
+func (q *BlockOutbox) handleErr(herr error, msg pb.BlockMessage) {
+	log.Warningf("error handling block message %s: %s", msg.Id, herr)
+
+	var err error
+	if msg.Attempts+1 >= blockOutMaxAttempts {
+		err = q.datastore.BlockMessages().Fail(msg.Id, herr.Error())
+		if err == nil {
+			err = q.sendNotification(queueFailedNotification(
+				q.node().Identity.Pretty(), pb.QueueItem_BLOCK_OUTBOX, msg.Id, msg.Peer, msg.Attempts+1, herr.Error()))
 		}
+	} else {
+		err = q.datastore.BlockMessages().AddAttempt(msg.Id, herr.Error())
+	}
+	if err != nil {
+		log.Errorf("error updating block message %s: %s", msg.Id, err)
+	}
+}
+
+// peerDue returns whether or not a peer's next scheduled attempt has arrived
+func peerDue(health *pb.PeerHealth) bool {
+	if health == nil || health.NextAttempt == nil {
+		return true
+	}
+	return !time.Now().Before(util.ProtoTime(health.NextAttempt))
+}
+
+// peerBackoff returns the delay before the next attempt to reach a peer which
+// has failed the given number of times in a row. The delay doubles w/ each failure
+// up to peerBackoffMax, and is jittered between half and all of that value so
+// retries of peers that went down together are spread out.
+func peerBackoff(failures int32) time.Duration {
+	d := peerBackoffBase
+	for i := int32(1); i < failures && d < peerBackoffMax; i++ {
+		d *= 2
+	}
+	if d > peerBackoffMax {
+		d = peerBackoffMax
 	}
-	return nil
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// deferred returns whether or not a handling error means a message should
+// simply stay queued, rather than count as a failed attempt
+func deferred(err error) bool {
+	return err == errPeerNotOnLAN || err == errPeerBackoff
 }
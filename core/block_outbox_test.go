@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+func TestBlockOutbox_peerBackoff(t *testing.T) {
+	for failures := int32(1); failures < 64; failures++ {
+		d := peerBackoff(failures)
+		max := peerBackoffBase << uint(failures-1)
+		if failures > 16 || max > peerBackoffMax {
+			max = peerBackoffMax
+		}
+		if d < max/2 || d > max {
+			t.Fatalf("backoff for %d failures should be between %s and %s, got %s", failures, max/2, max, d)
+		}
+	}
+}
+
+func TestBlockOutbox_peerDue(t *testing.T) {
+	if !peerDue(nil) {
+		t.Fatal("peer w/o failures should be due")
+	}
+	if peerDue(&pb.PeerHealth{NextAttempt: util.ProtoTs(time.Now().Add(time.Minute).UnixNano())}) {
+		t.Fatal("peer should not be due before its next attempt")
+	}
+	if !peerDue(&pb.PeerHealth{NextAttempt: util.ProtoTs(time.Now().Add(-time.Minute).UnixNano())}) {
+		t.Fatal("peer should be due after its next attempt")
+	}
+}
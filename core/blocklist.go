@@ -0,0 +1,106 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// ErrAccountBlocked indicates an interaction with a blocked account
+var ErrAccountBlocked = fmt.Errorf("account is blocked")
+
+// BlockAccount adds an account address to the blocklist
+// Pending invites and notifications from the account are removed.
+func (t *Textile) BlockAccount(address string) error {
+	if address == t.account.Address() {
+		return fmt.Errorf("cannot block own account")
+	}
+	kp, err := keypair.Parse(address)
+	if err != nil {
+		return err
+	}
+	if _, ok := kp.(*keypair.FromAddress); !ok {
+		return keypair.ErrInvalidKey
+	}
+
+	err = t.datastore.BlockedAccounts().Add(&pb.BlockedAccount{
+		Address: address,
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, invite := range t.datastore.Invites().List().Items {
+		if invite.Inviter != nil && invite.Inviter.Address == address {
+			err = t.datastore.Invites().Delete(invite.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, p := range t.datastore.Peers().List(fmt.Sprintf("address='%s'", address)) {
+		err = t.datastore.Notifications().DeleteByActor(p.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnblockAccount removes an account address from the blocklist
+func (t *Textile) UnblockAccount(address string) error {
+	if t.datastore.BlockedAccounts().Get(address) == nil {
+		return fmt.Errorf("account is not blocked")
+	}
+
+	return t.datastore.BlockedAccounts().Delete(address)
+}
+
+// BlockedAccounts lists the blocklist
+func (t *Textile) BlockedAccounts() *pb.BlockedAccountList {
+	return t.datastore.BlockedAccounts().List()
+}
+
+// IsBlocked returns whether or not an account address is blocked
+func (t *Textile) IsBlocked(address string) bool {
+	return accountBlocked(t.datastore, address)
+}
+
+// PublishBlocklist publishes the blocklist to account peers via the account thread
+func (t *Textile) PublishBlocklist() (mh.Multihash, error) {
+	thrd := t.AccountThread()
+	if thrd == nil {
+		return nil, fmt.Errorf("account thread not found")
+	}
+
+	var addresses []string
+	for _, a := range t.BlockedAccounts().Items {
+		addresses = append(addresses, a.Address)
+	}
+
+	return thrd.AddBlocklist(addresses)
+}
+
+// accountBlocked returns whether or not an account address is blocked
+func accountBlocked(datastore repo.Datastore, address string) bool {
+	if address == "" {
+		return false
+	}
+	return datastore.BlockedAccounts().Get(address) != nil
+}
+
+// peerBlocked returns whether or not a peer belongs to a blocked account
+func peerBlocked(datastore repo.Datastore, peerId string) bool {
+	p := datastore.Peers().Get(peerId)
+	if p == nil {
+		return false
+	}
+	return accountBlocked(datastore, p.Address)
+}
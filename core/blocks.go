@@ -9,15 +9,18 @@ import (
 // ErrBlockNotFound indicates a block was not found in the index
 var ErrBlockNotFound = fmt.Errorf("block not found")
 
-// GetBlocks paginates blocks
-func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
-	filtered := &pb.BlockList{Items: make([]*pb.Block, 0)}
+// visibleAuthorsQuery excludes blocks authored by peers of blocked accounts
+const visibleAuthorsQuery = "authorId not in (select id from peers where address in (select address from blocked_accounts))"
 
-	for _, block := range t.datastore.Blocks().List(offset, limit, query).Items {
-		filtered.Items = append(filtered.Items, block)
+// Blocks paginates blocks, excluding those authored by blocked accounts
+func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
+	// exclude in the query so the limit applies to visible blocks
+	if query != "" {
+		query = "(" + query + ") and "
 	}
+	query += visibleAuthorsQuery
 
-	return filtered
+	return t.datastore.Blocks().List(offset, limit, query)
 }
 
 // Block returns block with id
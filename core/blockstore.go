@@ -0,0 +1,121 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/s3ds"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/mount"
+	"github.com/ipfs/go-datastore/namespace"
+	bstore "github.com/ipfs/go-ipfs-blockstore"
+	"github.com/ipfs/go-ipfs/core/corerepo"
+	"github.com/ipfs/go-ipfs/repo"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+// defaultRemoteGCPeriod is used when the configured s3 gc period is missing or invalid
+const defaultRemoteGCPeriod = time.Hour * 24
+
+// ErrS3BlockstoreProfile indicates an s3 blockstore was configured for a peer which isn't a server or cafe
+var ErrS3BlockstoreProfile = fmt.Errorf("an s3 blockstore requires a server or open cafe")
+
+// s3Repo is an ipfs repo with its blocks in S3-compatible object storage.
+// The repo's own blockstore caches recently used blocks.
+type s3Repo struct {
+	repo.Repo
+	ds repo.Datastore
+}
+
+// Datastore returns the repo datastore with blocks mounted from s3
+func (r *s3Repo) Datastore() repo.Datastore {
+	return r.ds
+}
+
+// remoteBlockstore returns whether blocks are stored in s3
+func (t *Textile) remoteBlockstore() bool {
+	return t.config.Blockstore.S3.Bucket != ""
+}
+
+// openRepo opens the ipfs repo, backing its blockstore with s3 when configured
+func (t *Textile) openRepo() (repo.Repo, error) {
+	rep, err := fsrepo.Open(t.repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if !t.remoteBlockstore() {
+		return rep, nil
+	}
+	if !t.Server() && !t.config.Cafe.Host.Open {
+		_ = rep.Close()
+		return nil, ErrS3BlockstoreProfile
+	}
+
+	conf := t.config.Blockstore.S3
+	remote, err := s3ds.NewS3Datastore(s3ds.Config{
+		Bucket:    conf.Bucket,
+		Prefix:    conf.Prefix,
+		Region:    conf.Region,
+		Endpoint:  conf.Endpoint,
+		AccessKey: conf.AccessKey,
+		SecretKey: conf.SecretKey,
+	})
+	if err != nil {
+		_ = rep.Close()
+		return nil, err
+	}
+	blocks, err := s3ds.NewCache(remote, namespace.Wrap(rep.Datastore(), bstore.BlockPrefix), conf.CacheBlocks)
+	if err != nil {
+		_ = rep.Close()
+		return nil, err
+	}
+	log.Infof("storing blocks in s3 bucket %s", conf.Bucket)
+
+	return &s3Repo{
+		Repo: rep,
+		ds: mount.New([]mount.Mount{
+			{Prefix: bstore.BlockPrefix, Datastore: blocks},
+			{Prefix: ds.NewKey("/"), Datastore: rep.Datastore()},
+		}),
+	}, nil
+}
+
+// runRemoteGC periodically removes unpinned blocks from s3. Unlike local GC, it doesn't
+// wait for a storage watermark, which would only measure the local cache.
+func (t *Textile) runRemoteGC() {
+	period, err := time.ParseDuration(t.config.Blockstore.S3.GCPeriod)
+	if err != nil {
+		log.Warningf("invalid s3 gc period %s, using %s", t.config.Blockstore.S3.GCPeriod, defaultRemoteGCPeriod)
+		period = defaultRemoteGCPeriod
+	}
+	if period == 0 {
+		return
+	}
+
+	go func() {
+		tick := time.NewTicker(period)
+		defer tick.Stop()
+		for {
+			select {
+			case <-t.node.Context().Done():
+				log.Debug("s3 blockstore GC shutdown")
+				return
+			case <-tick.C:
+				t.collectRemoteGarbage(t.node.Context())
+			}
+		}
+	}()
+}
+
+// collectRemoteGarbage runs a full gc, which lists the bucket
+func (t *Textile) collectRemoteGarbage(ctx context.Context) {
+	log.Info("starting s3 blockstore GC...")
+	start := time.Now()
+	err := corerepo.GarbageCollect(t.node, ctx)
+	if err != nil {
+		log.Errorf("error running s3 blockstore gc: %s", err)
+		return
+	}
+	log.Infof("s3 blockstore GC done in %s", time.Since(start))
+}
@@ -0,0 +1,254 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/jwt"
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// cafeAdminActor is the audit actor of actions taken via the cafe admin api
+const cafeAdminActor = "cafe-admin"
+
+// ErrCafeClientNotFound indicates a cafe client does not exist
+var ErrCafeClientNotFound = fmt.Errorf("cafe client not found")
+
+// ErrCafeTokenNotFound indicates a cafe token does not exist
+var ErrCafeTokenNotFound = fmt.Errorf("cafe token not found")
+
+// ErrCafeBanNotFound indicates an account is not banned
+var ErrCafeBanNotFound = fmt.Errorf("cafe ban not found")
+
+// ValidateCafeAdminToken checks a token against the configured admin token hash
+func (t *Textile) ValidateCafeAdminToken(token string) bool {
+	hash := t.config.Cafe.Host.AdminToken
+	if hash == "" || token == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(token)) == nil
+}
+
+// CafeClients lists clients registered with this cafe, most recently seen first.
+// A non-empty query matches part of a client's peer id or account address.
+func (t *Textile) CafeClients(query string, limit int) *pb.CafeClientList {
+	var clients []pb.CafeClient
+	if query != "" {
+		clients = t.datastore.CafeClients().Search(query, limit)
+	} else {
+		clients = t.datastore.CafeClients().List()
+		if limit > 0 && len(clients) > limit {
+			clients = clients[:limit]
+		}
+	}
+
+	list := &pb.CafeClientList{Items: make([]*pb.CafeClient, 0)}
+	for i := range clients {
+		list.Items = append(list.Items, &clients[i])
+	}
+	return list
+}
+
+// CafeClient returns a client with its stored threads and up to limit waiting messages
+func (t *Textile) CafeClient(id string, limit int) (*pb.CafeClientInfo, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+
+	info := &pb.CafeClientInfo{
+		Client:       client,
+		Threads:      make([]string, 0),
+		Messages:     make([]*pb.CafeClientMessage, 0),
+		MessageCount: int32(t.datastore.CafeClientMessages().CountByClient(id)),
+	}
+	for _, thrd := range t.datastore.CafeClientThreads().ListByClient(id) {
+		info.Threads = append(info.Threads, thrd.Id)
+	}
+	msgs := t.datastore.CafeClientMessages().ListByClient(id, limit)
+	for i := range msgs {
+		info.Messages = append(info.Messages, &msgs[i])
+	}
+	return info, nil
+}
+
+// ExpireCafeClientSessions invalidates all sessions issued to a client, here and at neighbors.
+// The client has to register again with a valid token.
+func (t *Textile) ExpireCafeClientSessions(id string) error {
+	err := t.expireCafeClientSessions(id)
+	t.auditAs(cafeAdminActor, AuditCafeClientExpire, id, err)
+	return err
+}
+
+// expireCafeClientSessions sets the client's session expiry to now
+func (t *Textile) expireCafeClientSessions(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+	now := time.Now()
+	err := t.datastore.CafeClients().Expire(id, now)
+	if err != nil {
+		return err
+	}
+	t.cafe.replicateExpireClient(id, now)
+	return nil
+}
+
+// BanCafeAccount stops an account from registering and expires the sessions
+// of all its clients. Neighbors mirror the ban.
+func (t *Textile) BanCafeAccount(address string, reason string) error {
+	err := t.banCafeAccount(address, reason)
+	t.auditAs(cafeAdminActor, AuditCafeAccountBan, address, err)
+	return err
+}
+
+// banCafeAccount adds a ban and expires the account's clients
+func (t *Textile) banCafeAccount(address string, reason string) error {
+	kp, err := keypair.Parse(address)
+	if err != nil {
+		return err
+	}
+	if _, ok := kp.(*keypair.FromAddress); !ok {
+		return fmt.Errorf(errInvalidAddress)
+	}
+
+	ban := &pb.CafeBan{
+		Address: address,
+		Reason:  reason,
+		Date:    ptypes.TimestampNow(),
+	}
+	err = t.cafe.addBan(ban)
+	if err != nil {
+		return err
+	}
+	t.cafe.replicateBan(ban)
+	return nil
+}
+
+// UnbanCafeAccount lifts a ban, here and at neighbors
+func (t *Textile) UnbanCafeAccount(address string) error {
+	err := t.unbanCafeAccount(address)
+	t.auditAs(cafeAdminActor, AuditCafeAccountUnban, address, err)
+	return err
+}
+
+// unbanCafeAccount deletes a ban
+func (t *Textile) unbanCafeAccount(address string) error {
+	if t.datastore.CafeBans().Get(address) == nil {
+		return ErrCafeBanNotFound
+	}
+	err := t.datastore.CafeBans().Delete(address)
+	if err != nil {
+		return err
+	}
+	t.cafe.replicateUnban(address)
+	return nil
+}
+
+// CafeBans lists banned accounts
+func (t *Textile) CafeBans() *pb.CafeBanList {
+	return t.datastore.CafeBans().List()
+}
+
+// CafeTokenList lists stored cafe tokens by id. Token hashes are omitted.
+func (t *Textile) CafeTokenList() *pb.CafeTokenList {
+	list := &pb.CafeTokenList{Items: make([]*pb.CafeToken, 0)}
+	for _, token := range t.datastore.CafeTokens().List() {
+		token := token
+		token.Value = nil
+		list.Items = append(list.Items, &token)
+	}
+	return list
+}
+
+// RevokeCafeToken deletes a cafe token by id, so it can no longer be used to register,
+// and applies cascade to the clients it admitted
+func (t *Textile) RevokeCafeToken(id string, cascade CafeTokenCascade) error {
+	err := t.revokeCafeToken(id, cascade)
+	t.auditAs(cafeAdminActor, AuditCafeTokenRevoke, id, err)
+	return err
+}
+
+// revokeCafeToken deletes a token by id
+func (t *Textile) revokeCafeToken(id string, cascade CafeTokenCascade) error {
+	if t.datastore.CafeTokens().Get(id) == nil {
+		return ErrCafeTokenNotFound
+	}
+	err := t.datastore.CafeTokens().Delete(id)
+	if err != nil {
+		return err
+	}
+	return t.cascadeCafeToken(id, cascade)
+}
+
+// PurgeCafeClient deletes a client along with its thread snapshots and messages,
+// and unpins the message envelopes and any objects no other client stores.
+func (t *Textile) PurgeCafeClient(id string) error {
+	err := t.purgeCafeClient(id)
+	t.auditAs(cafeAdminActor, AuditCafeClientPurge, id, err)
+	return err
+}
+
+// purgeCafeClient unpins messages and deletes the client
+func (t *Textile) purgeCafeClient(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+
+	for _, msg := range t.datastore.CafeClientMessages().ListByClient(id, -1) {
+		dec, err := icid.Decode(msg.Id)
+		if err != nil {
+			return err
+		}
+		err = ipfs.UnpinCid(t.node, dec, true)
+		if err != nil {
+			log.Warningf("error unpinning message %s: %s", msg.Id, err)
+		}
+	}
+
+	err := t.cafe.deleteClient(id)
+	if err != nil {
+		return err
+	}
+	t.cafe.replicateDeleteClient(id)
+	return nil
+}
+
+// sessionExpired returns whether a session was issued before its client's sessions
+// were expired
+func (h *CafeService) sessionExpired(claims *jwt.TextileClaims) bool {
+	client := h.datastore.CafeClients().Get(claims.Subject)
+	if client == nil || client.Expired == nil {
+		return false
+	}
+	return claims.IssuedAt <= client.Expired.Seconds
+}
+
+// addBan adds a ban and expires the sessions the account's clients were issued before it
+func (h *CafeService) addBan(ban *pb.CafeBan) error {
+	date, err := ptypes.Timestamp(ban.Date)
+	if err != nil {
+		return err
+	}
+	err = h.datastore.CafeBans().Add(ban)
+	if err != nil {
+		return err
+	}
+	for _, client := range h.datastore.CafeClients().ListByAddress(ban.Address) {
+		err = h.datastore.CafeClients().Expire(client.Id, date)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// banned returns whether an account is banned from this cafe
+func (h *CafeService) banned(address string) bool {
+	return h.datastore.CafeBans().Get(address) != nil
+}
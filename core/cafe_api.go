@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/b582q9/go-textile-sapien/jwt"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	"github.com/b582q9/go-textile-sapien/pb"
 	njwt "github.com/dgrijalva/jwt-go"
 	limit "github.com/gin-contrib/size"
@@ -71,6 +72,7 @@ func (t *Textile) stopCafeApi() error {
 // start starts the cafe api
 func (c *cafeApi) start() {
 	router := gin.Default()
+	router.Use(metrics.Gin("cafe_api"))
 	router.GET("/", func(g *gin.Context) {
 		g.JSON(http.StatusOK, c.node.CafeInfo())
 	})
@@ -128,6 +130,23 @@ func (c *cafeApi) start() {
 		search.POST("", c.search)
 	}
 
+	// operator routes, only served when an admin token is configured
+	if conf.Cafe.Host.AdminToken != "" {
+		admin := v1.Group("/admin", c.validateAdminToken)
+		{
+			admin.GET("/clients", c.adminListClients)
+			admin.GET("/clients/:id", c.adminGetClient)
+			admin.POST("/clients/:id/expire", c.adminExpireClient)
+			admin.DELETE("/clients/:id", c.adminPurgeClient)
+			admin.GET("/bans", c.adminListBans)
+			admin.PUT("/bans/:address", c.adminBanAccount)
+			admin.DELETE("/bans/:address", c.adminUnbanAccount)
+			admin.GET("/tokens", c.adminListTokens)
+			admin.GET("/tokens/usage", c.adminTokenUsage)
+			admin.DELETE("/tokens/:id", c.adminRevokeToken)
+		}
+	}
+
 	// Enables bots on cafes
 	bots := v1.Group("/bots")
 	{
@@ -142,10 +161,19 @@ func (c *cafeApi) start() {
 		Handler: router,
 	}
 
+	secure := c.node.tls != nil && c.node.config.TLS.CafeAPI
+	if secure {
+		c.server.TLSConfig = c.node.tls.TLSConfig()
+	}
+
 	// start listening
 	errc := make(chan error)
 	go func() {
-		errc <- c.server.ListenAndServe()
+		if secure {
+			errc <- c.server.ListenAndServeTLS("", "")
+		} else {
+			errc <- c.server.ListenAndServe()
+		}
 		close(errc)
 	}()
 	go func() {
@@ -207,6 +235,15 @@ func (c *cafeApi) validateToken(g *gin.Context) {
 		}
 		return
 	}
+	if c.node.cafe.sessionExpired(claims) {
+		log.Warning("session was expired")
+		if refreshing {
+			c.abort(g, http.StatusForbidden, nil)
+		} else {
+			c.abort(g, http.StatusUnauthorized, nil)
+		}
+		return
+	}
 
 	g.Set("from", claims.Subject)
 	g.Set("token", token)
@@ -250,7 +287,7 @@ func (c *cafeApi) validateChallengeToken(g *gin.Context) {
 
 // verifyKeyFunc returns the correct key for token verification
 func (c *cafeApi) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
-	return c.node.Ipfs().PrivateKey.GetPublic(), nil
+	return c.node.cafe.verifyKeyFunc(token)
 }
 
 // CafeError represents a cafe request error
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultAdminListLimit bounds client and message listings unless a limit is given
+const defaultAdminListLimit = 100
+
+// validateAdminToken aborts the request unless it carries the admin token
+func (c *cafeApi) validateAdminToken(g *gin.Context) {
+	auth := strings.Split(g.Request.Header.Get("Authorization"), " ")
+	if len(auth) < 2 {
+		log.Warning("missing admin token")
+		c.abort(g, http.StatusUnauthorized, nil)
+		return
+	}
+	if !c.node.ValidateCafeAdminToken(auth[1]) {
+		log.Warning("invalid admin token")
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+}
+
+// GET /admin/clients?q=<query>&limit=<limit> (header=>admin token)
+func (c *cafeApi) adminListClients(g *gin.Context) {
+	limit, err := adminLimit(g)
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	pbJSON(g, http.StatusOK, c.node.CafeClients(g.Query("q"), limit))
+}
+
+// GET /admin/clients/:id?limit=<limit> (header=>admin token)
+func (c *cafeApi) adminGetClient(g *gin.Context) {
+	limit, err := adminLimit(g)
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	info, err := c.node.CafeClient(g.Param("id"), limit)
+	if err != nil {
+		c.abortAdmin(g, err)
+		return
+	}
+	pbJSON(g, http.StatusOK, info)
+}
+
+// POST /admin/clients/:id/expire (header=>admin token)
+func (c *cafeApi) adminExpireClient(g *gin.Context) {
+	if err := c.node.ExpireCafeClientSessions(g.Param("id")); err != nil {
+		c.abortAdmin(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// DELETE /admin/clients/:id (header=>admin token)
+func (c *cafeApi) adminPurgeClient(g *gin.Context) {
+	if err := c.node.PurgeCafeClient(g.Param("id")); err != nil {
+		c.abortAdmin(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// GET /admin/bans (header=>admin token)
+func (c *cafeApi) adminListBans(g *gin.Context) {
+	pbJSON(g, http.StatusOK, c.node.CafeBans())
+}
+
+// PUT /admin/bans/:address?reason=<reason> (header=>admin token)
+func (c *cafeApi) adminBanAccount(g *gin.Context) {
+	if err := c.node.BanCafeAccount(g.Param("address"), g.Query("reason")); err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// DELETE /admin/bans/:address (header=>admin token)
+func (c *cafeApi) adminUnbanAccount(g *gin.Context) {
+	if err := c.node.UnbanCafeAccount(g.Param("address")); err != nil {
+		c.abortAdmin(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// GET /admin/tokens (header=>admin token)
+func (c *cafeApi) adminListTokens(g *gin.Context) {
+	pbJSON(g, http.StatusOK, c.node.CafeTokenList())
+}
+
+// GET /admin/tokens/usage (header=>admin token)
+func (c *cafeApi) adminTokenUsage(g *gin.Context) {
+	pbJSON(g, http.StatusOK, c.node.CafeTokenUsages())
+}
+
+// DELETE /admin/tokens/:id?cascade=<expire|purge> (header=>admin token)
+func (c *cafeApi) adminRevokeToken(g *gin.Context) {
+	cascade, err := ParseCafeTokenCascade(g.Query("cascade"))
+	if err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
+	if err := c.node.RevokeCafeToken(g.Param("id"), cascade); err != nil {
+		c.abortAdmin(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// abortAdmin aborts with not found for missing records, otherwise with a server error
+func (c *cafeApi) abortAdmin(g *gin.Context, err error) {
+	switch err {
+	case ErrCafeClientNotFound, ErrCafeTokenNotFound, ErrCafeBanNotFound:
+		c.abort(g, http.StatusNotFound, err)
+	default:
+		log.Warning(err)
+		c.abort(g, http.StatusInternalServerError, err)
+	}
+}
+
+// adminLimit parses the limit query param
+func adminLimit(g *gin.Context) (int, error) {
+	limit := g.Query("limit")
+	if limit == "" {
+		return defaultAdminListLimit, nil
+	}
+	return strconv.Atoi(limit)
+}
@@ -21,8 +21,16 @@ import (
 // pin take raw data or a tarball and pins it to the local ipfs node.
 // request must be authenticated with a token
 func (c *cafeApi) pin(g *gin.Context) {
-	// handle based on content type
+	// reject requests which are known to be too large before reading them
+	client := c.node.datastore.CafeClients().Get(g.GetString("from"))
+	if client != nil && !c.node.cafe.allowStore(client, g.Request.ContentLength) {
+		c.abort(g, http.StatusForbidden, fmt.Errorf(errQuotaExceeded))
+		return
+	}
+
+	// handle based on content type, content is pinned once it's known to fit the quota
 	var id cid.Cid
+	var recursive bool
 	cType := g.Request.Header.Get("Content-Type")
 	switch cType {
 	case "application/gzip":
@@ -60,21 +68,22 @@ func (c *cafeApi) pin(g *gin.Context) {
 			}
 		}
 
-		// pin the directory
+		// add the directory
 		dir, err := dirb.GetNode()
 		if err != nil {
 			c.abort(g, http.StatusInternalServerError, err)
 			return
 		}
-		err = ipfs.PinNode(c.node.Ipfs(), dir, true)
+		err = c.node.Ipfs().DAG.Add(g.Request.Context(), dir)
 		if err != nil {
 			c.abort(g, http.StatusInternalServerError, err)
 			return
 		}
 		id = dir.Cid()
+		recursive = true
 
 	case "application/octet-stream":
-		idp, err := ipfs.AddData(c.node.Ipfs(), g.Request.Body, true, false)
+		idp, err := ipfs.AddData(c.node.Ipfs(), g.Request.Body, false, false)
 		if err != nil {
 			c.abort(g, http.StatusInternalServerError, err)
 			return
@@ -86,6 +95,22 @@ func (c *cafeApi) pin(g *gin.Context) {
 	}
 	hash := id.Hash().B58String()
 
+	from := g.GetString("from")
+	size, err := c.node.cafe.clientObjectSize(from, id)
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+	if client != nil && !c.node.cafe.allowStore(client, size) {
+		c.abort(g, http.StatusForbidden, fmt.Errorf(errQuotaExceeded))
+		return
+	}
+	err = c.node.cafe.storeObject(from, id, recursive, size)
+	if err != nil {
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+
 	log.Debugf("pinned request with content type %s: %s", cType, hash)
 
 	g.JSON(http.StatusCreated, gin.H{"id": hash})
@@ -19,7 +19,6 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/ipfs/go-cid"
-	"github.com/ipfs/go-ipfs/pin"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/segmentio/ksuid"
 )
@@ -39,6 +38,18 @@ func (c *cafeApi) getSessionChallenge(g *gin.Context) {
 		c.abort(g, http.StatusBadRequest, fmt.Errorf(errInvalidAddress))
 		return
 	}
+	if c.node.cafe.banned(accnt.Address()) {
+		log.Warning("account is banned")
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+	ip := c.node.cafe.requestIP(g.Request)
+	if !c.node.cafe.allowRegistration("", ip) {
+		log.Warningf("too many challenges from %s", ip)
+		c.abort(g, http.StatusTooManyRequests, fmt.Errorf(errTooManyRequests))
+		return
+	}
+	c.node.cafe.expireNonces()
 
 	// generate a new random nonce
 	nonce := &pb.CafeClientNonce{
@@ -79,6 +90,12 @@ func (c *cafeApi) createSession(g *gin.Context) {
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
+	// the ip was charged for the challenge, which a session needs
+	if !c.node.cafe.allowRegistration(pid.Pretty(), "") {
+		log.Warningf("too many registrations from %s", pid.Pretty())
+		c.abort(g, http.StatusTooManyRequests, fmt.Errorf(errTooManyRequests))
+		return
+	}
 
 	// check nonce
 	snonce := c.node.datastore.CafeClientNonces().Get(g.Query("challenge"))
@@ -87,6 +104,12 @@ func (c *cafeApi) createSession(g *gin.Context) {
 		c.abort(g, http.StatusForbidden, nil)
 		return
 	}
+	if c.node.cafe.nonceExpired(snonce) {
+		log.Warning("challenge expired")
+		_ = c.node.datastore.CafeClientNonces().Delete(snonce.Value)
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
 	if snonce.Address != accnt.Address() {
 		log.Warning("invalid address")
 		c.abort(g, http.StatusForbidden, nil)
@@ -115,25 +138,23 @@ func (c *cafeApi) createSession(g *gin.Context) {
 		c.abort(g, http.StatusForbidden, nil)
 		return
 	}
-
-	now := ptypes.TimestampNow()
-	client := &pb.CafeClient{
-		Id:      pid.Pretty(),
-		Address: accnt.Address(),
-		Created: now,
-		Seen:    now,
-		Token:   g.GetString("token"),
+	if c.node.cafe.banned(accnt.Address()) {
+		log.Warning("account is banned")
+		c.abort(g, http.StatusForbidden, nil)
+		return
 	}
-	err = c.node.datastore.CafeClients().Add(client)
+	token := c.node.datastore.CafeTokens().Get(g.GetString("token"))
+	if token == nil || !c.node.cafe.tokenAdmits(token, pid.Pretty()) {
+		log.Warning("token expired or has no registrations left")
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+
+	_, err = c.node.cafe.registerClient(pid.Pretty(), accnt.Address(), token)
 	if err != nil {
-		// check if already exists
-		client = c.node.datastore.CafeClients().Get(pid.Pretty())
-		if client == nil {
-			err = fmt.Errorf("get or create client failed")
-			log.Warning(err)
-			c.abort(g, http.StatusInternalServerError, err)
-			return
-		}
+		log.Warning(err)
+		c.abort(g, http.StatusInternalServerError, err)
+		return
 	}
 
 	session, err := jwt.NewSession(
@@ -244,26 +265,13 @@ func (c *cafeApi) refreshSession(g *gin.Context) {
 // DELETE /sessions/:pid (header=>access)
 func (c *cafeApi) deleteSession(g *gin.Context) {
 	pid := g.GetString("from")
-	err := c.node.datastore.CafeClientThreads().DeleteByClient(pid)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusInternalServerError, err)
-		return
-	}
-
-	err = c.node.datastore.CafeClientMessages().DeleteByClient(pid, -1)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusInternalServerError, err)
-		return
-	}
-
-	err = c.node.datastore.CafeClients().Delete(pid)
+	err := c.node.cafe.deleteClient(pid)
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateDeleteClient(pid)
 
 	g.Status(http.StatusNoContent)
 }
@@ -272,6 +280,13 @@ func (c *cafeApi) store(g *gin.Context) {
 	var err error
 	var aid *cid.Cid
 
+	client := c.node.datastore.CafeClients().Get(g.GetString("from"))
+	if client == nil {
+		log.Warning("client not found")
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
+
 	form, err := g.MultipartForm()
 	if err != nil {
 		log.Warning(err)
@@ -280,6 +295,7 @@ func (c *cafeApi) store(g *gin.Context) {
 	}
 	files := form.File["file"]
 
+	var size int64
 	var f multipart.File
 	defer func() {
 		if f != nil {
@@ -294,10 +310,10 @@ func (c *cafeApi) store(g *gin.Context) {
 			return
 		}
 
-		aid, err = ipfs.AddObject(c.node.Ipfs(), f, true)
+		aid, err = ipfs.AddObject(c.node.Ipfs(), f, false)
 		if err != nil {
 			_, _ = f.Seek(0, 0)
-			aid, err = ipfs.AddData(c.node.Ipfs(), f, true, false)
+			aid, err = ipfs.AddData(c.node.Ipfs(), f, false, false)
 		}
 		if err != nil {
 			log.Warning(err)
@@ -305,7 +321,27 @@ func (c *cafeApi) store(g *gin.Context) {
 			return
 		}
 
+		// objects the client already stores don't count toward the quota again
+		size, err = c.node.cafe.clientObjectSize(client.Id, *aid)
+		if err != nil {
+			log.Warning(err)
+			c.abort(g, http.StatusInternalServerError, err)
+			return
+		}
+		if !c.node.cafe.allowStore(client, size) {
+			c.abort(g, http.StatusForbidden, fmt.Errorf(errQuotaExceeded))
+			return
+		}
+		err = c.node.cafe.storeObject(client.Id, *aid, false, size)
+		if err != nil {
+			log.Warning(err)
+			c.abort(g, http.StatusInternalServerError, err)
+			return
+		}
+
 		log.Debugf("stored %s", aid.Hash().B58String())
+		client.Stored += size
+		c.node.cafe.replicateObjects(client.Id, []cid.Cid{*aid})
 
 		f.Close()
 		f = nil
@@ -322,25 +358,19 @@ func (c *cafeApi) unstore(g *gin.Context) {
 		return
 	}
 
-	pinned, err := c.node.Ipfs().Pinning.CheckIfPinned(id)
+	// objects stored by other clients stay pinned
+	from := g.GetString("from")
+	unstored, err := c.node.cafe.unstoreObjects(from, []string{id.String()})
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
 
-	for _, p := range pinned {
-		if p.Mode != pin.NotPinned {
-			err = ipfs.UnpinCid(c.node.Ipfs(), p.Key, true)
-			if err != nil {
-				log.Warning(err)
-				c.abort(g, http.StatusBadRequest, err)
-				return
-			}
-
-			log.Debugf("unstored %s", p.Key.Hash().B58String())
-		}
+	for _, hash := range unstored {
+		log.Debugf("unstored %s", hash)
 	}
+	c.node.cafe.replicateUnstore(from, unstored)
 
 	g.Status(http.StatusNoContent)
 }
@@ -355,6 +385,10 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		c.abort(g, http.StatusForbidden, nil)
 		return
 	}
+	if !c.node.cafe.allowThread(client, id) {
+		c.abort(g, http.StatusForbidden, fmt.Errorf(errQuotaExceeded))
+		return
+	}
 
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
@@ -370,16 +404,18 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		return
 	}
 
-	err = c.node.datastore.CafeClientThreads().AddOrUpdate(&pb.CafeClientThread{
+	thrd := &pb.CafeClientThread{
 		Id:         id,
 		Client:     client.Id,
-		Ciphertext: buf.Bytes(),
-	})
+		Ciphertext: append([]byte(nil), buf.Bytes()...),
+	}
+	err = c.node.datastore.CafeClientThreads().AddOrUpdate(thrd)
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateThread(thrd)
 
 	log.Debugf("stored thread %s", id)
 
@@ -403,6 +439,7 @@ func (c *cafeApi) unstoreThread(g *gin.Context) {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateDeleteThread(id, client.Id)
 
 	log.Debugf("unstored thread %s", id)
 
@@ -448,12 +485,14 @@ func (c *cafeApi) deleteMessages(g *gin.Context) {
 	}
 
 	// delete the most recent page
+	page := c.node.datastore.CafeClientMessages().ListByClient(client.Id, inboxMessagePageSize)
 	err := c.node.datastore.CafeClientMessages().DeleteByClient(client.Id, inboxMessagePageSize)
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateDeleteMessages(client.Id, page)
 
 	// check for more
 	remaining := c.node.datastore.CafeClientMessages().CountByClient(client.Id)
@@ -472,6 +511,11 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		g.Status(http.StatusOK)
 		return
 	}
+	if !c.node.cafe.allowMessage(client.Id) {
+		log.Warningf("too many messages for client %s", client.Id)
+		c.abort(g, http.StatusTooManyRequests, fmt.Errorf(errTooManyRequests))
+		return
+	}
 
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
@@ -488,68 +532,27 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 	}
 	body := buf.Bytes()
 
-	// pin inner node
-	nenv := new(pb.Envelope)
-	err = proto.Unmarshal(body, nenv)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	tenv := new(pb.ThreadEnvelope)
-	err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	oid, err := ipfs.AddObject(c.node.Ipfs(), bytes.NewReader(tenv.Node), true)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	node, err := ipfs.NodeAtCid(c.node.Ipfs(), *oid)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-	if tenv.Block != nil {
-		_, err = ipfs.AddData(c.node.Ipfs(), bytes.NewReader(tenv.Block), true, false)
-		if err != nil {
-			log.Warning(err)
-			c.abort(g, http.StatusBadRequest, err)
-			return
-		}
-	}
-	_, err = extractNode(c.node.Ipfs(), node, tenv.Block == nil)
-	if err != nil {
-		log.Warning(err)
-		c.abort(g, http.StatusBadRequest, err)
-		return
-	}
-
-	// pin envelope
-	id, err := ipfs.AddData(c.node.Ipfs(), bytes.NewReader(body), true, false)
+	// pin inner node and envelope
+	msgId, err := c.node.cafe.pinMessage(body)
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
 
-	msgId := id.Hash().B58String()
-	err = c.node.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
+	msg := &pb.CafeClientMessage{
 		Id:     msgId,
 		Peer:   from,
 		Client: client.Id,
 		Date:   ptypes.TimestampNow(),
-	})
+	}
+	err = c.node.datastore.CafeClientMessages().AddOrUpdate(msg)
 	if err != nil {
 		log.Warning(err)
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateMessage(msg, append([]byte(nil), body...))
 
 	go func() {
 		err = c.node.cafe.notifyClient(client.Id)
@@ -572,6 +575,11 @@ func (c *cafeApi) search(g *gin.Context) {
 		c.abort(g, http.StatusBadRequest, err)
 		return
 	}
+	if !c.node.cafe.allowQuery(pid.Pretty()) {
+		log.Warningf("too many queries from %s", pid.Pretty())
+		c.abort(g, http.StatusTooManyRequests, fmt.Errorf(errTooManyRequests))
+		return
+	}
 
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
@@ -0,0 +1,587 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/repo/config"
+	"github.com/b582q9/go-textile-sapien/service"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/segmentio/ksuid"
+)
+
+// replicateBackoff is the delay before the first replication retry, doubled after each attempt
+const replicateBackoff = time.Second * 5
+
+// maxReplicateBackoff caps the delay between replication retries
+const maxReplicateBackoff = time.Minute * 10
+
+// setNeighbors loads the neighbor cafes which mirror client data with this cafe.
+// Neighbors given as multiaddrs are added to the peerstore so they can be dialed directly.
+func (h *CafeService) setNeighbors(conf *config.Config) {
+	var neighbors []string
+	for _, n := range conf.Cafe.Host.Neighbors {
+		info, err := parseNeighbor(n)
+		if err != nil {
+			log.Errorf("invalid cafe neighbor %s: %s", n, err)
+			continue
+		}
+		if len(info.Addrs) > 0 {
+			h.service.Node().Peerstore.AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+		}
+		neighbors = append(neighbors, info.ID.Pretty())
+	}
+	h.neighbors = neighbors
+
+	h.replicas = make(map[string]*replicaQueue)
+	for _, n := range neighbors {
+		if _, ok := h.replicas[n]; ok {
+			continue
+		}
+		q := newReplicaQueue(n, h.datastore.CafeReplicas(), h.sendReplicate)
+		h.replicas[n] = q
+		go q.run(h.service.Node().Context().Done())
+	}
+}
+
+// isNeighbor returns whether or not a peer is a configured neighbor cafe.
+// Cafes only exchange client data when both list each other.
+func (h *CafeService) isNeighbor(id string) bool {
+	for _, n := range h.neighbors {
+		if n == id {
+			return true
+		}
+	}
+	return false
+}
+
+// replicate queues a change to client data for each neighbor
+func (h *CafeService) replicate(rep *pb.CafeReplicate) {
+	for _, q := range h.replicas {
+		q.add(rep)
+	}
+}
+
+// replicaQueue sends changes to a neighbor one at a time, in the order they were made,
+// so that a retried change can't land after a later one, e.g., a message after its deletion.
+// Changes are kept in the datastore until delivered, surviving restarts and outages.
+type replicaQueue struct {
+	neighbor string
+	store    repo.CafeReplicaStore
+	send     func(rep *pb.CafeReplicate, neighbor string) error
+	backoff  time.Duration
+	ready    chan struct{}
+}
+
+// newReplicaQueue returns a queue for neighbor, which starts with any changes left in store
+func newReplicaQueue(neighbor string, store repo.CafeReplicaStore, send func(rep *pb.CafeReplicate, neighbor string) error) *replicaQueue {
+	q := &replicaQueue{
+		neighbor: neighbor,
+		store:    store,
+		send:     send,
+		backoff:  replicateBackoff,
+		ready:    make(chan struct{}, 1),
+	}
+	q.ready <- struct{}{}
+	return q
+}
+
+// add appends a change to the queue
+func (q *replicaQueue) add(rep *pb.CafeReplicate) {
+	body, err := proto.Marshal(rep)
+	if err != nil {
+		log.Errorf("error encoding %s replication: %s", rep.Type.String(), err)
+		return
+	}
+	err = q.store.Add(&pb.CafeReplica{
+		Id:       ksuid.New().String(),
+		Neighbor: q.neighbor,
+		Body:     body,
+		Date:     ptypes.TimestampNow(),
+	})
+	if err != nil {
+		log.Errorf("error queueing %s replication to %s: %s", rep.Type.String(), q.neighbor, err)
+		return
+	}
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the oldest change without removing it
+func (q *replicaQueue) next() *pb.CafeReplica {
+	list := q.store.ListByNeighbor(q.neighbor, 1)
+	if len(list) == 0 {
+		return nil
+	}
+	return &list[0]
+}
+
+// run sends queued changes until done is closed
+func (q *replicaQueue) run(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-q.ready:
+		}
+		for item := q.next(); item != nil; item = q.next() {
+			rep := new(pb.CafeReplicate)
+			if err := proto.Unmarshal(item.Body, rep); err != nil {
+				log.Errorf("error decoding replication %s: %s", item.Id, err)
+			} else if !q.deliver(rep, done) {
+				return
+			}
+			if err := q.store.Delete(item.Id); err != nil {
+				log.Errorf("error removing replication %s: %s", item.Id, err)
+				return
+			}
+		}
+	}
+}
+
+// deliver sends a change, retrying with backoff while the neighbor is unreachable.
+// Later changes wait, a change is only skipped once it's rejected.
+// Returns false if done was closed.
+func (q *replicaQueue) deliver(rep *pb.CafeReplicate, done <-chan struct{}) bool {
+	backoff := q.backoff
+	for {
+		err := q.send(rep, q.neighbor)
+		if err == nil {
+			return true
+		}
+		if _, ok := err.(*service.RemoteError); ok {
+			log.Errorf("neighbor %s rejected %s replication: %s", q.neighbor, rep.Type.String(), err)
+			return true
+		}
+		log.Warningf("error replicating %s to %s: %s", rep.Type.String(), q.neighbor, err)
+		select {
+		case <-done:
+			return false
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxReplicateBackoff {
+			backoff = maxReplicateBackoff
+		}
+	}
+}
+
+// sendReplicate sends a replication request to a neighbor
+func (h *CafeService) sendReplicate(rep *pb.CafeReplicate, neighbor string) error {
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_REPLICATE, rep, nil, false)
+	if err != nil {
+		return err
+	}
+	renv, err := h.service.SendRequest(neighbor, env)
+	if err != nil {
+		return err
+	}
+	ack := new(pb.CafeReplicateAck)
+	return ptypes.UnmarshalAny(renv.Message.Payload, ack)
+}
+
+// replicateClient mirrors a client registration
+func (h *CafeService) replicateClient(client *pb.CafeClient) {
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_CLIENT,
+		Client: client,
+	})
+}
+
+// replicateDeleteClient mirrors a client deregistration
+func (h *CafeService) replicateDeleteClient(id string) {
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_DELETE_CLIENT,
+		Client: &pb.CafeClient{Id: id},
+	})
+}
+
+// replicateExpireClient mirrors the expiry of a client's sessions
+func (h *CafeService) replicateExpireClient(id string, date time.Time) {
+	expired, err := ptypes.TimestampProto(date)
+	if err != nil {
+		log.Errorf("error encoding expiry of %s for replication: %s", id, err)
+		return
+	}
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_EXPIRE_CLIENT,
+		Client: &pb.CafeClient{Id: id, Expired: expired},
+	})
+}
+
+// replicateBan mirrors an account ban
+func (h *CafeService) replicateBan(ban *pb.CafeBan) {
+	h.replicate(&pb.CafeReplicate{
+		Type: pb.CafeReplicate_BAN,
+		Ban:  ban,
+	})
+}
+
+// replicateUnban mirrors a lifted account ban
+func (h *CafeService) replicateUnban(address string) {
+	h.replicate(&pb.CafeReplicate{
+		Type: pb.CafeReplicate_UNBAN,
+		Ban:  &pb.CafeBan{Address: address},
+	})
+}
+
+// replicateObjects mirrors objects stored by a client
+func (h *CafeService) replicateObjects(clientId string, ids []icid.Cid) {
+	if len(h.neighbors) == 0 {
+		return
+	}
+	for _, id := range ids {
+		obj, err := h.objectPayload(id)
+		if err != nil {
+			log.Errorf("error reading object %s for replication: %s", id.Hash().B58String(), err)
+			continue
+		}
+		h.replicate(&pb.CafeReplicate{
+			Type:   pb.CafeReplicate_OBJECT,
+			Client: &pb.CafeClient{Id: clientId},
+			Object: obj,
+		})
+	}
+}
+
+// replicateUnstore mirrors objects dropped by a client
+func (h *CafeService) replicateUnstore(clientId string, cids []string) {
+	if len(cids) == 0 {
+		return
+	}
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_UNSTORE,
+		Client: &pb.CafeClient{Id: clientId},
+		Cids:   cids,
+	})
+}
+
+// replicateThread mirrors a client thread snapshot
+func (h *CafeService) replicateThread(thrd *pb.CafeClientThread) {
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_THREAD,
+		Thread: thrd,
+	})
+}
+
+// replicateDeleteThread mirrors a removed client thread snapshot
+func (h *CafeService) replicateDeleteThread(id string, clientId string) {
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_DELETE_THREAD,
+		Thread: &pb.CafeClientThread{Id: id, Client: clientId},
+	})
+}
+
+// replicateMessage mirrors an inbox message and its envelope
+func (h *CafeService) replicateMessage(msg *pb.CafeClientMessage, env []byte) {
+	h.replicate(&pb.CafeReplicate{
+		Type:    pb.CafeReplicate_MESSAGE,
+		Message: msg,
+		Env:     env,
+	})
+}
+
+// replicateDeleteMessages mirrors inbox messages downloaded by a client
+func (h *CafeService) replicateDeleteMessages(clientId string, msgs []pb.CafeClientMessage) {
+	if len(msgs) == 0 {
+		return
+	}
+	var ids []string
+	for _, msg := range msgs {
+		ids = append(ids, msg.Id)
+	}
+	h.replicate(&pb.CafeReplicate{
+		Type:     pb.CafeReplicate_DELETE_MESSAGES,
+		Message:  &pb.CafeClientMessage{Client: clientId},
+		Messages: ids,
+	})
+}
+
+// handleReplicate applies a change to client data from a neighbor
+func (h *CafeService) handleReplicate(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	rep := new(pb.CafeReplicate)
+	err := ptypes.UnmarshalAny(env.Message.Payload, rep)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.isNeighbor(pid.Pretty()) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
+	switch rep.Type {
+	case pb.CafeReplicate_CLIENT:
+		if rep.Client == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		if h.datastore.CafeClients().Get(rep.Client.Id) == nil {
+			err = h.datastore.CafeClients().Add(rep.Client)
+		} else {
+			err = h.datastore.CafeClients().UpdateToken(
+				rep.Client.Id, rep.Client.Token, rep.Client.StorageQuota, rep.Client.ThreadQuota)
+		}
+
+	case pb.CafeReplicate_DELETE_CLIENT:
+		if rep.Client == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		err = h.deleteClient(rep.Client.Id)
+
+	case pb.CafeReplicate_EXPIRE_CLIENT:
+		if rep.Client == nil || rep.Client.Expired == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		if h.datastore.CafeClients().Get(rep.Client.Id) != nil {
+			var date time.Time
+			if date, err = ptypes.Timestamp(rep.Client.Expired); err == nil {
+				err = h.datastore.CafeClients().Expire(rep.Client.Id, date)
+			}
+		}
+
+	case pb.CafeReplicate_BAN:
+		if rep.Ban == nil || rep.Ban.Date == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		err = h.addBan(rep.Ban)
+
+	case pb.CafeReplicate_UNBAN:
+		if rep.Ban == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		err = h.datastore.CafeBans().Delete(rep.Ban.Address)
+
+	case pb.CafeReplicate_OBJECT:
+		if rep.Object == nil || rep.Client == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		var aid *icid.Cid
+		if aid, err = h.addObject(rep.Object); err == nil {
+			var size int64
+			if size, err = h.clientObjectSize(rep.Client.Id, *aid); err == nil {
+				err = h.storeObject(rep.Client.Id, *aid, false, size)
+			}
+		}
+
+	case pb.CafeReplicate_UNSTORE:
+		if rep.Client == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		_, err = h.unstoreObjects(rep.Client.Id, rep.Cids)
+
+	case pb.CafeReplicate_THREAD:
+		if rep.Thread == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		err = h.datastore.CafeClientThreads().AddOrUpdate(rep.Thread)
+
+	case pb.CafeReplicate_DELETE_THREAD:
+		if rep.Thread == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		err = h.datastore.CafeClientThreads().Delete(rep.Thread.Id, rep.Thread.Client)
+
+	case pb.CafeReplicate_MESSAGE:
+		if rep.Message == nil || rep.Env == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		if _, err = h.pinMessage(rep.Env); err == nil {
+			err = h.datastore.CafeClientMessages().AddOrUpdate(rep.Message)
+		}
+
+	case pb.CafeReplicate_DELETE_MESSAGES:
+		if rep.Message == nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		for _, id := range rep.Messages {
+			if err = h.datastore.CafeClientMessages().Delete(id, rep.Message.Client); err != nil {
+				break
+			}
+		}
+
+	default:
+		return h.service.NewError(400, errBadRequest, env.Message.Request)
+	}
+	if err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.Request)
+	}
+	log.Debugf("applied %s replication from %s", rep.Type.String(), pid.Pretty())
+
+	res := &pb.CafeReplicateAck{Type: rep.Type}
+	return h.service.NewEnvelope(pb.Message_CAFE_REPLICATE_ACK, res, &env.Message.Request, true)
+}
+
+// failover sends a request to the neighbors of a session's cafe, which accept
+// the session while the cafe is unreachable
+func (h *CafeService) failover(session *pb.CafeSession, env *pb.Envelope) (*pb.Envelope, error) {
+	if session.Cafe == nil || len(session.Cafe.Neighbors) == 0 {
+		return nil, fmt.Errorf("cafe %s has no neighbors", session.Id)
+	}
+	var err error
+	for _, n := range session.Cafe.Neighbors {
+		var renv *pb.Envelope
+		renv, err = h.service.SendRequest(n, env)
+		if err == nil {
+			log.Infof("cafe %s is unreachable, used neighbor %s", session.Id, n)
+			return renv, nil
+		}
+		if _, ok := err.(*service.RemoteError); ok {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// sessionByNeighbor returns the session of a cafe which lists the given neighbor
+func (h *CafeService) sessionByNeighbor(id string) *pb.CafeSession {
+	for _, session := range h.datastore.CafeSessions().List().Items {
+		if session.Cafe == nil {
+			continue
+		}
+		for _, n := range session.Cafe.Neighbors {
+			if n == id {
+				return session
+			}
+		}
+	}
+	return nil
+}
+
+// objectPayload reads data or an object by cid into a cafe object
+func (h *CafeService) objectPayload(id icid.Cid) (*pb.CafeObject, error) {
+	hash := id.Hash().B58String()
+	obj := &pb.CafeObject{Cid: hash}
+
+	data, err := ipfs.DataAtPath(h.service.Node(), hash)
+	if err != nil {
+		if err == iface.ErrIsDir {
+			data, err := ipfs.ObjectAtPath(h.service.Node(), hash)
+			if err != nil {
+				return nil, err
+			}
+			obj.Node = data
+		} else {
+			return nil, err
+		}
+	} else {
+		obj.Data = data
+	}
+	return obj, nil
+}
+
+// addObject adds the data or object of a cafe object without pinning it.
+// The sender's cid must match the one it resolves to.
+func (h *CafeService) addObject(obj *pb.CafeObject) (*icid.Cid, error) {
+	var aid *icid.Cid
+	var err error
+	if obj.Data != nil {
+		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), false, false)
+	} else if obj.Node != nil {
+		aid, err = ipfs.AddObject(h.service.Node(), bytes.NewReader(obj.Node), false)
+	} else {
+		return nil, fmt.Errorf(errBadRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rhash := aid.Hash().B58String()
+	if rhash != obj.Cid {
+		return nil, fmt.Errorf("cids do not match (received %s, resolved %s)", obj.Cid, rhash)
+	}
+	return aid, nil
+}
+
+// pinMessage pins an inbox message envelope and the thread node it carries,
+// returning the message id
+func (h *CafeService) pinMessage(body []byte) (string, error) {
+	nenv := new(pb.Envelope)
+	err := proto.Unmarshal(body, nenv)
+	if err != nil {
+		return "", err
+	}
+	tenv := new(pb.ThreadEnvelope)
+	err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
+	if err != nil {
+		return "", err
+	}
+
+	// pin inner node
+	oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), true)
+	if err != nil {
+		return "", err
+	}
+	node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
+	if err != nil {
+		return "", err
+	}
+	if tenv.Block != nil {
+		_, err = ipfs.AddData(h.service.Node(), bytes.NewReader(tenv.Block), true, false)
+		if err != nil {
+			return "", err
+		}
+	}
+	_, err = extractNode(h.service.Node(), node, tenv.Block == nil)
+	if err != nil {
+		return "", err
+	}
+
+	// pin envelope
+	id, err := ipfs.AddData(h.service.Node(), bytes.NewReader(body), true, false)
+	if err != nil {
+		return "", err
+	}
+	return id.Hash().B58String(), nil
+}
+
+// deleteClient removes a client and its threads and messages, and drops its objects
+func (h *CafeService) deleteClient(id string) error {
+	var cids []string
+	for _, obj := range h.datastore.CafeClientObjects().ListByClient(id) {
+		cids = append(cids, obj.Id)
+	}
+	_, err := h.unstoreObjects(id, cids)
+	if err != nil {
+		return fmt.Errorf("unstore client objects failed")
+	}
+	err = h.datastore.CafeClientThreads().DeleteByClient(id)
+	if err != nil {
+		return fmt.Errorf("delete client threads failed")
+	}
+	err = h.datastore.CafeClientMessages().DeleteByClient(id, -1)
+	if err != nil {
+		return fmt.Errorf("delete client messages failed")
+	}
+	err = h.datastore.CafeClients().Delete(id)
+	if err != nil {
+		return fmt.Errorf("delete client failed")
+	}
+	return nil
+}
+
+// parseNeighbor parses a neighbor peer id or multiaddr
+func parseNeighbor(s string) (*peer.AddrInfo, error) {
+	if !strings.HasPrefix(s, "/") {
+		id, err := peer.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		return &peer.AddrInfo{ID: id}, nil
+	}
+	addr, err := ma.NewMultiaddr(s)
+	if err != nil {
+		return nil, err
+	}
+	return peer.AddrInfoFromP2pAddr(addr)
+}
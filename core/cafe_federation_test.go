@@ -0,0 +1,410 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo/config"
+	"github.com/b582q9/go-textile-sapien/service"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+)
+
+var federationVars = struct {
+	nodeInitConfig  InitConfig
+	cafeInitConfigs []InitConfig
+
+	node  *Textile
+	cafes []*Textile
+
+	message string
+}{
+	nodeInitConfig: InitConfig{
+		BaseRepoPath: "./testdata/.textile7",
+		Debug:        true,
+	},
+	cafeInitConfigs: []InitConfig{
+		{
+			BaseRepoPath: "./testdata/.textile8",
+			Debug:        true,
+			SwarmPorts:   "4201",
+			CafeApiAddr:  "0.0.0.0:5201",
+			CafeURL:      "http://127.0.0.1:5201",
+			CafeOpen:     true,
+		},
+		{
+			BaseRepoPath: "./testdata/.textile9",
+			Debug:        true,
+			SwarmPorts:   "4202",
+			CafeApiAddr:  "0.0.0.0:5202",
+			CafeURL:      "http://127.0.0.1:5202",
+			CafeOpen:     true,
+		},
+		{
+			BaseRepoPath: "./testdata/.textile10",
+			Debug:        true,
+			SwarmPorts:   "4203",
+			CafeApiAddr:  "0.0.0.0:5203",
+			CafeURL:      "http://127.0.0.1:5203",
+			CafeOpen:     true,
+		},
+	},
+}
+
+func TestCore_SetupFederation(t *testing.T) {
+	var err error
+	federationVars.node, err = CreateAndStartPeer(federationVars.nodeInitConfig, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var addrs []string
+	for _, conf := range federationVars.cafeInitConfigs {
+		cafe, err := CreateAndStartPeer(conf, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		federationVars.cafes = append(federationVars.cafes, cafe)
+		addrs = append(addrs, fmt.Sprintf("/ip4/127.0.0.1/tcp/%s/ipfs/%s",
+			conf.SwarmPorts, cafe.Ipfs().Identity.Pretty()))
+	}
+
+	// every cafe lists the other two and restarts with the new config
+	for i, cafe := range federationVars.cafes {
+		var neighbors []string
+		for j, addr := range addrs {
+			if j != i {
+				neighbors = append(neighbors, addr)
+			}
+		}
+		repoPath := cafe.repoPath
+		cafe.config.Cafe.Host.Neighbors = neighbors
+		err = config.Write(repoPath, cafe.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = cafe.Stop()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		restarted, err := NewTextile(RunConfig{RepoPath: repoPath, Debug: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = restarted.Start()
+		if err != nil {
+			t.Fatal(err)
+		}
+		<-restarted.OnlineCh()
+		federationVars.cafes[i] = restarted
+	}
+
+	for _, cafe := range federationVars.cafes {
+		federationVars.node.Ipfs().Peerstore.AddAddrs(
+			cafe.Ipfs().Identity, cafe.Ipfs().PeerHost.Addrs(), peerstore.PermanentAddrTTL)
+	}
+}
+
+func TestCore_RegisterFederatedCafe(t *testing.T) {
+	primary := federationVars.cafes[0]
+	token, err := primary.CreateCafeToken("", true, CafeTokenOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := federationVars.node.RegisterCafe(primary.Ipfs().Identity.Pretty(), token)
+	if err != nil {
+		t.Fatalf("register with primary cafe failed: %s", err)
+	}
+	if len(session.Cafe.Neighbors) != 2 {
+		t.Fatalf("expected session to list 2 neighbors, got %d", len(session.Cafe.Neighbors))
+	}
+
+	err = addTestData(federationVars.node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	federationVars.node.FlushCafes()
+}
+
+func TestCore_ReplicateClientData(t *testing.T) {
+	waitOnFederatedRequests(time.Second * 60)
+
+	n := federationVars.node
+	clientId := n.Ipfs().Identity.Pretty()
+	primary := federationVars.cafes[0]
+
+	var blocks []string
+	for _, b := range n.Blocks("", -1, "").Items {
+		blocks = append(blocks, b.Id)
+	}
+	threads := len(primary.datastore.CafeClientThreads().ListByClient(clientId))
+	if threads == 0 {
+		t.Fatal("primary cafe has no thread snapshots")
+	}
+
+	for _, cafe := range federationVars.cafes[1:] {
+		cafeId := cafe.Ipfs().Identity.Pretty()
+		err := waitFor(time.Second*30, func() bool {
+			not, err := ipfs.NotPinned(cafe.Ipfs(), blocks)
+			return err == nil && len(not) == 0 &&
+				cafe.datastore.CafeClients().Get(clientId) != nil &&
+				len(cafe.datastore.CafeClientThreads().ListByClient(clientId)) == threads
+		})
+		if err != nil {
+			t.Fatalf("client data was not replicated to %s", cafeId)
+		}
+	}
+}
+
+func TestCore_ReplicateInboxMessages(t *testing.T) {
+	n := federationVars.node
+	clientId := n.Ipfs().Identity.Pretty()
+	session := n.datastore.CafeSessions().Get(federationVars.cafes[0].Ipfs().Identity.Pretty())
+
+	// deliver one of the client's own blocks to its inbox
+	thrd := n.Threads()[0]
+	heads, err := thrd.Heads()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := ipfs.ObjectAtPath(n.Ipfs(), heads[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ipfs.DataAtPath(n.Ipfs(), heads[0]+"/"+blockLinkName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := n.threads.NewEnvelope(thrd.Id, node, block, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := proto.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mid, err := ipfs.AddData(n.Ipfs(), bytes.NewReader(body), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	federationVars.message = mid.Hash().B58String()
+
+	err = n.cafe.deliverMessage(federationVars.message, clientId, session.Cafe)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cafe := range federationVars.cafes {
+		err = waitFor(time.Second*30, func() bool {
+			return cafe.datastore.CafeClientMessages().CountByClient(clientId) == 1
+		})
+		if err != nil {
+			t.Fatalf("message was not replicated to %s", cafe.Ipfs().Identity.Pretty())
+		}
+	}
+}
+
+func TestCore_ReplicateBans(t *testing.T) {
+	primary := federationVars.cafes[0]
+	address := keypair.Random().Address()
+
+	err := primary.BanCafeAccount(address, "abuse report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cafe := range federationVars.cafes[1:] {
+		err = waitFor(time.Second*30, func() bool {
+			return cafe.cafe.banned(address)
+		})
+		if err != nil {
+			t.Fatalf("ban was not replicated to %s", cafe.Ipfs().Identity.Pretty())
+		}
+	}
+
+	err = primary.UnbanCafeAccount(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cafe := range federationVars.cafes[1:] {
+		err = waitFor(time.Second*30, func() bool {
+			return !cafe.cafe.banned(address)
+		})
+		if err != nil {
+			t.Fatalf("unban was not replicated to %s", cafe.Ipfs().Identity.Pretty())
+		}
+	}
+}
+
+func TestCore_ReplicateRequiresNeighbor(t *testing.T) {
+	n := federationVars.node
+	err := n.cafe.sendReplicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_DELETE_CLIENT,
+		Client: &pb.CafeClient{Id: n.Ipfs().Identity.Pretty()},
+	}, federationVars.cafes[1].Ipfs().Identity.Pretty())
+	if _, ok := err.(*service.RemoteError); !ok || err.Error() != errForbidden {
+		t.Fatalf("expected replication from a client to be forbidden, got %v", err)
+	}
+}
+
+func TestCore_FailoverToNeighbor(t *testing.T) {
+	primary := federationVars.cafes[0]
+	primaryId := primary.Ipfs().Identity.Pretty()
+	err := primary.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// check messages through the primary's session
+	n := federationVars.node
+	renv, err := n.cafe.sendCafeRequest(primaryId, func(session *pb.CafeSession) (*pb.Envelope, error) {
+		return n.cafe.service.NewEnvelope(pb.Message_CAFE_CHECK_MESSAGES, &pb.CafeCheckMessages{
+			Token: session.Access,
+		}, nil, false)
+	})
+	if err != nil {
+		t.Fatalf("check messages with primary down failed: %s", err)
+	}
+	res := new(pb.CafeMessages)
+	err = ptypes.UnmarshalAny(renv.Message.Payload, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Messages) != 1 || res.Messages[0].Id != federationVars.message {
+		t.Fatalf("expected neighbor to return the replicated message, got %d", len(res.Messages))
+	}
+}
+
+func TestCore_ReplicateExpiry(t *testing.T) {
+	// the primary is down, expire the client's sessions through one of its neighbors
+	clientId := federationVars.node.Ipfs().Identity.Pretty()
+	cafe := federationVars.cafes[1]
+	neighbor := federationVars.cafes[2]
+
+	err := cafe.ExpireCafeClientSessions(clientId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired := cafe.datastore.CafeClients().Get(clientId).Expired
+	err = waitFor(time.Second*30, func() bool {
+		client := neighbor.datastore.CafeClients().Get(clientId)
+		return client != nil && proto.Equal(client.Expired, expired)
+	})
+	if err != nil {
+		t.Fatalf("expiry was not replicated to %s", neighbor.Ipfs().Identity.Pretty())
+	}
+
+	// the session issued by the primary is now rejected by the neighbor
+	n := federationVars.node
+	session := n.datastore.CafeSessions().Get(federationVars.cafes[0].Ipfs().Identity.Pretty())
+	rerr, err := neighbor.cafe.authToken(n.Ipfs().Identity, session.Access, false, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rerr == nil {
+		t.Fatal("neighbor accepted an expired session")
+	}
+}
+
+func TestCore_ReplicaQueueOrdered(t *testing.T) {
+	// the first attempt at the message fails, its deletion must still come after it
+	sent := make(chan pb.CafeReplicate_Type, 2)
+	var failed bool
+	store := federationVars.node.datastore.CafeReplicas()
+	q := newReplicaQueue("ordered", store, func(rep *pb.CafeReplicate, neighbor string) error {
+		if rep.Type == pb.CafeReplicate_MESSAGE && !failed {
+			failed = true
+			return fmt.Errorf("unreachable")
+		}
+		sent <- rep.Type
+		return nil
+	})
+	q.backoff = time.Millisecond * 10
+	done := make(chan struct{})
+	defer close(done)
+	go q.run(done)
+
+	q.add(&pb.CafeReplicate{Type: pb.CafeReplicate_MESSAGE})
+	q.add(&pb.CafeReplicate{Type: pb.CafeReplicate_DELETE_MESSAGES})
+
+	for _, expected := range []pb.CafeReplicate_Type{
+		pb.CafeReplicate_MESSAGE,
+		pb.CafeReplicate_DELETE_MESSAGES,
+	} {
+		select {
+		case typ := <-sent:
+			if typ != expected {
+				t.Fatalf("expected %s to be replicated next, got %s", expected, typ)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("%s was not replicated", expected)
+		}
+	}
+	_ = waitFor(time.Second, func() bool {
+		return len(store.ListByNeighbor("ordered", 1)) == 0
+	})
+	if len(store.ListByNeighbor("ordered", 1)) != 0 {
+		t.Fatal("delivered replications should be removed")
+	}
+}
+
+func TestCore_ReplicaQueueResumes(t *testing.T) {
+	// changes queued before a restart are sent by the next queue
+	store := federationVars.node.datastore.CafeReplicas()
+	stopped := newReplicaQueue("resumed", store, func(rep *pb.CafeReplicate, neighbor string) error {
+		return fmt.Errorf("unreachable")
+	})
+	stopped.add(&pb.CafeReplicate{Type: pb.CafeReplicate_UNSTORE, Cids: []string{"cid"}})
+
+	sent := make(chan *pb.CafeReplicate, 1)
+	q := newReplicaQueue("resumed", store, func(rep *pb.CafeReplicate, neighbor string) error {
+		sent <- rep
+		return nil
+	})
+	done := make(chan struct{})
+	defer close(done)
+	go q.run(done)
+
+	select {
+	case rep := <-sent:
+		if rep.Type != pb.CafeReplicate_UNSTORE || len(rep.Cids) != 1 || rep.Cids[0] != "cid" {
+			t.Fatalf("wrong replication was resumed: %s", rep.Type)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("queued replication was not resumed")
+	}
+}
+
+func TestCore_TeardownFederation(t *testing.T) {
+	_ = federationVars.node.Stop()
+	for _, cafe := range federationVars.cafes[1:] {
+		_ = cafe.Stop()
+	}
+	federationVars.node = nil
+	federationVars.cafes = nil
+}
+
+func waitOnFederatedRequests(total time.Duration) {
+	_ = waitFor(total, func() bool {
+		return federationVars.node.datastore.CafeRequests().Count(-1) == 0
+	})
+}
+
+// waitFor polls cond every second until it holds or total elapses
+func waitFor(total time.Duration, cond func() bool) error {
+	deadline := time.Now().Add(total)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s", total)
+		}
+		time.Sleep(time.Second)
+	}
+	return nil
+}
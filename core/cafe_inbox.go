@@ -15,17 +15,18 @@ import (
 // cafeInFlushGroupSize is the size of concurrently processed messages
 const cafeInFlushGroupSize = 16
 
-// cafeInMaxDownloadAttempts is the number of times a message can fail to download before being deleted
+// cafeInMaxDownloadAttempts is the number of times a message can fail to download before being marked failed
 const cafeInMaxDownloadAttempts = 5
 
 // CafeInbox queues and processes downloaded cafe messages
 type CafeInbox struct {
-	service        func() *CafeService
-	threadsService func() *ThreadsService
-	node           func() *core.IpfsNode
-	datastore      repo.Datastore
-	checking       bool
-	lock           sync.Mutex
+	service          func() *CafeService
+	threadsService   func() *ThreadsService
+	node             func() *core.IpfsNode
+	datastore        repo.Datastore
+	sendNotification func(*pb.Notification) error
+	checking         bool
+	lock             sync.Mutex
 }
 
 // NewCafeInbox creates a new inbox queue
@@ -34,12 +35,14 @@ func NewCafeInbox(
 	threadsService func() *ThreadsService,
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
+	sendNotification func(*pb.Notification) error,
 ) *CafeInbox {
 	return &CafeInbox{
-		service:        service,
-		threadsService: threadsService,
-		node:           node,
-		datastore:      datastore,
+		service:          service,
+		threadsService:   threadsService,
+		node:             node,
+		datastore:        datastore,
+		sendNotification: sendNotification,
 	}
 }
 
@@ -133,6 +136,12 @@ func (q *CafeInbox) handle(msg pb.CafeMessage) error {
 		return q.handleErr(fmt.Errorf("error decoding msg peer: %s", err), msg)
 	}
 
+	// don't bother downloading messages from blocked accounts
+	if peerBlocked(q.datastore, msg.Peer) {
+		log.Debugf("dropping cafe message %s from blocked peer %s", msg.Id, msg.Peer)
+		return nil
+	}
+
 	envb, err := ipfs.DataAtPath(q.node(), msg.Id)
 	if err != nil {
 		return q.handleErr(fmt.Errorf("error getting msg data: %s", err), msg)
@@ -156,13 +165,17 @@ func (q *CafeInbox) handle(msg pb.CafeMessage) error {
 	return nil
 }
 
-// handleErr deletes or adds an attempt to a message processing error
+// handleErr marks failed or adds an attempt to a message processing error
 func (q *CafeInbox) handleErr(herr error, msg pb.CafeMessage) error {
 	var err error
 	if msg.Attempts+1 >= cafeInMaxDownloadAttempts {
-		err = q.datastore.CafeMessages().Delete(msg.Id)
+		err = q.datastore.CafeMessages().Fail(msg.Id, herr.Error())
+		if err == nil {
+			err = q.sendNotification(queueFailedNotification(
+				q.node().Identity.Pretty(), pb.QueueItem_CAFE_INBOX, msg.Id, msg.Peer, msg.Attempts+1, herr.Error()))
+		}
 	} else {
-		err = q.datastore.CafeMessages().AddAttempt(msg.Id)
+		err = q.datastore.CafeMessages().AddAttempt(msg.Id, herr.Error())
 	}
 	if err != nil {
 		return err
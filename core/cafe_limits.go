@@ -0,0 +1,179 @@
+package core
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/ratelimit"
+	"github.com/b582q9/go-textile-sapien/repo/config"
+	"github.com/golang/protobuf/ptypes"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// defaultNonceTTL is used when the configured nonce ttl is missing or invalid
+const defaultNonceTTL = time.Minute * 5
+
+// cafeLimits are the rate limits and caps of an open cafe, a nil limiter allows everything
+type cafeLimits struct {
+	registrations *ratelimit.Limiter
+	messages      *ratelimit.Limiter
+	queries       *ratelimit.Limiter
+	maxInbox      int
+	nonceTTL      time.Duration
+	proxies       []*net.IPNet
+}
+
+// setLimits loads rate limits and caps from config
+func (h *CafeService) setLimits(conf *config.Config) {
+	lconf := conf.Cafe.Host.Limits
+	ttl, err := time.ParseDuration(lconf.NonceTTL)
+	if err != nil || ttl <= 0 {
+		if lconf.NonceTTL != "" {
+			log.Warningf("invalid cafe nonce ttl %s, using %s", lconf.NonceTTL, defaultNonceTTL)
+		}
+		ttl = defaultNonceTTL
+	}
+	var proxies []*net.IPNet
+	for _, p := range lconf.TrustedProxies {
+		ipnet, err := parseIPNet(p)
+		if err != nil {
+			log.Errorf("invalid trusted proxy %s: %s", p, err)
+			continue
+		}
+		proxies = append(proxies, ipnet)
+	}
+	h.limits = cafeLimits{
+		registrations: ratelimit.New(lconf.RegistrationRate, lconf.RegistrationBurst),
+		messages:      ratelimit.New(lconf.MessageRate, lconf.MessageBurst),
+		queries:       ratelimit.New(lconf.QueryRate, lconf.QueryBurst),
+		maxInbox:      lconf.MaxInboxMessages,
+		nonceTTL:      ttl,
+		proxies:       proxies,
+	}
+}
+
+// allowRegistration applies the registration limit to a peer and the ip it connects from
+func (h *CafeService) allowRegistration(pid string, ip string) bool {
+	if pid != "" && !h.limits.registrations.Allow("peer:"+pid) {
+		log.Warningf("registration limit reached for peer %s", pid)
+		return false
+	}
+	if ip != "" && !h.limits.registrations.Allow("ip:"+ip) {
+		log.Warningf("registration limit reached for ip %s", ip)
+		return false
+	}
+	return true
+}
+
+// allowMessage applies the delivery limit and inbox cap to a client
+func (h *CafeService) allowMessage(clientId string) bool {
+	if !h.limits.messages.Allow(clientId) {
+		log.Warningf("message limit reached for client %s", clientId)
+		return false
+	}
+	if h.limits.maxInbox > 0 && h.datastore.CafeClientMessages().CountByClient(clientId) >= h.limits.maxInbox {
+		log.Warningf("inbox of client %s is full", clientId)
+		return false
+	}
+	return true
+}
+
+// allowQuery applies the search limit to a client or pubsub peer
+func (h *CafeService) allowQuery(id string) bool {
+	if !h.limits.queries.Allow(id) {
+		log.Warningf("query limit reached for %s", id)
+		return false
+	}
+	return true
+}
+
+// nonceExpired returns whether a registration challenge is too old to use
+func (h *CafeService) nonceExpired(nonce *pb.CafeClientNonce) bool {
+	date, err := ptypes.Timestamp(nonce.Date)
+	if err != nil {
+		return true
+	}
+	return time.Since(date) > h.nonceTTL()
+}
+
+// expireNonces deletes registration challenges which are too old to use
+func (h *CafeService) expireNonces() {
+	err := h.datastore.CafeClientNonces().DeleteBefore(time.Now().Add(-h.nonceTTL()))
+	if err != nil {
+		log.Errorf("error expiring cafe nonces: %s", err)
+	}
+}
+
+// nonceTTL returns how long a registration challenge is valid
+func (h *CafeService) nonceTTL() time.Duration {
+	if h.limits.nonceTTL <= 0 {
+		return defaultNonceTTL
+	}
+	return h.limits.nonceTTL
+}
+
+// remoteIP returns the ip of a peer's first open connection
+func (h *CafeService) remoteIP(pid peer.ID) string {
+	for _, conn := range h.service.Node().PeerHost.Network().ConnsToPeer(pid) {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err == nil {
+			return ip.String()
+		}
+	}
+	return ""
+}
+
+// requestIP returns the ip of an http client. X-Forwarded-For is anyone's to set,
+// so it's only followed through trusted proxies, from the connecting one back.
+func (h *CafeService) requestIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	if !h.trustedProxy(ip) {
+		return ip
+	}
+	forwarded := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(addr) == nil {
+			break
+		}
+		ip = addr
+		if !h.trustedProxy(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+// trustedProxy returns whether or not ip belongs to a trusted proxy
+func (h *CafeService) trustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, p := range h.limits.proxies {
+		if p.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIPNet parses a CIDR or a single ip
+func parseIPNet(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		if strings.Contains(s, ":") {
+			s += "/128"
+		} else {
+			s += "/32"
+		}
+	}
+	_, ipnet, err := net.ParseCIDR(s)
+	return ipnet, err
+}
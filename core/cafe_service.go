@@ -1,7 +1,6 @@
 package core
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -15,6 +14,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/ipfs"
 	"github.com/b582q9/go-textile-sapien/jwt"
 	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/repo"
 	"github.com/b582q9/go-textile-sapien/repo/config"
@@ -26,7 +26,6 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	icid "github.com/ipfs/go-cid"
 	"github.com/ipfs/go-ipfs/core"
-	iface "github.com/ipfs/interface-go-ipfs-core"
 	peer "github.com/libp2p/go-libp2p-core/peer"
 	protocol "github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/mr-tron/base58/base58"
@@ -37,7 +36,7 @@ import (
 // defaultSessionDuration after which session token expires
 const defaultSessionDuration = time.Hour * 24 * 7 * 4
 
-// maxRequestAttempts is the number of times a request can fail before being deleted
+// maxRequestAttempts is the number of times a request can fail before being marked failed
 const maxRequestAttempts = 5
 
 // inboxMessagePageSize is the page size used when checking messages
@@ -58,10 +57,12 @@ const cafeOutFlushGroupSize = 32
 
 // validation errors
 const (
-	errInvalidAddress = "invalid address"
-	errUnauthorized   = "unauthorized"
-	errForbidden      = "forbidden"
-	errBadRequest     = "bad request"
+	errInvalidAddress  = "invalid address"
+	errUnauthorized    = "unauthorized"
+	errForbidden       = "forbidden"
+	errBadRequest      = "bad request"
+	errTooManyRequests = "too many requests"
+	errQuotaExceeded   = "quota exceeded"
 )
 
 // cafeServiceProtocol is the current protocol tag
@@ -69,14 +70,20 @@ const cafeServiceProtocol = protocol.ID("/textile/cafe/1.0.0")
 
 // CafeService is a libp2p pinning and offline message service
 type CafeService struct {
-	service         *service.Service
-	datastore       repo.Datastore
-	inbox           *CafeInbox
-	info            *pb.Cafe
-	online          bool
-	open            bool
-	queryResults    *broadcast.Broadcaster
-	inFlightQueries map[string]struct{}
+	service          *service.Service
+	datastore        repo.Datastore
+	inbox            *CafeInbox
+	info             *pb.Cafe
+	neighbors        []string
+	replicas         map[string]*replicaQueue
+	limits           cafeLimits
+	objects          sync.Mutex // serializes pinning and unpinning client objects
+	online           bool
+	open             bool
+	queryResults     *broadcast.Broadcaster
+	inFlightQueries  map[string]struct{}
+	sendNotification func(*pb.Notification) error
+	audit            func(actor string, action string, target string, err error)
 }
 
 // NewCafeService returns a new threads service
@@ -85,12 +92,16 @@ func NewCafeService(
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
 	inbox *CafeInbox,
+	sendNotification func(*pb.Notification) error,
+	audit func(actor string, action string, target string, err error),
 ) *CafeService {
 	handler := &CafeService{
-		datastore:       datastore,
-		inbox:           inbox,
-		queryResults:    broadcast.NewBroadcaster(10),
-		inFlightQueries: make(map[string]struct{}),
+		datastore:        datastore,
+		inbox:            inbox,
+		queryResults:     broadcast.NewBroadcaster(10),
+		inFlightQueries:  make(map[string]struct{}),
+		sendNotification: sendNotification,
+		audit:            audit,
 	}
 	handler.service = service.NewService(account, handler, node)
 	return handler
@@ -113,6 +124,42 @@ func (h *CafeService) Ping(pid peer.ID) (service.PeerStatus, error) {
 
 // Handle is called by the underlying service handler method
 func (h *CafeService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	start := time.Now()
+	renv, err := h.handle(env, pid)
+	metrics.CafeRequestDuration.
+		WithLabelValues(env.Message.Type.String(), metrics.Result(err)).
+		Observe(time.Since(start).Seconds())
+
+	switch env.Message.Type {
+	case pb.Message_CAFE_REGISTRATION, pb.Message_CAFE_DEREGISTRATION:
+		h.auditRequest(env, renv, err, pid)
+	}
+	return renv, err
+}
+
+// auditRequest records the result of a client's (de)registration
+func (h *CafeService) auditRequest(env *pb.Envelope, renv *pb.Envelope, err error, pid peer.ID) {
+	if err == nil && renv != nil && renv.Message.Type == pb.Message_ERROR {
+		res := new(pb.Error)
+		if perr := ptypes.UnmarshalAny(renv.Message.Payload, res); perr == nil {
+			err = fmt.Errorf("%d %s", res.Code, res.Message)
+		}
+	}
+
+	action := AuditCafeDeregister
+	target := pid.Pretty()
+	if env.Message.Type == pb.Message_CAFE_REGISTRATION {
+		action = AuditCafeRegister
+		reg := new(pb.CafeRegistration)
+		if perr := ptypes.UnmarshalAny(env.Message.Payload, reg); perr == nil {
+			target = reg.Address
+		}
+	}
+	h.audit(pid.Pretty(), action, target, err)
+}
+
+// handle routes a message to its handler
+func (h *CafeService) handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
 	switch env.Message.Type {
 	case pb.Message_CAFE_CHALLENGE:
 		return h.handleChallenge(env, pid)
@@ -146,6 +193,8 @@ func (h *CafeService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error
 		return h.handlePubSubQuery(env, pid)
 	case pb.Message_CAFE_PUBSUB_QUERY_RES:
 		return h.handlePubSubQueryResults(env, pid)
+	case pb.Message_CAFE_REPLICATE:
+		return h.handleReplicate(env, pid)
 	default:
 		return nil, nil
 	}
@@ -412,8 +461,15 @@ func (h *CafeService) sendCafeRequest(cafeId string, envFactory func(*pb.CafeSes
 			if err != nil {
 				return nil, err
 			}
-		} else {
+		} else if _, ok := err.(*service.RemoteError); ok {
 			return nil, err
+		} else {
+			// the cafe is unreachable, neighbors accept the same session
+			renv, ferr := h.failover(session, env)
+			if ferr != nil {
+				return nil, err
+			}
+			return renv, nil
 		}
 	}
 	return renv, nil
@@ -470,26 +526,11 @@ func (h *CafeService) refresh(session *pb.CafeSession) (*pb.CafeSession, error)
 
 // sendObject sends data or an object by cid to a cafe peer
 func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error {
-	hash := id.Hash().B58String()
-	obj := &pb.CafeObject{
-		Token: token,
-		Cid:   hash,
-	}
-
-	data, err := ipfs.DataAtPath(h.service.Node(), hash)
+	obj, err := h.objectPayload(id)
 	if err != nil {
-		if err == iface.ErrIsDir {
-			data, err := ipfs.ObjectAtPath(h.service.Node(), hash)
-			if err != nil {
-				return err
-			}
-			obj.Node = data
-		} else {
-			return err
-		}
-	} else {
-		obj.Data = data
+		return err
 	}
+	obj.Token = token
 
 	// send over the raw object data
 	env, err := h.service.NewEnvelope(pb.Message_CAFE_OBJECT, obj, nil, false)
@@ -697,6 +738,13 @@ func (h *CafeService) handleChallenge(env *pb.Envelope, pid peer.ID) (*pb.Envelo
 		// we don't want to handle account seeds, just addresses
 		return h.service.NewError(400, errInvalidAddress, env.Message.Request)
 	}
+	if h.banned(req.Address) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+	if !h.allowRegistration(pid.Pretty(), h.remoteIP(pid)) {
+		return h.service.NewError(429, errTooManyRequests, env.Message.Request)
+	}
+	h.expireNonces()
 
 	// generate a new random nonce
 	nonce := &pb.CafeClientNonce{
@@ -726,6 +774,7 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 	if !h.open {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
+	// the peer and ip were charged for the challenge, which a registration needs
 
 	// does the provided token match?
 	// dev tokens are actually base58(id+token)
@@ -749,6 +798,10 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 	if snonce == nil {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
+	if h.nonceExpired(snonce) {
+		_ = h.datastore.CafeClientNonces().Delete(snonce.Value)
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
 	if snonce.Address != reg.Address {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
@@ -767,22 +820,16 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 	if err != nil {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
-
-	now := ptypes.TimestampNow()
-	client := &pb.CafeClient{
-		Id:      pid.Pretty(),
-		Address: reg.Address,
-		Created: now,
-		Seen:    now,
-		Token:   encodedToken.Id,
+	if h.banned(reg.Address) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+	if !h.tokenAdmits(encodedToken, pid.Pretty()) {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
-	err = h.datastore.CafeClients().Add(client)
+
+	_, err = h.registerClient(pid.Pretty(), reg.Address, encodedToken)
 	if err != nil {
-		// check if already exists
-		client = h.datastore.CafeClients().Get(pid.Pretty())
-		if client == nil {
-			return h.service.NewError(500, "get or create client failed", env.Message.Request)
-		}
+		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
 
 	session, err := jwt.NewSession(
@@ -814,18 +861,11 @@ func (h *CafeService) handleDeregistration(env *pb.Envelope, pid peer.ID) (*pb.E
 
 	// cleanup
 	peerId := pid.Pretty()
-	err = h.datastore.CafeClientThreads().DeleteByClient(peerId)
-	if err != nil {
-		return h.service.NewError(500, "delete client threads failed", env.Message.Request)
-	}
-	err = h.datastore.CafeClientMessages().DeleteByClient(peerId, -1)
+	err = h.deleteClient(peerId)
 	if err != nil {
-		return h.service.NewError(500, "delete client messages failed", env.Message.Request)
-	}
-	err = h.datastore.CafeClients().Delete(peerId)
-	if err != nil {
-		return h.service.NewError(500, "delete client failed", env.Message.Request)
+		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicateDeleteClient(peerId)
 
 	res := &pb.CafeDeregistrationAck{
 		Id: peerId,
@@ -913,14 +953,18 @@ func (h *CafeService) handleStore(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return rerr, nil
 	}
 
-	// ignore cids for data already pinned
-	list, err := ipfs.NotPinned(h.service.Node(), store.Cids)
-	if err != nil {
-		return nil, err
-	}
+	// ignore cids for data the client already stores, pinned data is still
+	// sent by new owners so that each one is charged for it
 	var need []string
-	for _, p := range list {
-		need = append(need, p.Hash().B58String())
+	for _, c := range store.Cids {
+		id, err := icid.Decode(c)
+		if err != nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		hash := id.Hash().B58String()
+		if h.datastore.CafeClientObjects().Get(hash, pid.Pretty()) == nil {
+			need = append(need, hash)
+		}
 	}
 
 	res := &pb.CafeObjectList{Cids: need}
@@ -943,21 +987,14 @@ func (h *CafeService) handleUnstore(env *pb.Envelope, pid peer.ID) (*pb.Envelope
 		return rerr, nil
 	}
 
-	// ignore cids for data not pinned
-	list, err := ipfs.Pinned(h.service.Node(), unstore.Cids)
+	// objects stored by other clients stay pinned
+	unstored, err := h.unstoreObjects(pid.Pretty(), unstore.Cids)
 	if err != nil {
 		return nil, err
 	}
-	var unstored []string
-	for _, p := range list {
-		err := ipfs.UnpinCid(h.service.Node(), p, true)
-		if err != nil {
-			return nil, err
-		}
-		unstored = append(unstored, p.Hash().B58String())
-	}
+	h.replicateUnstore(pid.Pretty(), unstored)
 
-	res := &pb.CafeUnstoreAck{Cids: unstored}
+	res := &pb.CafeUnstoreAck{Cids: unstore.Cids}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_ACK, res, &env.Message.Request, true)
 }
 
@@ -977,24 +1014,32 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return rerr, nil
 	}
 
-	var aid *icid.Cid
-	if obj.Data != nil {
-		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), true, false)
-	} else if obj.Node != nil {
-		aid, err = ipfs.AddObject(h.service.Node(), bytes.NewReader(obj.Node), true)
-	} else {
+	if obj.Data == nil && obj.Node == nil {
+		return h.service.NewError(400, errBadRequest, env.Message.Request)
+	}
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+
+	// charge the object the data resolves to, not the one the client claims
+	aid, err := h.addObject(obj)
+	if err != nil {
+		log.Warningf("error adding object from %s: %s", pid.Pretty(), err)
 		return h.service.NewError(400, errBadRequest, env.Message.Request)
 	}
+	size, err := h.clientObjectSize(client.Id, *aid)
 	if err != nil {
 		return nil, err
 	}
-	rhash := aid.Hash().B58String()
-
-	log.Debugf("stored %s", rhash)
-
-	if rhash != obj.Cid {
-		log.Warningf("cids do not match (received %s, resolved %s)", obj.Cid, rhash)
+	if !h.allowStore(client, size) {
+		return h.service.NewError(403, errQuotaExceeded, env.Message.Request)
 	}
+	err = h.storeObject(client.Id, *aid, false, size)
+	if err != nil {
+		return nil, err
+	}
+	h.replicateObjects(client.Id, []icid.Cid{*aid})
 
 	res := &pb.CafeStoreAck{Id: obj.Cid}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_ACK, res, &env.Message.Request, true)
@@ -1020,6 +1065,9 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if client == nil {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
+	if !h.allowThread(client, store.Id) {
+		return h.service.NewError(403, errQuotaExceeded, env.Message.Request)
+	}
 
 	thrd := &pb.CafeClientThread{
 		Id:         store.Id,
@@ -1030,6 +1078,7 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicateThread(thrd)
 
 	res := &pb.CafeStoreThreadAck{Id: store.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD_ACK, res, &env.Message.Request, true)
@@ -1060,6 +1109,7 @@ func (h *CafeService) handleUnstoreThread(env *pb.Envelope, pid peer.ID) (*pb.En
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicateDeleteThread(unstore.Id, client.Id)
 
 	res := &pb.CafeUnstoreThreadAck{Id: unstore.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_THREAD_ACK, res, &env.Message.Request, true)
@@ -1078,64 +1128,33 @@ func (h *CafeService) handleDeliverMessage(env *pb.Envelope, pid peer.ID) (*pb.E
 		log.Warningf("received message from %s for unknown client %s", pid.Pretty(), msg.Client)
 		return nil, nil
 	}
+	if !h.allowMessage(client.Id) {
+		return h.service.NewError(429, errTooManyRequests, env.Message.Request)
+	}
 
 	if msg.Env != nil {
-		// pin inner node
-		nenv := new(pb.Envelope)
-		err = proto.Unmarshal(msg.Env, nenv)
-		if err != nil {
-			log.Warningf("error unmarshaling envelope: %s", err)
-			return nil, err
-		}
-		tenv := new(pb.ThreadEnvelope)
-		err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
-		if err != nil {
-			log.Warningf("error unmarshaling payload: %s", err)
-			return nil, err
-		}
-		oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), true)
-		if err != nil {
-			log.Warningf("error adding object: %s", err)
-			return nil, err
-		}
-		node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
-		if err != nil {
-			log.Warningf("error getting node: %s", err)
-			return nil, err
-		}
-		if tenv.Block != nil {
-			_, err = ipfs.AddData(h.service.Node(), bytes.NewReader(tenv.Block), true, false)
-			if err != nil {
-				log.Warningf("error adding block: %s", err)
-				return nil, err
-			}
-		}
-		_, err = extractNode(h.service.Node(), node, tenv.Block == nil)
-		if err != nil {
-			log.Warningf("error extracting node: %s", err)
-			return nil, err
-		}
-
-		// pin envelope
-		id, err := ipfs.AddData(h.service.Node(), bytes.NewReader(msg.Env), true, false)
+		msg.Id, err = h.pinMessage(msg.Env)
 		if err != nil {
-			log.Warningf("error pinning envelope: %s", err)
+			log.Warningf("error pinning message: %s", err)
 			return nil, err
 		}
-		msg.Id = id.Hash().B58String()
 	}
 
-	err = h.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
+	cmsg := &pb.CafeClientMessage{
 		Id:     msg.Id,
 		Peer:   pid.Pretty(),
 		Client: client.Id,
 		Date:   ptypes.TimestampNow(),
-	})
+	}
+	err = h.datastore.CafeClientMessages().AddOrUpdate(cmsg)
 	if err != nil {
 		log.Errorf("error adding message: %s", err)
 		return nil, nil
 	}
 	log.Debugf("added message for %s: %s", client.Id, msg.Id)
+	if msg.Env != nil {
+		h.replicateMessage(cmsg, msg.Env)
+	}
 
 	go func() {
 		err = h.notifyClient(client.Id)
@@ -1209,10 +1228,12 @@ func (h *CafeService) handleDeleteMessages(env *pb.Envelope, pid peer.ID) (*pb.E
 	}
 
 	// delete the most recent page
+	page := h.datastore.CafeClientMessages().ListByClient(client.Id, inboxMessagePageSize)
 	err = h.datastore.CafeClientMessages().DeleteByClient(client.Id, inboxMessagePageSize)
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicateDeleteMessages(client.Id, page)
 
 	// check for more
 	remaining := h.datastore.CafeClientMessages().CountByClient(client.Id)
@@ -1224,12 +1245,16 @@ func (h *CafeService) handleDeleteMessages(env *pb.Envelope, pid peer.ID) (*pb.E
 // handleNotifyClient receives a message informing this peer that it has new messages waiting
 func (h *CafeService) handleNotifyClient(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
 	session := h.datastore.CafeSessions().Get(pid.Pretty())
+	if session == nil {
+		// neighbors hold a copy of the inbox
+		session = h.sessionByNeighbor(pid.Pretty())
+	}
 	if session == nil {
 		log.Warningf("received message from unknown cafe %s", pid.Pretty())
 		return nil, nil
 	}
 
-	err := h.CheckMessages(pid.Pretty())
+	err := h.CheckMessages(session.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -1278,6 +1303,15 @@ func (h *CafeService) handleQuery(env *pb.Envelope, pid peer.ID, renvs chan *pb.
 	}
 	query = queryDefaults(query)
 
+	if !h.allowQuery(pid.Pretty()) {
+		renv, err := h.service.NewError(429, errTooManyRequests, env.Message.Request)
+		if err != nil {
+			return err
+		}
+		renvs <- renv
+		return nil
+	}
+
 	results := newQueryResultSet(query.Options)
 	reply := func(res *pb.QueryResults) bool {
 		added := results.Add(res.Items...)
@@ -1322,6 +1356,9 @@ func (h *CafeService) handlePubSubQuery(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if _, ok := h.inFlightQueries[query.Id]; ok {
 		return nil, nil
 	}
+	if !h.allowQuery(pid.Pretty()) {
+		return nil, nil
+	}
 
 	// return results, if any
 	options := &pb.QueryOptions{
@@ -1391,7 +1428,7 @@ func (h *CafeService) handlePubSubQueryResults(env *pb.Envelope, pid peer.ID) (*
 // authToken verifies a request token from a peer
 func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requestId int32) (*pb.Envelope, error) {
 	subject := pid.Pretty()
-	_, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
+	claims, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
 	if err != nil {
 		switch err {
 		case jwt.ErrNoToken, jwt.ErrExpired:
@@ -1400,17 +1437,47 @@ func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requ
 			return h.service.NewError(403, errForbidden, requestId)
 		}
 	}
+	if claims != nil && h.sessionExpired(claims) {
+		// expired refresh tokens force the client to register again
+		if refreshing {
+			return h.service.NewError(403, errForbidden, requestId)
+		}
+		return h.service.NewError(401, errUnauthorized, requestId)
+	}
 	return nil, nil
 }
 
-// verifyKeyFunc returns the correct key for token verification
+// verifyKeyFunc returns the correct key for token verification.
+// Sessions issued by a neighbor are accepted so clients can fail over.
 func (h *CafeService) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
-	return h.service.Node().PrivateKey.GetPublic(), nil
+	claims, err := jwt.ParseClaims(token.Claims)
+	if err != nil {
+		return nil, err
+	}
+	self := h.service.Node().Identity.Pretty()
+	if claims.Issuer == "" || claims.Issuer == self {
+		return h.service.Node().PrivateKey.GetPublic(), nil
+	}
+	if !h.isNeighbor(claims.Issuer) {
+		return nil, fmt.Errorf("unknown token issuer %s", claims.Issuer)
+	}
+	issuer, err := peer.Decode(claims.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	return issuer.ExtractPublicKey()
 }
 
 // setAddrs sets addresses used in sessions generated by this host
 func (h *CafeService) setAddrs(conf *config.Config) {
 	url := strings.TrimRight(conf.Cafe.Host.URL, "/")
+	if url == "" && conf.TLS.CafeAPI && len(conf.TLS.Hosts) > 0 {
+		url = "https://" + conf.TLS.Hosts[0]
+		parts := strings.Split(conf.Addresses.CafeAPI, ":")
+		if len(parts) == 2 && parts[1] != "443" {
+			url += ":" + parts[1]
+		}
+	}
 	if url == "" {
 		ip4, err := ipfs.GetLANIPv4Addr(h.service.Node())
 		if err != nil {
@@ -1429,12 +1496,13 @@ func (h *CafeService) setAddrs(conf *config.Config) {
 	log.Infof("cafe url: %s", url)
 
 	h.info = &pb.Cafe{
-		Peer:     h.service.Node().Identity.Pretty(),
-		Address:  conf.Account.Address,
-		Api:      CafeApiVersion,
-		Protocol: string(cafeServiceProtocol),
-		Node:     common.Version,
-		Url:      url,
+		Peer:      h.service.Node().Identity.Pretty(),
+		Address:   conf.Account.Address,
+		Api:       CafeApiVersion,
+		Protocol:  string(cafeServiceProtocol),
+		Node:      common.Version,
+		Url:       url,
+		Neighbors: h.neighbors,
 	}
 }
 
@@ -1466,7 +1534,9 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 	}
 
 	// process each cafe group concurrently
-	var toComplete, toFail, toUnpin []string
+	var toComplete, toUnpin []string
+	toFail := make(map[string]string)
+	var failLock sync.Mutex
 	wg := sync.WaitGroup{}
 	for cafeId, group := range groups {
 		wg.Add(1)
@@ -1478,8 +1548,10 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 			}
 			for t, group := range types {
 				handled, failed, err := h.handleRequests(group, t, cafeId)
+				var reason string
 				if err != nil {
 					log.Warningf("error handling requests of type %s: %s", t.String(), err)
+					reason = err.Error()
 				}
 				for _, id := range handled {
 					toComplete = append(toComplete, id)
@@ -1487,9 +1559,11 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 						toUnpin = append(toUnpin, id)
 					}
 				}
+				failLock.Lock()
 				for _, id := range failed {
-					toFail = append(toFail, id)
+					toFail[id] = reason
 				}
+				failLock.Unlock()
 			}
 			wg.Done()
 		}(cafeId, group)
@@ -1518,13 +1592,13 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 	}
 
 	var err error
-	for _, id := range toFail {
+	for id, reason := range toFail {
 		req := h.datastore.CafeRequests().Get(id)
 		if req == nil {
 			continue
 		}
 		if req.Attempts+1 >= maxRequestAttempts {
-			err = h.datastore.CafeRequests().Delete(id)
+			err = h.datastore.CafeRequests().Fail(id, reason)
 			if err != nil {
 				log.Error(err.Error())
 				return
@@ -1533,8 +1607,11 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 			// delete queued block
 			// @todo: Uncomment this when sync can only be handled by a single cafe session
 			//err = h.datastore.Blocks().Delete(req.SyncGroup)
+
+			err = h.sendNotification(queueFailedNotification(
+				h.service.Node().Identity.Pretty(), pb.QueueItem_CAFE_OUTBOX, id, req.Cafe.Peer, req.Attempts+1, reason))
 		} else {
-			err = h.datastore.CafeRequests().AddAttempt(id)
+			err = h.datastore.CafeRequests().AddAttempt(id, reason)
 		}
 		if err != nil {
 			log.Error(err.Error())
@@ -1789,7 +1866,17 @@ func (h *CafeService) deliverMessage(mid string, peerId string, cafe *pb.Cafe) e
 		return err
 	}
 
-	return h.service.SendMessage(nil, cafe.Peer, env)
+	err = h.service.SendMessage(nil, cafe.Peer, env)
+	if err != nil {
+		// neighbors mirror the inbox
+		for _, n := range cafe.Neighbors {
+			if nerr := h.service.SendMessage(nil, n, env); nerr == nil {
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
 }
 
 // queryDefaults ensures the query is within the expected bounds
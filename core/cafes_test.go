@@ -2,12 +2,16 @@ package core
 
 import (
 	"fmt"
+	"net"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/keypair"
 	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo/config"
 	"github.com/b582q9/go-textile-sapien/schema/textile"
 	icid "github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p-core/peerstore"
@@ -44,15 +48,36 @@ func TestCore_SetupCafes(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	cafeVars.cafe, err = CreateAndStartPeer(cafeVars.cafeInitConfig, true)
+	cafe, err := CreateAndStartPeer(cafeVars.cafeInitConfig, true)
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// requests from 127.0.0.2 come through a trusted proxy, restart with the new config
+	repoPath := cafe.repoPath
+	cafe.config.Cafe.Host.Limits.TrustedProxies = []string{"127.0.0.2"}
+	err = config.Write(repoPath, cafe.config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cafe.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cafeVars.cafe, err = NewTextile(RunConfig{RepoPath: repoPath, Debug: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cafeVars.cafe.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-cafeVars.cafe.OnlineCh()
 }
 
 func TestTextile_CafeTokens(t *testing.T) {
 	var err error
-	cafeVars.token, err = cafeVars.cafe.CreateCafeToken("", true)
+	cafeVars.token, err = cafeVars.cafe.CreateCafeToken("", true, CafeTokenOptions{})
 	if err != nil {
 		t.Fatalf("error creating cafe token: %s", err)
 	}
@@ -77,7 +102,7 @@ func TestTextile_CafeTokens(t *testing.T) {
 }
 
 func TestTextile_RemoveCafeToken(t *testing.T) {
-	err := cafeVars.cafe.RemoveCafeToken(cafeVars.token)
+	err := cafeVars.cafe.RemoveCafeToken(cafeVars.token, CafeTokenCascadeNone)
 	if err != nil {
 		t.Fatal("expected be remove token cleanly")
 	}
@@ -89,7 +114,7 @@ func TestTextile_RemoveCafeToken(t *testing.T) {
 }
 
 func TestCore_RegisterCafe(t *testing.T) {
-	token, err := cafeVars.cafe.CreateCafeToken("", true)
+	token, err := cafeVars.cafe.CreateCafeToken("", true, CafeTokenOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -184,6 +209,276 @@ func TestCore_HandleCafeRequests(t *testing.T) {
 	}
 }
 
+func TestTextile_CafeAdmin(t *testing.T) {
+	n := cafeVars.node
+	c := cafeVars.cafe
+	cafeId := c.Ipfs().Identity.Pretty()
+	clientId := n.Ipfs().Identity.Pretty()
+
+	// list and search clients
+	if len(c.CafeClients("", 10).Items) != 1 {
+		t.Fatal("expected one client")
+	}
+	if len(c.CafeClients(n.Account().Address()[:8], 10).Items) != 1 {
+		t.Fatal("expected search by address to find the client")
+	}
+	info, err := c.CafeClient(clientId, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Threads) == 0 || info.Client.Seen == nil {
+		t.Fatal("expected client threads and last seen")
+	}
+
+	// expired sessions can neither be used nor refreshed
+	err = c.ExpireCafeClientSessions(clientId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.cafe.CheckMessages(cafeId); err == nil {
+		t.Fatal("expected expired session to be rejected")
+	}
+
+	// banned accounts cannot register again
+	err = c.BanCafeAccount(n.Account().Address(), "abuse report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.CreateCafeToken("", true, CafeTokenOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.RegisterCafe(cafeId, token); err == nil {
+		t.Fatal("expected banned account to be rejected")
+	}
+	if err := c.UnbanCafeAccount(n.Account().Address()); err != nil {
+		t.Fatal(err)
+	}
+
+	// revoke the token by id
+	var found bool
+	for _, tok := range c.CafeTokenList().Items {
+		if tok.Id == cafeTokenId(token) {
+			found = len(tok.Value) == 0
+		}
+	}
+	if !found {
+		t.Fatal("expected token to be listed without its hash")
+	}
+	if err := c.RevokeCafeToken(cafeTokenId(token), CafeTokenCascadeNone); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := c.ValidateCafeToken(token); ok {
+		t.Fatal("expected revoked token to be invalid")
+	}
+
+	// purge the client
+	err = c.PurgeCafeClient(clientId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CafeClient(clientId, 10); err != ErrCafeClientNotFound {
+		t.Fatal("expected client to be purged")
+	}
+	if len(c.datastore.CafeClientThreads().ListByClient(clientId)) != 0 {
+		t.Fatal("expected client threads to be purged")
+	}
+}
+
+func TestTextile_CafeTokenLimits(t *testing.T) {
+	n := cafeVars.node
+	c := cafeVars.cafe
+	cafeId := c.Ipfs().Identity.Pretty()
+	clientId := n.Ipfs().Identity.Pretty()
+
+	token, err := c.CreateCafeToken("", true, CafeTokenOptions{
+		Label:       "partner",
+		MaxClients:  1,
+		ThreadQuota: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.RegisterCafe(cafeId, token); err != nil {
+		t.Fatalf("register with limited token failed: %s", err)
+	}
+	client := c.datastore.CafeClients().Get(clientId)
+	if client == nil || client.Token != cafeTokenId(token) {
+		t.Fatal("expected client to be admitted by the limited token")
+	}
+
+	// the client inherits the thread quota
+	if !c.cafe.allowThread(client, "thread1") {
+		t.Fatal("expected first thread to be allowed")
+	}
+	err = c.datastore.CafeClientThreads().AddOrUpdate(&pb.CafeClientThread{
+		Id:         "thread1",
+		Client:     clientId,
+		Ciphertext: []byte("snapshot"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.cafe.allowThread(client, "thread2") {
+		t.Fatal("expected second thread to exceed the quota")
+	}
+
+	usage, err := c.CafeTokenUsage(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Token.Label != "partner" || usage.Clients != 1 || usage.Threads != 1 {
+		t.Fatalf("unexpected token usage: %v", usage)
+	}
+	if len(usage.Token.Value) != 0 {
+		t.Fatal("expected usage to omit the token hash")
+	}
+
+	// expired tokens and tokens with no registrations left admit no one new
+	expired, err := c.CreateCafeToken("", true, CafeTokenOptions{
+		Expiry: time.Now().Add(-time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.cafe.tokenAdmits(c.datastore.CafeTokens().Get(cafeTokenId(expired)), clientId) {
+		t.Fatal("expected expired token to be rejected")
+	}
+	limited := c.datastore.CafeTokens().Get(cafeTokenId(token))
+	if !c.cafe.tokenAdmits(limited, clientId) {
+		t.Fatal("expected registered client to be let back in")
+	}
+	if c.cafe.tokenAdmits(limited, "other") {
+		t.Fatal("expected token to have no registrations left")
+	}
+
+	// quotas stay with the clients of a removed token
+	err = c.RemoveCafeToken(token, CafeTokenCascadeNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = c.datastore.CafeClients().Get(clientId)
+	if client == nil || c.cafe.allowThread(client, "thread2") {
+		t.Fatal("expected the thread quota to outlive the token")
+	}
+
+	// registering again with another token takes its quotas
+	other, err := c.CreateCafeToken("", true, CafeTokenOptions{ThreadQuota: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.cafe.Register(cafeId, other); err != nil {
+		t.Fatalf("register with another token failed: %s", err)
+	}
+	client = c.datastore.CafeClients().Get(clientId)
+	if client == nil || client.Token != cafeTokenId(other) {
+		t.Fatal("expected client to be reassigned to the other token")
+	}
+	if !c.cafe.allowThread(client, "thread2") {
+		t.Fatal("expected the other token's thread quota to apply")
+	}
+
+	// stored bytes count each object once per client and are given back on unstore
+	aid, err := ipfs.AddData(c.Ipfs(), strings.NewReader("stored bytes"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := c.cafe.clientObjectSize(clientId, *aid)
+	if err != nil || size <= 0 {
+		t.Fatalf("expected a new object to have a size, got %d: %v", size, err)
+	}
+	err = c.cafe.storeObject(clientId, *aid, false, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := c.cafe.clientObjectSize(clientId, *aid); err != nil || again != 0 {
+		t.Fatalf("expected a stored object to count once, got %d: %v", again, err)
+	}
+	if client = c.datastore.CafeClients().Get(clientId); client.Stored != size {
+		t.Fatalf("expected %d stored bytes, got %d", size, client.Stored)
+	}
+	err = c.cafe.storeObject("other", *aid, false, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// clients can only drop their own objects
+	unstored, err := c.cafe.unstoreObjects("stranger", []string{aid.String()})
+	if err != nil || len(unstored) != 0 {
+		t.Fatalf("expected an object to stay with its owners, got %v: %v", unstored, err)
+	}
+	unstored, err = c.cafe.unstoreObjects(clientId, []string{aid.String()})
+	if err != nil || len(unstored) != 1 {
+		t.Fatalf("expected the object to be unstored, got %v: %v", unstored, err)
+	}
+	if client = c.datastore.CafeClients().Get(clientId); client.Stored != 0 {
+		t.Fatalf("expected stored bytes to be given back, got %d", client.Stored)
+	}
+	if pinned, _ := ipfs.Pinned(c.Ipfs(), []string{aid.String()}); len(pinned) != 1 {
+		t.Fatal("expected the object to stay pinned for its other owner")
+	}
+	_, err = c.cafe.unstoreObjects("other", []string{aid.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pinned, _ := ipfs.Pinned(c.Ipfs(), []string{aid.String()}); len(pinned) != 0 {
+		t.Fatal("expected the object to be unpinned with its last owner")
+	}
+
+	// revoking with purge deregisters the token's clients
+	err = c.RemoveCafeToken(other, CafeTokenCascadePurge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.datastore.CafeClients().Get(clientId) != nil {
+		t.Fatal("expected client to be purged with its token")
+	}
+}
+
+func TestCore_CafeApiRegistrationLimit(t *testing.T) {
+	c := cafeVars.cafe
+	token, err := c.CreateCafeToken("", true, CafeTokenOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := fmt.Sprintf("%s/api/v1/sessions/challenge?account_addr=%s",
+		cafeVars.cafeInitConfig.CafeURL, keypair.Random().Address())
+	challenge := func(client *http.Client, forwardedFor string) int {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Basic "+token)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		req.Header.Set("X-Real-Ip", forwardedFor)
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+		return res.StatusCode
+	}
+
+	// spoofed forwarding headers don't get a fresh limit
+	var limited bool
+	for i := 0; i <= c.config.Cafe.Host.Limits.RegistrationBurst; i++ {
+		if challenge(http.DefaultClient, fmt.Sprintf("10.0.0.%d", i+1)) == http.StatusTooManyRequests {
+			limited = true
+			break
+		}
+	}
+	if !limited {
+		t.Fatal("expected challenges to be rate limited")
+	}
+
+	// clients forwarded by a trusted proxy are limited on their own
+	dialer := &net.Dialer{LocalAddr: &net.TCPAddr{IP: net.ParseIP("127.0.0.2")}}
+	proxy := &http.Client{Transport: &http.Transport{DialContext: dialer.DialContext}}
+	if status := challenge(proxy, "10.0.1.1"); status != http.StatusOK {
+		t.Fatalf("expected forwarded client to be allowed, got %d", status)
+	}
+}
+
 func TestCore_TeardownCafes(t *testing.T) {
 	_ = cafeVars.node.Stop()
 	_ = cafeVars.cafe.Stop()
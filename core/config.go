@@ -11,6 +11,7 @@ import (
 	"github.com/ipfs/go-ipfs/repo"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 	"github.com/rs/cors"
+	"golang.org/x/crypto/bcrypt"
 )
 
 const minPort = 1024
@@ -61,6 +62,13 @@ func applyTextileConfigOptions(init InitConfig) error {
 	if init.ProfilingAddr != "" {
 		conf.Addresses.Profiling = init.ProfilingAddr
 	}
+	if init.MetricsAddr != "" {
+		conf.Addresses.Metrics = init.MetricsAddr
+	}
+	if init.DisableMetrics || (init.IsMobile && init.MetricsAddr == "") {
+		// mobile peers only expose metrics if asked to
+		conf.Addresses.Metrics = ""
+	}
 
 	// log settings
 	conf.Logs.LogToDisk = init.LogToDisk
@@ -68,11 +76,20 @@ func applyTextileConfigOptions(init InitConfig) error {
 	// profile settings
 	conf.IsServer = init.IsServer
 	conf.IsMobile = init.IsMobile
+	conf.IsLAN = init.IsLAN
 
 	// cafe settings
 	conf.Cafe.Host.Open = init.CafeOpen
 	conf.Cafe.Host.URL = init.CafeURL
 	conf.Cafe.Host.NeighborURL = init.CafeNeighborURL
+	conf.Cafe.Host.Neighbors = init.CafeNeighbors
+	if init.CafeAdminToken != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(init.CafeAdminToken), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		conf.Cafe.Host.AdminToken = string(hash)
+	}
 
 	// write to disk
 	return config.Write(repo, conf)
@@ -116,6 +133,7 @@ const (
 	desktopProfile profile = iota
 	mobileProfile
 	serverProfile
+	lanProfile
 )
 
 // ensureProfile ensures the config settings are active for the selected profile
@@ -146,16 +164,24 @@ func ensureProfile(profile profile, repoPath string) error {
 	} else {
 		conf.Discovery.MDNS.Enabled = true
 	}
+	if profile == lanProfile {
+		conf.Discovery.MDNS.Interval = int(lanDiscoveryInterval.Seconds())
+	} else {
+		conf.Discovery.MDNS.Interval = 10
+	}
 
 	if profile == serverProfile {
 		conf.Routing.Type = "dht"
 		conf.Reprovider.Interval = "12h"
+	} else if profile == lanProfile {
+		conf.Routing.Type = "none"
+		conf.Reprovider.Interval = "0"
 	} else {
 		conf.Routing.Type = "dhtclient"
 		conf.Reprovider.Interval = "0"
 	}
 
-	if profile == mobileProfile {
+	if profile == mobileProfile || profile == lanProfile {
 		conf.Swarm.ConnMgr.LowWater = 200
 		conf.Swarm.ConnMgr.HighWater = 500
 		conf.Swarm.DisableBandwidthMetrics = true
@@ -171,6 +197,11 @@ func ensureProfile(profile profile, repoPath string) error {
 		conf.Swarm.EnableRelayHop = true
 		conf.Swarm.EnableAutoRelay = false
 		conf.Swarm.EnableAutoNATService = true
+	} else if profile == lanProfile {
+		conf.Swarm.DisableNatPortMap = true
+		conf.Swarm.EnableRelayHop = false
+		conf.Swarm.EnableAutoRelay = false
+		conf.Swarm.EnableAutoNATService = false
 	} else {
 		conf.Swarm.DisableNatPortMap = false
 		conf.Swarm.EnableRelayHop = false
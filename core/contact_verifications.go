@@ -0,0 +1,114 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/segmentio/ksuid"
+)
+
+// ContactSafetyNumber returns the safety number shared with the given address
+func (t *Textile) ContactSafetyNumber(address string) (string, error) {
+	if address == t.account.Address() {
+		return "", fmt.Errorf("cannot compare safety number with self")
+	}
+
+	return keypair.SafetyNumber(t.account.Address(), address)
+}
+
+// VerifyContact marks a contact and its current peers as verified
+// Call this once the safety number has been compared out of band.
+func (t *Textile) VerifyContact(address string) error {
+	contact := t.contact(address, false)
+	if contact == nil {
+		return ErrContactNotFound
+	}
+	if address == t.account.Address() {
+		return fmt.Errorf("cannot verify own contact")
+	}
+
+	var peers []string
+	for _, p := range contact.Peers {
+		peers = append(peers, p.Id)
+	}
+
+	return t.datastore.ContactVerifications().AddOrUpdate(&pb.ContactVerification{
+		Address: address,
+		Peers:   peers,
+		Date:    ptypes.TimestampNow(),
+	})
+}
+
+// UnverifyContact removes a contact's verified state
+func (t *Textile) UnverifyContact(address string) error {
+	if t.contact(address, false) == nil {
+		return ErrContactNotFound
+	}
+
+	return t.datastore.ContactVerifications().Delete(address)
+}
+
+// extendContactVerification adds the peers of a signed card to an existing verification
+func (t *Textile) extendContactVerification(card *pb.Contact) error {
+	verification := t.datastore.ContactVerifications().Get(card.Address)
+	if verification == nil {
+		return nil
+	}
+
+	unverified := unverifiedPeers(verification, card.Peers)
+	if len(unverified) == 0 {
+		return nil
+	}
+	for _, p := range unverified {
+		verification.Peers = append(verification.Peers, p.Id)
+	}
+	verification.Date = ptypes.TimestampNow()
+
+	return t.datastore.ContactVerifications().AddOrUpdate(verification)
+}
+
+// contactChangedNotifications returns warnings for peers that are not part
+// of a contact's verification
+func contactChangedNotifications(datastore repo.Datastore, address string, peers []*pb.Peer) []*pb.Notification {
+	verification := datastore.ContactVerifications().Get(address)
+	if verification == nil {
+		return nil
+	}
+
+	var notes []*pb.Notification
+	for _, p := range unverifiedPeers(verification, peers) {
+		name := p.Name
+		if name == "" {
+			name = address
+		}
+		notes = append(notes, &pb.Notification{
+			Id:          ksuid.New().String(),
+			Date:        ptypes.TimestampNow(),
+			Actor:       p.Id,
+			Subject:     address,
+			SubjectDesc: name,
+			Type:        pb.Notification_CONTACT_CHANGED,
+			Body:        "added an unverified peer",
+		})
+	}
+	return notes
+}
+
+// unverifiedPeers returns the peers not covered by a verification
+func unverifiedPeers(verification *pb.ContactVerification, peers []*pb.Peer) []*pb.Peer {
+	verified := make(map[string]struct{})
+	for _, id := range verification.Peers {
+		verified[id] = struct{}{}
+	}
+
+	var list []*pb.Peer
+	for _, p := range peers {
+		if _, ok := verified[p.Id]; !ok {
+			list = append(list, p)
+		}
+	}
+	return list
+}
@@ -2,16 +2,44 @@ package core
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/b582q9/go-textile-sapien/broadcast"
+	"github.com/b582q9/go-textile-sapien/keypair"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 )
 
+// ErrInvalidContact indicates a contact card with peers from another account
+var ErrInvalidContact = fmt.Errorf("invalid contact")
+
+// ErrInvalidContactSignature indicates a contact card not signed by its account key
+var ErrInvalidContactSignature = fmt.Errorf("invalid contact signature")
+
+// ErrUnsignedContact indicates an unsigned contact card for a verified contact
+var ErrUnsignedContact = fmt.Errorf("verified contacts only accept signed cards")
+
 // AddContact adds or updates a card
+// Signed cards are verified against the card's account address. Unsigned cards, e.g., search
+// results, are added as unverified contacts and are rejected once a contact is verified.
 func (t *Textile) AddContact(card *pb.Contact) error {
+	for _, peer := range card.Peers {
+		if peer.Address != card.Address {
+			return ErrInvalidContact
+		}
+	}
+
+	signed := card.Sig != nil
+	if signed {
+		if err := verifyContactCard(card); err != nil {
+			return err
+		}
+	} else if t.datastore.ContactVerifications().Get(card.Address) != nil {
+		return ErrUnsignedContact
+	}
+
 	var err error
 	for _, peer := range card.Peers {
 		err = t.AddPeer(peer)
@@ -20,9 +48,32 @@ func (t *Textile) AddContact(card *pb.Contact) error {
 		}
 	}
 
+	// a valid signature from the account key vouches for the card's peers
+	if signed {
+		return t.extendContactVerification(card)
+	}
 	return nil
 }
 
+// ContactCard returns own contact card signed by the account key
+func (t *Textile) ContactCard() (*pb.Contact, error) {
+	card := t.AccountContact()
+	if card == nil {
+		return nil, ErrContactNotFound
+	}
+	card.Verified = false
+
+	payload, err := contactCardPayload(card)
+	if err != nil {
+		return nil, err
+	}
+	card.Sig, err = t.account.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
 // Contact looks up a contact by address
 func (t *Textile) Contact(address string) *pb.Contact {
 	return t.contact(address, true)
@@ -40,6 +91,11 @@ func (t *Textile) RemoveContact(address string) error {
 		return fmt.Errorf("cannot remove own contact")
 	}
 
+	err := t.datastore.ContactVerifications().Delete(address)
+	if err != nil {
+		return err
+	}
+
 	return t.datastore.Peers().DeleteByAddress(address)
 }
 
@@ -199,6 +255,11 @@ func (t *Textile) contactView(contact *pb.Contact, addThreads bool) *pb.Contact
 		}
 	}
 
+	verification := t.datastore.ContactVerifications().Get(contact.Address)
+	if verification != nil {
+		contact.Verified = len(unverifiedPeers(verification, contact.Peers)) == 0
+	}
+
 	return ensureContactUser(contact)
 }
 
@@ -220,3 +281,35 @@ func ensureContactUser(contact *pb.Contact) *pb.Contact {
 	}
 	return contact
 }
+
+// contactCardPayload returns the bytes covered by a contact card signature
+func contactCardPayload(card *pb.Contact) ([]byte, error) {
+	peers := make([]*pb.Peer, len(card.Peers))
+	copy(peers, card.Peers)
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Id < peers[j].Id
+	})
+
+	return proto.Marshal(&pb.Contact{
+		Address: card.Address,
+		Name:    card.Name,
+		Avatar:  card.Avatar,
+		Peers:   peers,
+	})
+}
+
+// verifyContactCard checks a card's signature against its account address
+func verifyContactCard(card *pb.Contact) error {
+	accnt, err := keypair.Parse(card.Address)
+	if err != nil || accnt.Address() != card.Address {
+		return ErrInvalidContact
+	}
+	payload, err := contactCardPayload(card)
+	if err != nil {
+		return err
+	}
+	if err := accnt.Verify(payload, card.Sig); err != nil {
+		return ErrInvalidContactSignature
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/b582q9/go-textile-sapien/audit"
 	"github.com/b582q9/go-textile-sapien/broadcast"
 	"github.com/b582q9/go-textile-sapien/ipfs"
 	"github.com/b582q9/go-textile-sapien/keypair"
@@ -20,7 +22,9 @@ import (
 	"github.com/b582q9/go-textile-sapien/repo/config"
 	"github.com/b582q9/go-textile-sapien/repo/db"
 	"github.com/b582q9/go-textile-sapien/service"
+	"github.com/b582q9/go-textile-sapien/ssl"
 	"github.com/b582q9/go-textile-sapien/util"
+	icid "github.com/ipfs/go-cid"
 	utilmain "github.com/ipfs/go-ipfs/cmd/ipfs/util"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/core/bootstrap"
@@ -60,13 +64,18 @@ type InitConfig struct {
 	CafeApiAddr     string
 	GatewayAddr     string
 	ProfilingAddr   string
+	MetricsAddr     string
+	DisableMetrics  bool
 	IsMobile        bool
 	IsServer        bool
+	IsLAN           bool
 	LogToDisk       bool
 	Debug           bool
 	CafeOpen        bool
 	CafeURL         string
 	CafeNeighborURL string
+	CafeNeighbors   []string
+	CafeAdminToken  string
 }
 
 // MigrateConfig is used to define options during a major migration
@@ -100,6 +109,7 @@ type Textile struct {
 	done              chan struct{}
 	updates           chan *pb.AccountUpdate
 	threadUpdates     *broadcast.Broadcaster
+	threadPresence    *broadcast.Broadcaster
 	notifications     chan *pb.Notification
 	threads           *ThreadsService
 	blockOutbox       *BlockOutbox
@@ -108,8 +118,17 @@ type Textile struct {
 	cafeOutbox        *CafeOutbox
 	cafeOutboxHandler CafeOutboxHandler
 	cafeInbox         *CafeInbox
+	lan               *LanService
+	metrics           *http.Server
+	tls               *ssl.Server
+	tlsRedirect       *http.Server
+	metricsCollector  *nodeCollector
+	auditLog          *audit.Log
 	checkMessages     func() error
 	cancelSync        *broadcast.Broadcaster
+	profileUpdates    chan struct{}
+	profilePins       []icid.Cid
+	profileLock       sync.Mutex
 	lock              sync.Mutex
 	writer            io.Writer
 }
@@ -250,6 +269,40 @@ func MigrateRepo(conf MigrateConfig) error {
 	return repo.MigrateUp(conf.RepoPath, conf.PinCode, false)
 }
 
+// PendingRepoMigrations lists the migrations MigrateRepo would apply
+func PendingRepoMigrations(repoPath string) ([]repo.MigrationStep, error) {
+	if !fsrepo.IsInitialized(repoPath) {
+		return nil, repo.ErrRepoDoesNotExist
+	}
+
+	return repo.PendingMigrations(repoPath)
+}
+
+// RollbackRepo restores the datastore snapshot taken before the last migration run
+func RollbackRepo(repoPath string) error {
+	if !fsrepo.IsInitialized(repoPath) {
+		return repo.ErrRepoDoesNotExist
+	}
+
+	// force open the repo and datastore
+	removeLocks(repoPath)
+
+	return repo.RollbackMigrations(repoPath, false)
+}
+
+// ChangeRepoPin sets, changes, or removes the datastore pin of a repo which is not running.
+// An empty oldPin denotes an unencrypted datastore, an empty newPin removes encryption.
+func ChangeRepoPin(repoPath string, oldPin string, newPin string) error {
+	if !fsrepo.IsInitialized(repoPath) {
+		return repo.ErrRepoDoesNotExist
+	}
+
+	// force open the datastore
+	removeLocks(repoPath)
+
+	return db.ChangePin(repoPath, oldPin, newPin)
+}
+
 // NewTextile runs a node out of an initialized repo
 func NewTextile(conf RunConfig) (*Textile, error) {
 	if !fsrepo.IsInitialized(conf.RepoPath) {
@@ -270,6 +323,7 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 		pinCode:           conf.PinCode,
 		updates:           make(chan *pb.AccountUpdate, 10),
 		threadUpdates:     broadcast.NewBroadcaster(10),
+		threadPresence:    broadcast.NewBroadcaster(10),
 		notifications:     make(chan *pb.Notification, 10),
 		cafeOutboxHandler: conf.CafeOutboxHandler,
 		checkMessages:     conf.CheckMessages,
@@ -313,6 +367,34 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 	return node, nil
 }
 
+// ChangePin re-encrypts the datastore with a new pin. The node must be stopped.
+// An empty oldPin denotes an unencrypted datastore, an empty newPin removes encryption.
+func (t *Textile) ChangePin(oldPin string, newPin string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.started {
+		return ErrStarted
+	}
+	if oldPin != t.pinCode {
+		return db.ErrInvalidPin
+	}
+
+	t.datastore.Close()
+	err := db.ChangePin(t.repoPath, oldPin, newPin)
+	if err == nil {
+		t.pinCode = newPin
+	}
+
+	sqliteDb, err2 := db.Create(t.repoPath, t.pinCode)
+	if err2 != nil {
+		return err2
+	}
+	t.datastore = sqliteDb
+
+	return err
+}
+
 // Start creates an ipfs node and starts textile services
 func (t *Textile) Start() error {
 	t.lock.Lock()
@@ -324,6 +406,7 @@ func (t *Textile) Start() error {
 
 	t.online = make(chan struct{})
 	t.done = make(chan struct{})
+	t.profileUpdates = make(chan struct{}, 1)
 
 	_, err := repo.LoadPlugins(t.repoPath)
 	if err != nil {
@@ -331,7 +414,9 @@ func (t *Textile) Start() error {
 	}
 
 	// ensure older peers get latest profiles
-	if t.Mobile() {
+	if t.LAN() {
+		err = ensureProfile(lanProfile, t.repoPath)
+	} else if t.Mobile() {
 		err = ensureProfile(mobileProfile, t.repoPath)
 	} else if t.Server() {
 		err = ensureProfile(serverProfile, t.repoPath)
@@ -355,6 +440,11 @@ func (t *Textile) Start() error {
 		return err
 	}
 
+	err = t.openAudit()
+	if err != nil {
+		return err
+	}
+
 	// create queues
 	t.blockDownloads = NewBlockDownloads(
 		t.Ipfs,
@@ -364,7 +454,8 @@ func (t *Textile) Start() error {
 		t.cafeService,
 		t.threadsService,
 		t.Ipfs,
-		t.datastore)
+		t.datastore,
+		t.sendNotification)
 	t.cafeOutbox = NewCafeOutbox(
 		t.Ipfs,
 		t.datastore,
@@ -374,7 +465,9 @@ func (t *Textile) Start() error {
 		t.threadsService,
 		t.Ipfs,
 		t.datastore,
-		t.cafeOutbox)
+		t.cafeOutbox,
+		t.LAN(),
+		t.sendNotification)
 
 	// create services
 	t.threads = NewThreadsService(
@@ -384,16 +477,25 @@ func (t *Textile) Start() error {
 		t.Thread,
 		t.handleThreadAdd,
 		t.RemoveThread,
-		t.sendNotification)
+		t.sendNotification,
+		t.threadPresence)
 	t.cafe = NewCafeService(
 		t.account,
 		t.Ipfs,
 		t.datastore,
-		t.cafeInbox)
+		t.cafeInbox,
+		t.sendNotification,
+		t.auditAs)
 
 	if t.cafeOutbox.handler == nil {
 		t.cafeOutbox.handler = t.cafe
 	}
+	if t.LAN() {
+		t.lan = NewLanService(
+			t.Ipfs,
+			t.datastore,
+			t.blockOutbox)
+	}
 
 	// start the ipfs node
 	log.Debug("creating an ipfs node...")
@@ -402,6 +504,15 @@ func (t *Textile) Start() error {
 		return err
 	}
 
+	if t.config.Addresses.Metrics != "" {
+		t.startMetrics(t.config.Addresses.Metrics)
+	}
+
+	err = t.startTLS()
+	if err != nil {
+		return err
+	}
+
 	go func() {
 		defer func() {
 			close(t.online)
@@ -409,7 +520,12 @@ func (t *Textile) Start() error {
 			t.runJobs()
 		}()
 
-		err = t.node.Bootstrap(bootstrap.DefaultBootstrapConfig)
+		bootstrapConfig := bootstrap.DefaultBootstrapConfig
+		if t.LAN() {
+			// peers are found via mdns only
+			bootstrapConfig = bootstrap.BootstrapConfigWithPeers(nil)
+		}
+		err = t.node.Bootstrap(bootstrapConfig)
 		if err != nil {
 			log.Errorf("error bootstrapping ipfs node: %s", err)
 			return
@@ -418,10 +534,23 @@ func (t *Textile) Start() error {
 		t.threads.Start()
 		t.threads.online = true
 
+		if t.lan != nil {
+			err = t.lan.Start()
+			if err != nil {
+				log.Errorf("error starting lan discovery: %s", err)
+			}
+		}
+
 		t.cafe.Start()
 		t.cafe.online = true
 
+		if t.config.Profile.Publish && !t.LAN() {
+			go t.runProfilePublisher()
+		}
+
 		if t.config.Cafe.Host.Open {
+			t.cafe.setNeighbors(t.config)
+			t.cafe.setLimits(t.config)
 			go func() {
 				t.cafe.setAddrs(t.config)
 				t.cafe.open = true
@@ -435,6 +564,10 @@ func (t *Textile) Start() error {
 		}
 		log.Info("node is online")
 
+		if t.LAN() {
+			return
+		}
+
 		// ensure the peer table is not empty by adding our bootstraps
 		boots, err := config.TextileBootstrapPeers()
 		if err != nil {
@@ -515,6 +648,23 @@ func (t *Textile) Stop() error {
 	if err != nil {
 		return err
 	}
+	err = t.stopMetrics()
+	if err != nil {
+		return err
+	}
+	err = t.stopTLS()
+	if err != nil {
+		return err
+	}
+
+	// stop lan discovery
+	if t.lan != nil {
+		err = t.lan.Stop()
+		if err != nil {
+			log.Errorf("error stopping lan discovery: %s", err)
+		}
+		t.lan = nil
+	}
 
 	// close ipfs node
 	err = t.stop()
@@ -524,6 +674,7 @@ func (t *Textile) Stop() error {
 
 	// close db connection
 	t.datastore.Close()
+	t.closeAudit()
 	dsLockFile := filepath.Join(t.repoPath, "datastore", "LOCK")
 	_ = os.Remove(dsLockFile)
 
@@ -539,6 +690,7 @@ func (t *Textile) Stop() error {
 func (t *Textile) CloseChns() {
 	close(t.updates)
 	t.threadUpdates.Close()
+	t.threadPresence.Close()
 	close(t.notifications)
 }
 
@@ -575,6 +727,11 @@ func (t *Textile) Server() bool {
 	return t.config.IsServer
 }
 
+// LAN returns whether or not node is configured for LAN-only messaging
+func (t *Textile) LAN() bool {
+	return t.config.IsLAN
+}
+
 // Datastore returns the underlying sqlite datastore interface
 func (t *Textile) Datastore() repo.Datastore {
 	return t.datastore
@@ -745,13 +902,15 @@ func (t *Textile) cafeService() *CafeService {
 
 // createNode constructs an IpfsNode
 func (t *Textile) createNode() error {
-	rep, err := fsrepo.Open(t.repoPath)
+	rep, err := t.openRepo()
 	if err != nil {
 		return err
 	}
 
 	routing := libp2p.DHTOption
-	if t.Mobile() {
+	if t.LAN() {
+		routing = libp2p.NilRouterOption
+	} else if t.Mobile() {
 		routing = libp2p.DHTClientOption
 	}
 
@@ -908,6 +1067,10 @@ func (t *Textile) loadThread(mod *pb.Thread) (*Thread, error) {
 	}
 	t.loadedThreads = append(t.loadedThreads, thrd)
 
+	if t.threads != nil && t.threads.online {
+		t.threads.subscribePresence(thrd.Id)
+	}
+
 	return thrd, nil
 }
 
@@ -939,6 +1102,11 @@ func (t *Textile) sendThreadUpdate(block *pb.Block, key string) {
 		return
 	}
 
+	// the profile links the heads of web published threads
+	if t.ThreadWebPublished(block.Thread) {
+		t.profileChanged()
+	}
+
 	update, err := t.feedItem(block, feedItemOpts{})
 	if err != nil {
 		log.Errorf("error building thread update: %s", err)
@@ -950,6 +1118,10 @@ func (t *Textile) sendThreadUpdate(block *pb.Block, key string) {
 
 // sendNotification adds a notification to the notification channel
 func (t *Textile) sendNotification(note *pb.Notification) error {
+	if peerBlocked(t.datastore, note.Actor) {
+		return nil
+	}
+
 	if err := t.datastore.Notifications().Add(note); err != nil {
 		return err
 	}
@@ -975,6 +1147,11 @@ func (t *Textile) touchDatastore() error {
 
 // runPeriodicGC periodically runs repo blockstore GC
 func (t *Textile) runPeriodicGC() {
+	if t.remoteBlockstore() {
+		t.runRemoteGC()
+		return
+	}
+
 	errc := make(chan error)
 	go func() {
 		errc <- corerepo.PeriodicGC(t.node.Context(), t.node)
@@ -12,6 +12,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/mill"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/schema/textile"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/segmentio/ksuid"
 )
 
@@ -168,6 +169,93 @@ func TestTextile_AddContactAgain(t *testing.T) {
 	}
 }
 
+func TestTextile_ContactCard(t *testing.T) {
+	card, err := vars.node.ContactCard()
+	if err != nil {
+		t.Fatalf("get contact card failed: %s", err)
+	}
+	if err := verifyContactCard(card); err != nil {
+		t.Fatalf("verify contact card failed: %s", err)
+	}
+
+	card.Name = "impostor"
+	if err := verifyContactCard(card); err != ErrInvalidContactSignature {
+		t.Fatal("tampered contact card should not verify")
+	}
+}
+
+func TestTextile_VerifyContact(t *testing.T) {
+	if err := vars.node.VerifyContact(util.TestContact.Address); err != nil {
+		t.Fatalf("verify contact failed: %s", err)
+	}
+	if !vars.node.Contact(util.TestContact.Address).Verified {
+		t.Fatal("contact should be verified")
+	}
+
+	// unsigned cards can't add peers to a verified contact
+	err := vars.node.AddContact(&pb.Contact{
+		Address: util.TestContact.Address,
+		Peers: []*pb.Peer{{
+			Id:      "fghij",
+			Address: util.TestContact.Address,
+		}},
+	})
+	if err != ErrUnsignedContact {
+		t.Fatalf("expected unsigned card to be rejected, got %v", err)
+	}
+	if vars.node.datastore.Peers().Get("fghij") != nil {
+		t.Fatal("peer from an unsigned card should not be added")
+	}
+	if !vars.node.Contact(util.TestContact.Address).Verified {
+		t.Fatal("contact should still be verified")
+	}
+}
+
+func TestTextile_BlockAccount(t *testing.T) {
+	address := keypair.Random().Address()
+	if err := vars.node.BlockAccount(address); err != nil {
+		t.Fatalf("block account failed: %s", err)
+	}
+	if !vars.node.IsBlocked(address) {
+		t.Fatal("account should be blocked")
+	}
+	if len(vars.node.BlockedAccounts().Items) != 1 {
+		t.Fatal("blocklist should have one item")
+	}
+
+	// blocks authored by the account are excluded before paginating
+	peer := &pb.Peer{Id: ksuid.New().String(), Address: address}
+	if err := vars.node.datastore.Peers().Add(peer); err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.Block{
+		Id:     ksuid.New().String(),
+		Thread: ksuid.New().String(),
+		Author: peer.Id,
+		Type:   pb.Block_TEXT,
+		Date:   ptypes.TimestampNow(),
+	}
+	if err := vars.node.datastore.Blocks().Add(block); err != nil {
+		t.Fatal(err)
+	}
+	query := fmt.Sprintf("threadId='%s' or authorId='%s'", block.Thread, peer.Id)
+	if len(vars.node.Blocks("", 1, query).Items) != 0 {
+		t.Fatal("blocks from a blocked account should be excluded")
+	}
+
+	if err := vars.node.UnblockAccount(address); err != nil {
+		t.Fatalf("unblock account failed: %s", err)
+	}
+	if vars.node.IsBlocked(address) {
+		t.Fatal("account should not be blocked")
+	}
+	if len(vars.node.Blocks("", 1, query).Items) != 1 {
+		t.Fatal("blocks from an unblocked account should be included")
+	}
+	_ = vars.node.datastore.Blocks().Delete(block.Id)
+	_ = vars.node.datastore.Peers().Delete(peer.Id)
+}
+
 func TestTextile_GetMedia(t *testing.T) {
 	f, err := os.Open("../mill/testdata/image.jpeg")
 	if err != nil {
@@ -258,6 +346,126 @@ func TestTextile_AddFile(t *testing.T) {
 	}
 }
 
+func TestTextile_AddReaction(t *testing.T) {
+	query := fmt.Sprintf("threadId='%s' and type=%d", vars.thread.Id, pb.Block_FILES)
+	target := vars.node.Blocks("", 1, query).Items[0].Id
+
+	if _, err := vars.thread.AddReaction(target, "hi"); err != ErrInvalidReaction {
+		t.Fatal("text reaction should be invalid")
+	}
+	if _, err := vars.thread.AddReaction(target, "🎉"); err != nil {
+		t.Fatalf("add reaction failed: %s", err)
+	}
+	if _, err := vars.thread.AddReaction(target, ":tada:"); err != nil {
+		t.Fatalf("add shortcode reaction failed: %s", err)
+	}
+	if _, err := vars.thread.AddLike(target); err != nil {
+		t.Fatalf("add like failed: %s", err)
+	}
+	if len(vars.node.reactionSummaries(target)) != 3 {
+		t.Fatal("wrong number of reaction summaries")
+	}
+
+	if _, err := vars.thread.AddUnreact(target, "🎉"); err != nil {
+		t.Fatalf("add unreact failed: %s", err)
+	}
+	reactions, err := vars.node.Reactions(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reactions.Items) != 2 {
+		t.Fatal("unreact should remove reaction")
+	}
+}
+
+func TestTextile_MarkThreadRead(t *testing.T) {
+	if err := vars.node.MarkThreadRead(vars.thread.Id, ""); err != nil {
+		t.Fatalf("mark thread read failed: %s", err)
+	}
+
+	view, err := vars.node.ThreadView(vars.thread.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if view.UnreadCount != 0 {
+		t.Fatal("thread should not have unread blocks")
+	}
+
+	query := fmt.Sprintf("threadId='%s' and type=%d", vars.node.AccountThread().Id, pb.Block_READ)
+	if vars.node.datastore.Blocks().Count(query) != 1 {
+		t.Fatal("read position should be synced to the account thread")
+	}
+}
+
+func TestTextile_SendThreadRead(t *testing.T) {
+	blocks := vars.node.Blocks("", 1, "threadId='"+vars.thread.Id+"'")
+	if len(blocks.Items) == 0 {
+		t.Fatal("thread should have blocks")
+	}
+	if err := vars.node.SendThreadRead(vars.thread.Id, blocks.Items[0].Id); err != nil {
+		t.Fatalf("send thread read failed: %s", err)
+	}
+
+	reads, err := vars.node.ThreadReads(vars.thread.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reads.Items) != 1 || reads.Items[0].Block != blocks.Items[0].Id {
+		t.Fatal("thread read was not recorded")
+	}
+}
+
+func TestTextile_QueueItems(t *testing.T) {
+	msg := pb.BlockMessage{
+		Id:       ksuid.New().String(),
+		Peer:     "QmPeer",
+		Env:      &pb.Envelope{Message: &pb.Message{Type: pb.Message_THREAD_ENVELOPE}},
+		Date:     ptypes.TimestampNow(),
+		Attempts: blockOutMaxAttempts - 1,
+	}
+	if err := vars.node.datastore.BlockMessages().Add(&msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// running out of attempts should fail the message
+	vars.node.blockOutbox.handleErr(fmt.Errorf("unreachable"), msg)
+	item, err := vars.node.QueueItem(msg.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !item.Failed || item.LastError != "unreachable" || item.Queue != pb.QueueItem_BLOCK_OUTBOX {
+		t.Fatal("block message should be failed")
+	}
+	failed := vars.node.QueueItems(true, pb.QueueItem_BLOCK_OUTBOX)
+	if len(failed.Items) != 1 || failed.Items[0].Id != msg.Id {
+		t.Fatal("failed block message should be listed")
+	}
+	notes := vars.node.Notifications("", -1)
+	if len(notes.Items) == 0 || notes.Items[0].Type != pb.Notification_QUEUE_ITEM_FAILED {
+		t.Fatal("failed block message should generate a notification")
+	}
+
+	// retry
+	if err := vars.node.datastore.BlockMessages().Retry(msg.Id); err != nil {
+		t.Fatal(err)
+	}
+	item, err = vars.node.QueueItem(msg.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Failed || item.Attempts != 0 {
+		t.Fatal("retried block message should be reset")
+	}
+
+	// purge
+	if _, err := vars.node.PurgeQueues(false, pb.QueueItem_BLOCK_OUTBOX); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vars.node.QueueItem(msg.Id); err != ErrQueueItemNotFound {
+		t.Fatal("purged block message should not be found")
+	}
+}
+
 func TestTextile_Stop(t *testing.T) {
 	err := vars.node.Stop()
 	if err != nil {
@@ -18,6 +18,7 @@ var flatFeedTypes = []pb.Block_BlockType{
 	pb.Block_TEXT,
 	pb.Block_COMMENT,
 	pb.Block_LIKE,
+	pb.Block_REACTION,
 }
 
 var annotatedFeedTypes = []pb.Block_BlockType{
@@ -37,6 +38,7 @@ type feedItemOpts struct {
 	annotations bool
 	comments    []*pb.Comment
 	likes       []*pb.Like
+	reactions   []*pb.ReactionSummary
 	target      *pb.FeedItem
 }
 
@@ -165,6 +167,8 @@ func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, er
 		payload, err = t.comment(block, opts)
 	case pb.Block_LIKE:
 		payload, err = t.like(block, opts)
+	case pb.Block_REACTION:
+		payload, err = t.reaction(block, opts)
 	default:
 		return nil, nil
 	}
@@ -203,6 +207,8 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 				return err
 			}
 			likes = append(likes, like)
+		case pb.Block_REACTION:
+			// aggregated from the index below, since un-reacts are not in the feed
 		default:
 			target = child
 		}
@@ -234,8 +240,9 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 	}
 
 	targetItem, err := t.feedItem(target, feedItemOpts{
-		comments: comments,
-		likes:    likes,
+		comments:  comments,
+		likes:     likes,
+		reactions: t.reactionSummaries(target.Id),
 	})
 	if err != nil {
 		return nil, err
@@ -298,6 +305,8 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 		payload = new(pb.Comment)
 	case pb.Block_LIKE:
 		payload = new(pb.Like)
+	case pb.Block_REACTION:
+		payload = new(pb.Reaction)
 	default:
 		return nil, fmt.Errorf("unable to parse payload")
 	}
@@ -310,7 +319,7 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 
 func getTargetId(block *pb.Block) string {
 	switch block.Type {
-	case pb.Block_COMMENT, pb.Block_LIKE:
+	case pb.Block_COMMENT, pb.Block_LIKE, pb.Block_REACTION:
 		return block.Target
 	default:
 		return block.Id
@@ -319,7 +328,7 @@ func getTargetId(block *pb.Block) string {
 
 func isAnnotation(block *pb.Block) bool {
 	switch block.Type {
-	case pb.Block_COMMENT, pb.Block_LIKE:
+	case pb.Block_COMMENT, pb.Block_LIKE, pb.Block_REACTION:
 		return true
 	default:
 		return false
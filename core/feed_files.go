@@ -61,6 +61,10 @@ func (t *Textile) fileAtData(data string) ([]*pb.File, error) {
 			return nil, err
 		}
 
+		if i < 0 || i >= len(files) {
+			return nil, fmt.Errorf("invalid file index %s", index.Name)
+		}
+
 		f := &pb.File{Index: int32(i)}
 		if looksLikeFileNode(node) {
 			file, err := t.fileIndexForPair(node)
@@ -70,15 +74,19 @@ func (t *Textile) fileAtData(data string) ([]*pb.File, error) {
 			f.File = file
 
 		} else {
+			// links vary w/ the schema version the file was added under,
+			// and unpinned links may be unavailable, so skip what can't be read
 			f.Links = make(map[string]*pb.FileIndex)
 			for _, link := range node.Links() {
 				pair, err := ipfs.NodeAtLink(t.node, link)
 				if err != nil {
-					return nil, err
+					log.Warningf("unable to read link %s of %s: %s", link.Name, data, err)
+					continue
 				}
 				file, err := t.fileIndexForPair(pair)
 				if err != nil {
-					return nil, err
+					log.Warningf("unable to read link %s of %s: %s", link.Name, data, err)
+					continue
 				}
 				if file != nil {
 					f.Links[link.Name] = file
@@ -124,9 +132,11 @@ func (t *Textile) file(block *pb.Block, opts feedItemOpts) (*pb.Files, error) {
 			return nil, err
 		}
 		item.Likes = likes.Items
+		item.Reactions = t.reactionSummaries(block.Id)
 	} else {
 		item.Comments = opts.comments
 		item.Likes = opts.likes
+		item.Reactions = opts.reactions
 	}
 
 	return item, nil
@@ -21,8 +21,10 @@ func (t *Textile) join(block *pb.Block, opts feedItemOpts) (*pb.Join, error) {
 			return nil, err
 		}
 		item.Likes = likes.Items
+		item.Reactions = t.reactionSummaries(block.Id)
 	} else {
 		item.Likes = opts.likes
+		item.Reactions = opts.reactions
 	}
 
 	return item, nil
@@ -64,9 +64,11 @@ func (t *Textile) message(block *pb.Block, opts feedItemOpts) (*pb.Text, error)
 			return nil, err
 		}
 		item.Likes = likes.Items
+		item.Reactions = t.reactionSummaries(block.Id)
 	} else {
 		item.Comments = opts.comments
 		item.Likes = opts.likes
+		item.Reactions = opts.reactions
 	}
 
 	return item, nil
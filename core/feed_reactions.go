@@ -0,0 +1,123 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+)
+
+// Reactions lists the active reactions on a target, including legacy likes
+func (t *Textile) Reactions(target string) (*pb.ReactionList, error) {
+	reactions := make([]*pb.Reaction, 0)
+
+	for _, block := range t.activeReactions(target) {
+		info, err := t.reaction(block, feedItemOpts{annotations: true})
+		if err != nil {
+			continue
+		}
+		reactions = append(reactions, info)
+	}
+
+	return &pb.ReactionList{Items: reactions}, nil
+}
+
+func (t *Textile) Reaction(blockId string) (*pb.Reaction, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.reaction(block, feedItemOpts{annotations: true})
+}
+
+func (t *Textile) reaction(block *pb.Block, opts feedItemOpts) (*pb.Reaction, error) {
+	item := &pb.Reaction{
+		Id:   block.Id,
+		Date: block.Date,
+		User: t.PeerUser(block.Author),
+	}
+	switch block.Type {
+	case pb.Block_REACTION:
+		item.Emoji = block.Body
+	case pb.Block_LIKE:
+		item.Emoji = LikeReaction
+	default:
+		return nil, ErrBlockWrongType
+	}
+
+	if opts.target != nil {
+		item.Target = opts.target
+	} else if !opts.annotations {
+		target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{})
+		if err != nil {
+			return nil, err
+		}
+		item.Target = target
+	}
+
+	return item, nil
+}
+
+// reactionSummaries aggregates the active reactions on a target by emoji
+func (t *Textile) reactionSummaries(target string) []*pb.ReactionSummary {
+	summaries := make([]*pb.ReactionSummary, 0)
+	index := make(map[string]*pb.ReactionSummary)
+
+	blocks := t.activeReactions(target)
+	for i := len(blocks) - 1; i >= 0; i-- { // oldest first
+		block := blocks[i]
+		emoji := block.Body
+		if block.Type == pb.Block_LIKE {
+			emoji = LikeReaction
+		}
+		summary, ok := index[emoji]
+		if !ok {
+			summary = &pb.ReactionSummary{Emoji: emoji}
+			index[emoji] = summary
+			summaries = append(summaries, summary)
+		}
+		summary.Count++
+		summary.Users = append(summary.Users, t.PeerUser(block.Author))
+	}
+
+	return summaries
+}
+
+// activeReactions returns the latest reaction per author and emoji on a target,
+// dropping those which have since been un-reacted
+func (t *Textile) activeReactions(target string) []*pb.Block {
+	active := make([]*pb.Block, 0)
+
+	query := fmt.Sprintf("(type=%d or type=%d or type=%d) and target='%s'",
+		pb.Block_LIKE, pb.Block_REACTION, pb.Block_UNREACT, target)
+	seen := make(map[string]struct{})
+	for _, block := range t.Blocks("", -1, query).Items { // newest first
+		emoji := block.Body
+		if block.Type == pb.Block_LIKE {
+			emoji = LikeReaction
+		}
+		key := t.reactionAuthor(block.Author) + "/" + emoji
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if block.Type != pb.Block_UNREACT {
+			active = append(active, block)
+		}
+	}
+
+	return active
+}
+
+// reactionAuthor returns the account address of a peer, falling back to its id,
+// so that reactions from account peers count as one
+func (t *Textile) reactionAuthor(peerId string) string {
+	if peerId == t.node.Identity.Pretty() {
+		return t.account.Address()
+	}
+	peer := t.datastore.Peers().Get(peerId)
+	if peer == nil || peer.Address == "" {
+		return peerId
+	}
+	return peer.Address
+}
@@ -5,7 +5,6 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -125,7 +124,7 @@ func (t *Textile) GetMedia(reader io.Reader) (string, error) {
 		return "", err
 	}
 
-	return http.DetectContentType(buffer[:n]), nil
+	return m.DetectMedia(buffer[:n]), nil
 }
 
 func (t *Textile) GetMillMedia(reader io.Reader, mill m.Mill) (string, error) {
@@ -233,6 +232,16 @@ func (t *Textile) FileMeta(hash string) (*pb.FileIndex, error) {
 	return file, nil
 }
 
+// FileMetaAtPath returns the file index of the data behind an ipfs path,
+// if it's a known local file
+func (t *Textile) FileMetaAtPath(pth string) (*pb.FileIndex, error) {
+	node, err := ipfs.NodeAtPath(t.node, pth, ipfs.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return t.FileMeta(node.Cid().Hash().B58String())
+}
+
 func (t *Textile) FileContent(hash string) (io.ReadSeeker, *pb.FileIndex, error) {
 	var err error
 	var file *pb.FileIndex
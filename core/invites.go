@@ -102,6 +102,13 @@ func (t *Textile) Invites() *pb.InviteViewList {
 
 // AcceptInvite adds a new thread, and notifies the inviter of the join
 func (t *Textile) AcceptInvite(id string) (mh.Multihash, error) {
+	hash, err := t.acceptInvite(id)
+	t.Audit(AuditInviteAccept, id, err)
+	return hash, err
+}
+
+// acceptInvite adds a thread from an internal invite
+func (t *Textile) acceptInvite(id string) (mh.Multihash, error) {
 	invite := t.datastore.Invites().Get(id)
 	if invite == nil {
 		return nil, ErrThreadInviteNotFound
@@ -123,6 +130,13 @@ func (t *Textile) AcceptInvite(id string) (mh.Multihash, error) {
 // AcceptExternalInvite attemps to download an encrypted thread key from an external invite,
 // adds a new thread, and notifies the inviter of the join
 func (t *Textile) AcceptExternalInvite(id string, key []byte) (mh.Multihash, error) {
+	hash, err := t.acceptExternalInvite(id, key)
+	t.Audit(AuditInviteAccept, id, err)
+	return hash, err
+}
+
+// acceptExternalInvite adds a thread from an external invite
+func (t *Textile) acceptExternalInvite(id string, key []byte) (mh.Multihash, error) {
 	node, err := ipfs.NodeAtPath(t.node, fmt.Sprintf("%s", id), ipfs.CatTimeout)
 	if err != nil {
 		return nil, err
@@ -172,6 +186,9 @@ func (t *Textile) handleThreadAdd(plaintext []byte, parents []string) (mh.Multih
 	if msg.Thread == nil || msg.Inviter == nil {
 		return nil, ErrInvalidThreadBlock
 	}
+	if t.IsBlocked(msg.Inviter.Address) {
+		return nil, ErrAccountBlocked
+	}
 
 	// check if we're allowed to get an invite
 	// Note: just using a dummy thread here because having these access+sharing
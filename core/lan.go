@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/ipfs/go-ipfs/core"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+// lanServiceTag is the mdns service tag advertised by textile peers in LAN mode
+const lanServiceTag = "_textile-lan._udp"
+
+// lanDiscoveryInterval is how often the local network is queried for peers
+const lanDiscoveryInterval = time.Second * 5
+
+// lanConnectTimeout is the max time to wait when dialing a discovered peer
+const lanConnectTimeout = time.Second * 10
+
+// LanService discovers account peers and thread members on the local network
+// via mdns and delivers queued block messages as soon as they are connected
+type LanService struct {
+	node      func() *core.IpfsNode
+	datastore repo.Datastore
+	outbox    *BlockOutbox
+	mdns      discovery.Service
+	notifiee  *network.NotifyBundle
+}
+
+// NewLanService returns a new LAN discovery service
+func NewLanService(
+	node func() *core.IpfsNode,
+	datastore repo.Datastore,
+	outbox *BlockOutbox) *LanService {
+	return &LanService{
+		node:      node,
+		datastore: datastore,
+		outbox:    outbox,
+	}
+}
+
+// Start starts mdns discovery and watches for new connections
+func (s *LanService) Start() error {
+	n := s.node()
+
+	mdns, err := discovery.NewMdnsService(n.Context(), n.PeerHost, lanDiscoveryInterval, lanServiceTag)
+	if err != nil {
+		return err
+	}
+	mdns.RegisterNotifee(s)
+	s.mdns = mdns
+
+	s.notifiee = &network.NotifyBundle{
+		ConnectedF: func(_ network.Network, conn network.Conn) {
+			go s.outbox.FlushPeer(conn.RemotePeer().Pretty())
+		},
+	}
+	n.PeerHost.Network().Notify(s.notifiee)
+
+	log.Info("lan discovery started")
+	return nil
+}
+
+// Stop stops mdns discovery
+func (s *LanService) Stop() error {
+	if s.notifiee != nil {
+		s.node().PeerHost.Network().StopNotify(s.notifiee)
+		s.notifiee = nil
+	}
+	if s.mdns == nil {
+		return nil
+	}
+	err := s.mdns.Close()
+	s.mdns = nil
+	return err
+}
+
+// HandlePeerFound connects to a discovered peer if it's an account peer or thread member
+func (s *LanService) HandlePeerFound(pi peer.AddrInfo) {
+	n := s.node()
+	if pi.ID == n.Identity || !s.member(pi.ID.Pretty()) {
+		return
+	}
+	if n.PeerHost.Network().Connectedness(pi.ID) == network.Connected {
+		return
+	}
+	log.Debugf("found %s on the local network", pi.ID.Pretty())
+
+	ctx, cancel := context.WithTimeout(n.Context(), lanConnectTimeout)
+	defer cancel()
+	err := n.PeerHost.Connect(ctx, pi)
+	if err != nil {
+		log.Debugf("error connecting to %s: %s", pi.ID.Pretty(), err)
+	}
+}
+
+// member returns whether or not a peer is known to us via an account or thread
+func (s *LanService) member(id string) bool {
+	if s.datastore.Peers().Get(id) != nil {
+		return true
+	}
+	return len(s.datastore.ThreadPeers().ListById(id)) > 0
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+var lanVars = struct {
+	node1InitConfig InitConfig
+	node2InitConfig InitConfig
+
+	node1 *Textile
+	node2 *Textile
+}{
+	node1InitConfig: InitConfig{
+		BaseRepoPath: "./testdata/.textile5",
+		Debug:        true,
+		SwarmPorts:   "4101",
+		IsLAN:        true,
+	},
+	node2InitConfig: InitConfig{
+		BaseRepoPath: "./testdata/.textile6",
+		Debug:        true,
+		SwarmPorts:   "4102",
+		IsLAN:        true,
+	},
+}
+
+func TestCore_SetupLAN(t *testing.T) {
+	var err error
+	lanVars.node1, err = CreateAndStartPeer(lanVars.node1InitConfig, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lanVars.node2, err = CreateAndStartPeer(lanVars.node2InitConfig, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lanVars.node1.lan == nil || lanVars.node2.lan == nil {
+		t.Fatal("lan discovery should be running")
+	}
+}
+
+func TestCore_LANDelivery(t *testing.T) {
+	node1 := lanVars.node1
+	node2 := lanVars.node2
+	node2Id := node2.Ipfs().Identity.Pretty()
+
+	thrd, err := addTestThread(node1, &pb.AddThreadConfig{
+		Key:  "lan",
+		Name: "lan",
+		Schema: &pb.AddThreadConfig_Schema{
+			Preset: pb.AddThreadConfig_Schema_BLOB,
+		},
+		Type:    pb.Thread_OPEN,
+		Sharing: pb.Thread_SHARED,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// make node2 a thread member
+	err = node1.datastore.Peers().Add(&pb.Peer{
+		Id:      node2Id,
+		Address: node2.Account().Address(),
+		Created: ptypes.TimestampNow(),
+		Updated: ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = node1.datastore.ThreadPeers().Add(&pb.ThreadPeer{
+		Id:       node2Id,
+		Thread:   thrd.Id,
+		Welcomed: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without a connection, messages should stay queued
+	_, err = thrd.AddMessage("", "hello over lan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node1.FlushBlocks()
+	if len(node1.datastore.BlockMessages().ListByPeer(node2Id)) == 0 {
+		t.Fatal("block message should be queued until node2 is seen")
+	}
+
+	// simulate mdns finding node2 on loopback
+	var addrs []ma.Multiaddr
+	for _, addr := range node2.Ipfs().PeerHost.Addrs() {
+		if _, err := addr.ValueForProtocol(ma.P_IP4); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+	node1.lan.HandlePeerFound(peer.AddrInfo{
+		ID:    node2.Ipfs().Identity,
+		Addrs: addrs,
+	})
+
+	deadline := time.Now().Add(time.Second * 30)
+	for len(node1.datastore.BlockMessages().ListByPeer(node2Id)) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("queued block messages should be delivered once node2 is seen")
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+}
+
+func TestCore_TeardownLAN(t *testing.T) {
+	_ = lanVars.node1.Stop()
+	_ = lanVars.node2.Stop()
+	lanVars.node1 = nil
+	lanVars.node2 = nil
+}
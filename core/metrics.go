@@ -0,0 +1,147 @@
+package core
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/metrics"
+	"github.com/ipfs/go-ipfs/core/corerepo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricsPath is where metrics are served on the metrics address
+const metricsPath = "/metrics"
+
+var (
+	queueItemsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "queue", "items"),
+		"Number of queued items by queue and state.",
+		[]string{"queue", "state"}, nil)
+	cafeClientsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cafe", "clients"),
+		"Number of clients registered with this cafe.",
+		nil, nil)
+	repoSizeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "repo", "size_bytes"),
+		"Bytes stored in the ipfs repo, including pinned content.",
+		nil, nil)
+	repoStorageMaxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "repo", "storage_max_bytes"),
+		"Configured max size of the ipfs repo.",
+		nil, nil)
+)
+
+// MetricsAddr returns the metrics address, empty if not serving metrics
+func (t *Textile) MetricsAddr() string {
+	if t.metrics == nil {
+		return ""
+	}
+	return t.metrics.Addr
+}
+
+// startMetrics serves prometheus metrics on the given address
+func (t *Textile) startMetrics(addr string) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Errorf("error starting metrics listener: %s", err)
+		return
+	}
+
+	collector := &nodeCollector{node: t}
+	err = metrics.Registry.Register(collector)
+	if err != nil {
+		log.Errorf("error registering node metrics: %s", err)
+		_ = listener.Close()
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, metrics.Handler())
+	t.metrics = &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+	t.metricsCollector = collector
+
+	go func() {
+		err := t.metrics.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("metrics error: %s", err)
+		}
+	}()
+	log.Infof("metrics listening at %s%s", addr, metricsPath)
+}
+
+// stopMetrics stops serving metrics
+func (t *Textile) stopMetrics() error {
+	if t.metrics == nil {
+		return nil
+	}
+	metrics.Registry.Unregister(t.metricsCollector)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := t.metrics.Shutdown(ctx)
+	t.metrics = nil
+	t.metricsCollector = nil
+	if err != nil {
+		log.Errorf("error shutting down metrics: %s", err)
+		return err
+	}
+	return nil
+}
+
+// nodeCollector reads node state at scrape time
+type nodeCollector struct {
+	node *Textile
+}
+
+// Describe implements prometheus.Collector
+func (c *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueItemsDesc
+	ch <- cafeClientsDesc
+	ch <- repoSizeDesc
+	ch <- repoStorageMaxDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	if !c.node.Started() {
+		return
+	}
+
+	counts := make(map[string][2]float64)
+	for _, queue := range queueSet(nil) {
+		counts[queue.String()] = [2]float64{}
+	}
+	for _, item := range c.node.QueueItems(false).Items {
+		count := counts[item.Queue.String()]
+		if item.Failed {
+			count[1]++
+		} else {
+			count[0]++
+		}
+		counts[item.Queue.String()] = count
+	}
+	for queue, count := range counts {
+		ch <- prometheus.MustNewConstMetric(queueItemsDesc, prometheus.GaugeValue, count[0], queue, "pending")
+		ch <- prometheus.MustNewConstMetric(queueItemsDesc, prometheus.GaugeValue, count[1], queue, "failed")
+	}
+
+	ch <- prometheus.MustNewConstMetric(cafeClientsDesc, prometheus.GaugeValue,
+		float64(c.node.datastore.CafeClients().Count()))
+
+	node := c.node.Ipfs()
+	if node == nil {
+		return
+	}
+	stat, err := corerepo.RepoSize(node.Context(), node)
+	if err != nil {
+		log.Warningf("error reading repo size: %s", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(repoSizeDesc, prometheus.GaugeValue, float64(stat.RepoSize))
+	ch <- prometheus.MustNewConstMetric(repoStorageMaxDesc, prometheus.GaugeValue, float64(stat.StorageMax))
+}
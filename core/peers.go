@@ -103,6 +103,9 @@ func peersEqual(a *pb.Peer, b *pb.Peer) bool {
 	if a.Avatar != b.Avatar {
 		return false
 	}
+	if a.Profile != b.Profile {
+		return false
+	}
 	if len(a.Inboxes) != len(b.Inboxes) {
 		return false
 	}
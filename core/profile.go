@@ -36,6 +36,7 @@ func (t *Textile) SetName(name string) error {
 			return err
 		}
 	}
+	t.profileChanged()
 
 	return t.PublishPeer()
 }
@@ -71,6 +72,7 @@ func (t *Textile) SetAvatar() error {
 			return err
 		}
 	}
+	t.profileChanged()
 
 	return t.PublishPeer()
 }
@@ -0,0 +1,383 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/strkey"
+	"github.com/b582q9/go-textile-sapien/wallet"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	uio "github.com/ipfs/go-unixfs/io"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+)
+
+// profileKeyName is the ipfs keystore name of the account profile key
+const profileKeyName = "textile-profile"
+
+// profileLinkName is the name of the signed profile in a profile directory
+const profileLinkName = "profile"
+
+// profileSigLinkName is the name of the account signature of the profile
+const profileSigLinkName = "sig"
+
+// avatarLinkName links the avatar file of a profile
+const avatarLinkName = "avatar"
+
+// threadsLinkName links the heads of each web published thread by thread id
+const threadsLinkName = "threads"
+
+// kProfileRepublishFreq is how often the profile is republished,
+// well within the default ipns record lifetime of 24 hours
+const kProfileRepublishFreq = time.Hour * 4
+
+// kProfilePublishDelay collects bursts of changes into a single publish
+const kProfilePublishDelay = time.Second * 10
+
+// kProfileTimeout bounds ipns publishing and resolution
+const kProfileTimeout = time.Minute
+
+// ErrProfileNotFound indicates no profile key is known for an account
+var ErrProfileNotFound = fmt.Errorf("profile not found")
+
+// ErrInvalidProfile indicates a profile was not signed by its account
+var ErrInvalidProfile = fmt.Errorf("invalid profile signature")
+
+// ProfileName returns the ipns name of the account profile
+func (t *Textile) ProfileName() (string, error) {
+	sk, err := t.profileKey()
+	if err != nil {
+		return "", err
+	}
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		return "", err
+	}
+	return id.Pretty(), nil
+}
+
+// PublishProfile publishes a signed directory with the account profile, avatar
+// and the heads of web published threads to the profile ipns name
+func (t *Textile) PublishProfile() (*pb.Profile, error) {
+	t.profileLock.Lock()
+	defer t.profileLock.Unlock()
+
+	prof, root, pins, err := t.buildProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ipfs.PublishIPNS(t.node, root, profileKeyName, kProfileTimeout)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("published profile %s to /ipns/%s", root, prof.Id)
+
+	// release nodes which are no longer part of the profile
+	keep := make(map[icid.Cid]struct{})
+	for _, id := range pins {
+		keep[id] = struct{}{}
+	}
+	for _, id := range t.profilePins {
+		if _, ok := keep[id]; ok {
+			continue
+		}
+		if err := ipfs.UnpinCid(t.node, id, false); err != nil {
+			log.Warningf("error unpinning profile node %s: %s", id.String(), err)
+		}
+	}
+	t.profilePins = pins
+
+	return prof, nil
+}
+
+// ResolveProfile resolves and verifies the public profile of an account.
+// The profile name of other accounts is learned from their peers.
+func (t *Textile) ResolveProfile(address string) (*pb.Profile, error) {
+	kp, err := keypair.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := kp.(*keypair.FromAddress); !ok {
+		return nil, fmt.Errorf("invalid address")
+	}
+
+	var name string
+	if address == t.account.Address() {
+		name, err = t.ProfileName()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		query := fmt.Sprintf("address='%s' and profile!=''", address)
+		peers := t.datastore.Peers().List(query)
+		if len(peers) == 0 {
+			return nil, ErrProfileNotFound
+		}
+		name = peers[0].Profile
+	}
+
+	id, err := peer.Decode(name)
+	if err != nil {
+		return nil, err
+	}
+	root, err := ipfs.ResolveIPNS(t.node, id, kProfileTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ipfs.DataAtPath(t.node, root.String()+"/"+profileLinkName)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := ipfs.DataAtPath(t.node, root.String()+"/"+profileSigLinkName)
+	if err != nil {
+		return nil, err
+	}
+	if err := kp.Verify(data, sig); err != nil {
+		return nil, ErrInvalidProfile
+	}
+
+	prof := new(pb.Profile)
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), prof); err != nil {
+		return nil, err
+	}
+	// a signed profile is only valid under its own name
+	if prof.Address != address || prof.Id != name {
+		return nil, ErrInvalidProfile
+	}
+	return prof, nil
+}
+
+// profileKey derives the profile key from the account seed
+func (t *Textile) profileKey() (libp2pc.PrivKey, error) {
+	seed, err := strkey.Decode(strkey.VersionByteSeed, t.account.Seed())
+	if err != nil {
+		return nil, err
+	}
+	key, err := wallet.DeriveForPath(wallet.TextileProfilePath, seed)
+	if err != nil {
+		return nil, err
+	}
+	raw := key.RawSeed()
+	sk, _, err := libp2pc.GenerateEd25519Key(bytes.NewReader(raw[:]))
+	if err != nil {
+		return nil, err
+	}
+	return sk, nil
+}
+
+// setupProfileKey adds the profile key to the ipfs keystore and
+// advertises its name on the local peer
+func (t *Textile) setupProfileKey() error {
+	sk, err := t.profileKey()
+	if err != nil {
+		return err
+	}
+
+	ks := t.node.Repo.Keystore()
+	has, err := ks.Has(profileKeyName)
+	if err != nil {
+		return err
+	}
+	if has {
+		existing, err := ks.Get(profileKeyName)
+		if err != nil {
+			return err
+		}
+		if !existing.Equals(sk) {
+			if err := ks.Delete(profileKeyName); err != nil {
+				return err
+			}
+			has = false
+		}
+	}
+	if !has {
+		if err := ks.Put(profileKeyName, sk); err != nil {
+			return err
+		}
+	}
+
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		return err
+	}
+	self := t.Profile()
+	if self != nil && self.Profile != id.Pretty() {
+		return t.datastore.Peers().UpdateProfile(self.Id, id.Pretty())
+	}
+	return nil
+}
+
+// buildProfile writes the profile directory, returning its root and the nodes
+// pinned for it. Avatar and thread nodes are already pinned by their threads.
+func (t *Textile) buildProfile() (*pb.Profile, string, []icid.Cid, error) {
+	name, err := t.ProfileName()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	prof := &pb.Profile{
+		Id:      name,
+		Address: t.account.Address(),
+		Name:    t.Name(),
+		Avatar:  t.Avatar(),
+		Date:    ptypes.TimestampNow(),
+	}
+	for _, id := range t.config.Gateway.PublishedThreads {
+		if !t.ThreadWebPublished(id) {
+			continue
+		}
+		thrd := t.Thread(id)
+		heads, err := thrd.Heads()
+		if err != nil {
+			return nil, "", nil, err
+		}
+		prof.Threads = append(prof.Threads, &pb.ProfileThread{
+			Id:    thrd.Id,
+			Name:  thrd.Name,
+			Heads: heads,
+		})
+	}
+
+	data, err := pbMarshaler.MarshalToString(prof)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	sig, err := t.account.Sign([]byte(data))
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	var pins []icid.Cid
+	dir := uio.NewDirectory(t.node.DAG)
+	pid, err := ipfs.AddDataToDirectory(t.node, dir, profileLinkName, bytes.NewReader([]byte(data)))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	sid, err := ipfs.AddDataToDirectory(t.node, dir, profileSigLinkName, bytes.NewReader(sig))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	pins = append(pins, *pid, *sid)
+
+	if prof.Avatar != "" {
+		err = ipfs.AddLinkToDirectory(t.node, dir, avatarLinkName, prof.Avatar)
+		if err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	if len(prof.Threads) > 0 {
+		tdir := uio.NewDirectory(t.node.DAG)
+		for _, thrd := range prof.Threads {
+			hdir := uio.NewDirectory(t.node.DAG)
+			for i, head := range thrd.Heads {
+				err = ipfs.AddLinkToDirectory(t.node, hdir, strconv.Itoa(i), head)
+				if err != nil {
+					return nil, "", nil, err
+				}
+			}
+			hnode, err := hdir.GetNode()
+			if err != nil {
+				return nil, "", nil, err
+			}
+			err = ipfs.PinNode(t.node, hnode, false)
+			if err != nil {
+				return nil, "", nil, err
+			}
+			pins = append(pins, hnode.Cid())
+			err = ipfs.AddLinkToDirectory(t.node, tdir, thrd.Id, hnode.Cid().Hash().B58String())
+			if err != nil {
+				return nil, "", nil, err
+			}
+		}
+		tnode, err := tdir.GetNode()
+		if err != nil {
+			return nil, "", nil, err
+		}
+		err = ipfs.PinNode(t.node, tnode, false)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		pins = append(pins, tnode.Cid())
+		err = ipfs.AddLinkToDirectory(t.node, dir, threadsLinkName, tnode.Cid().Hash().B58String())
+		if err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	node, err := dir.GetNode()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	for _, id := range []icid.Cid{*pid, *sid} {
+		nd, err := ipfs.NodeAtCid(t.node, id)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		err = ipfs.PinNode(t.node, nd, false)
+		if err != nil {
+			return nil, "", nil, err
+		}
+	}
+	err = ipfs.PinNode(t.node, node, false)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	pins = append(pins, node.Cid())
+
+	return prof, node.Cid().Hash().B58String(), pins, nil
+}
+
+// profileChanged schedules a profile republish
+func (t *Textile) profileChanged() {
+	select {
+	case t.profileUpdates <- struct{}{}:
+	default:
+	}
+}
+
+// runProfilePublisher publishes the profile on start, on change and on a schedule
+func (t *Textile) runProfilePublisher() {
+	if err := t.setupProfileKey(); err != nil {
+		log.Errorf("error setting up profile key: %s", err)
+		return
+	}
+
+	tick := time.NewTicker(kProfileRepublishFreq)
+	defer tick.Stop()
+
+	publish := func() {
+		if _, err := t.PublishProfile(); err != nil {
+			log.Errorf("error publishing profile: %s", err)
+		}
+	}
+	publish()
+
+	var delay <-chan time.Time
+	for {
+		select {
+		case <-tick.C:
+			publish()
+
+		case <-t.profileUpdates:
+			if delay == nil {
+				delay = time.After(kProfilePublishDelay)
+			}
+
+		case <-delay:
+			delay = nil
+			publish()
+
+		case <-t.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/segmentio/ksuid"
+)
+
+// ErrQueueItemNotFound indicates a queue item does not exist
+var ErrQueueItemNotFound = fmt.Errorf("queue item not found")
+
+// ErrInvalidQueue indicates a queue name is unknown
+var ErrInvalidQueue = fmt.Errorf("queue must be one of block_outbox, cafe_outbox or cafe_inbox")
+
+// ParseQueue returns the queue with the given name, e.g., "cafe_outbox"
+func ParseQueue(name string) (pb.QueueItem_Queue, error) {
+	queue, ok := pb.QueueItem_Queue_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, ErrInvalidQueue
+	}
+	return pb.QueueItem_Queue(queue), nil
+}
+
+// QueueItems lists pending and failed items in the given queues, all if empty,
+// oldest first
+func (t *Textile) QueueItems(failedOnly bool, queues ...pb.QueueItem_Queue) *pb.QueueItemList {
+	items := make([]*pb.QueueItem, 0)
+
+	for _, queue := range queueSet(queues) {
+		switch queue {
+		case pb.QueueItem_BLOCK_OUTBOX:
+			for _, msg := range t.datastore.BlockMessages().ListQueued() {
+				items = append(items, blockMessageItem(msg))
+			}
+		case pb.QueueItem_CAFE_OUTBOX:
+			for _, req := range t.datastore.CafeRequests().ListQueued().Items {
+				items = append(items, cafeRequestItem(req))
+			}
+		case pb.QueueItem_CAFE_INBOX:
+			for _, msg := range t.datastore.CafeMessages().ListQueued() {
+				items = append(items, cafeMessageItem(msg))
+			}
+		}
+	}
+
+	if failedOnly {
+		failed := make([]*pb.QueueItem, 0)
+		for _, item := range items {
+			if item.Failed {
+				failed = append(failed, item)
+			}
+		}
+		items = failed
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return util.ProtoTime(items[i].Date).Before(util.ProtoTime(items[j].Date))
+	})
+
+	return &pb.QueueItemList{Items: items}
+}
+
+// QueueItem returns a single queue item
+func (t *Textile) QueueItem(id string) (*pb.QueueItem, error) {
+	if msg := t.datastore.BlockMessages().Get(id); msg != nil {
+		return blockMessageItem(*msg), nil
+	}
+	if req := t.datastore.CafeRequests().Get(id); req != nil && req.Status != pb.CafeRequest_COMPLETE {
+		return cafeRequestItem(req), nil
+	}
+	if msg := t.datastore.CafeMessages().Get(id); msg != nil {
+		return cafeMessageItem(*msg), nil
+	}
+	return nil, ErrQueueItemNotFound
+}
+
+// RetryQueueItem resets the attempts of a queue item and flushes its queue
+func (t *Textile) RetryQueueItem(id string) error {
+	item, err := t.QueueItem(id)
+	if err != nil {
+		return err
+	}
+
+	err = t.retryQueueItem(item)
+	if err != nil {
+		return err
+	}
+
+	t.flushQueueItems(item.Queue)
+	return nil
+}
+
+// RetryQueues resets the attempts of all failed items in the given queues,
+// all if empty, and flushes them
+func (t *Textile) RetryQueues(queues ...pb.QueueItem_Queue) (int, error) {
+	var count int
+	for _, item := range t.QueueItems(true, queues...).Items {
+		err := t.retryQueueItem(item)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	t.flushQueueItems(queueSet(queues)...)
+	return count, nil
+}
+
+// RemoveQueueItem drops a single queue item
+func (t *Textile) RemoveQueueItem(id string) error {
+	item, err := t.QueueItem(id)
+	if err != nil {
+		return err
+	}
+	return t.removeQueueItem(item)
+}
+
+// PurgeQueues drops items from the given queues, all if empty
+func (t *Textile) PurgeQueues(failedOnly bool, queues ...pb.QueueItem_Queue) (int, error) {
+	var count int
+	for _, item := range t.QueueItems(failedOnly, queues...).Items {
+		err := t.removeQueueItem(item)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// retryQueueItem resets a queue item's attempts
+func (t *Textile) retryQueueItem(item *pb.QueueItem) error {
+	switch item.Queue {
+	case pb.QueueItem_BLOCK_OUTBOX:
+		// a manual retry should not wait on the peer's backoff schedule
+		if err := t.datastore.PeerHealth().Delete(item.Target); err != nil {
+			return err
+		}
+		return t.datastore.BlockMessages().Retry(item.Id)
+	case pb.QueueItem_CAFE_OUTBOX:
+		return t.datastore.CafeRequests().Retry(item.Id)
+	case pb.QueueItem_CAFE_INBOX:
+		return t.datastore.CafeMessages().Retry(item.Id)
+	}
+	return ErrInvalidQueue
+}
+
+// removeQueueItem deletes a queue item
+func (t *Textile) removeQueueItem(item *pb.QueueItem) error {
+	switch item.Queue {
+	case pb.QueueItem_BLOCK_OUTBOX:
+		return t.datastore.BlockMessages().Delete(item.Id)
+	case pb.QueueItem_CAFE_OUTBOX:
+		return t.datastore.CafeRequests().Delete(item.Id)
+	case pb.QueueItem_CAFE_INBOX:
+		return t.datastore.CafeMessages().Delete(item.Id)
+	}
+	return ErrInvalidQueue
+}
+
+// flushQueueItems flushes the given queues in the background
+func (t *Textile) flushQueueItems(queues ...pb.QueueItem_Queue) {
+	if !t.Online() {
+		return
+	}
+	for _, queue := range queues {
+		switch queue {
+		case pb.QueueItem_BLOCK_OUTBOX:
+			go t.blockOutbox.Flush()
+		case pb.QueueItem_CAFE_OUTBOX:
+			go t.cafeOutbox.Flush(true)
+		case pb.QueueItem_CAFE_INBOX:
+			go t.cafeInbox.Flush()
+		}
+	}
+}
+
+// queueSet returns all queues if none are given
+func queueSet(queues []pb.QueueItem_Queue) []pb.QueueItem_Queue {
+	if len(queues) > 0 {
+		return queues
+	}
+	return []pb.QueueItem_Queue{
+		pb.QueueItem_BLOCK_OUTBOX,
+		pb.QueueItem_CAFE_OUTBOX,
+		pb.QueueItem_CAFE_INBOX,
+	}
+}
+
+func blockMessageItem(msg pb.BlockMessage) *pb.QueueItem {
+	var mtype string
+	if msg.Env != nil && msg.Env.Message != nil {
+		mtype = msg.Env.Message.Type.String()
+	}
+	return &pb.QueueItem{
+		Id:        msg.Id,
+		Queue:     pb.QueueItem_BLOCK_OUTBOX,
+		Target:    msg.Peer,
+		Type:      mtype,
+		Attempts:  msg.Attempts,
+		LastError: msg.LastError,
+		Failed:    msg.Failed,
+		Date:      msg.Date,
+	}
+}
+
+func cafeRequestItem(req *pb.CafeRequest) *pb.QueueItem {
+	return &pb.QueueItem{
+		Id:        req.Id,
+		Queue:     pb.QueueItem_CAFE_OUTBOX,
+		Target:    req.Cafe.Peer,
+		Type:      req.Type.String(),
+		Attempts:  req.Attempts,
+		LastError: req.LastError,
+		Failed:    req.Status == pb.CafeRequest_FAILED,
+		Date:      req.Date,
+	}
+}
+
+func cafeMessageItem(msg pb.CafeMessage) *pb.QueueItem {
+	return &pb.QueueItem{
+		Id:        msg.Id,
+		Queue:     pb.QueueItem_CAFE_INBOX,
+		Target:    msg.Peer,
+		Type:      "MESSAGE",
+		Attempts:  msg.Attempts,
+		LastError: msg.LastError,
+		Failed:    msg.Failed,
+		Date:      msg.Date,
+	}
+}
+
+// queueFailedNotification returns a notification for an item which has run out of attempts
+func queueFailedNotification(
+	actor string,
+	queue pb.QueueItem_Queue,
+	id string,
+	target string,
+	attempts int32,
+	reason string,
+) *pb.Notification {
+	return &pb.Notification{
+		Id:          ksuid.New().String(),
+		Date:        ptypes.TimestampNow(),
+		Actor:       actor,
+		Subject:     queue.String(),
+		SubjectDesc: strings.ToLower(queue.String()),
+		Block:       id,
+		Target:      target,
+		Type:        pb.Notification_QUEUE_ITEM_FAILED,
+		Body:        fmt.Sprintf("failed after %d attempts: %s", attempts, reason),
+	}
+}
@@ -0,0 +1,215 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	m "github.com/b582q9/go-textile-sapien/mill"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/schema"
+	ipld "github.com/ipfs/go-ipld-format"
+	uio "github.com/ipfs/go-unixfs/io"
+)
+
+// RemillThread regenerates links which were added to a thread's schema after its files
+// were added, e.g., a new image size, returning the number of updated file blocks.
+// The regenerated data is only indexed locally, the original blocks are unchanged.
+func (t *Textile) RemillThread(id string) (int, error) {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return 0, ErrThreadNotFound
+	}
+	if thrd.Schema == nil {
+		return 0, ErrThreadSchemaRequired
+	}
+	if len(thrd.Schema.Links) == 0 {
+		return 0, nil
+	}
+
+	steps, err := schema.Steps(thrd.Schema.Links)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	query := fmt.Sprintf("threadId='%s' and type=%d", thrd.Id, pb.Block_FILES)
+	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+		node, err := t.remillData(block.Data, steps)
+		if err != nil {
+			log.Warningf("unable to re-mill %s: %s", block.Id, err)
+			continue
+		}
+		if node == nil {
+			continue
+		}
+
+		block.Data = node.Cid().Hash().B58String()
+		err = t.datastore.Blocks().Replace(block)
+		if err != nil {
+			return count, err
+		}
+		err = thrd.indexFileData(node, block.Data)
+		if err != nil {
+			return count, err
+		}
+		count++
+
+		log.Debugf("re-milled %s: %s", block.Id, block.Data)
+	}
+
+	return count, nil
+}
+
+// remillData rebuilds a file data node with missing links, returning nil if nothing was missing
+func (t *Textile) remillData(data string, steps []pb.Step) (ipld.Node, error) {
+	links, err := ipfs.LinksAtPath(t.node, data)
+	if err != nil {
+		return nil, err
+	}
+
+	outer := uio.NewDirectory(t.node.DAG)
+	var changed bool
+	for _, index := range links {
+		node, err := ipfs.NodeAtLink(t.node, index)
+		if err != nil {
+			return nil, err
+		}
+
+		if !looksLikeFileNode(node) {
+			dir, err := t.remillDir(node, steps)
+			if err != nil {
+				return nil, err
+			}
+			if dir != nil {
+				node = dir
+				changed = true
+			}
+		}
+
+		err = ipfs.AddLinkToDirectory(t.node, outer, index.Name, node.Cid().Hash().B58String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	node, err := outer.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	err = ipfs.PinNode(t.node, node, false)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// remillDir mills links missing from a file directory, returning nil if nothing was missing
+func (t *Textile) remillDir(inode ipld.Node, steps []pb.Step) (ipld.Node, error) {
+	files := make(map[string]*pb.FileIndex)
+	for _, link := range inode.Links() {
+		pair, err := ipfs.NodeAtLink(t.node, link)
+		if err != nil {
+			return nil, err
+		}
+		file, err := t.fileIndexForPair(pair)
+		if err != nil {
+			return nil, err
+		}
+		if file != nil {
+			files[link.Name] = file
+		}
+	}
+
+	dir, err := uio.NewDirectoryFromNode(t.node.DAG, inode)
+	if err != nil {
+		return nil, err
+	}
+
+	var added bool
+	for _, step := range steps {
+		if files[step.Name] != nil {
+			continue
+		}
+
+		// the original input is only available if it was stored as a blob
+		var src *pb.FileIndex
+		if step.Link.Use == schema.FileTag {
+			for _, s := range steps {
+				if s.Link.Use == schema.FileTag && s.Link.Mill == "/blob" {
+					src = files[s.Name]
+					break
+				}
+			}
+		} else {
+			src = files[step.Link.Use]
+		}
+		if src == nil {
+			continue
+		}
+
+		mil, err := m.ForLink(step.Link, files)
+		if err != nil {
+			return nil, err
+		}
+		if mil == nil {
+			continue
+		}
+
+		reader, err := t.FileIndexContent(src)
+		if err != nil {
+			return nil, err
+		}
+		input, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		conf := AddFileConfig{
+			Input:     input,
+			Plaintext: step.Link.Plaintext,
+		}
+		if step.Link.Use == schema.FileTag {
+			conf.Name = src.Name
+		} else {
+			conf.Use = src.Checksum
+		}
+		if mil.ID() == "/json" {
+			conf.Media = "application/json"
+		} else {
+			conf.Media, err = t.GetMillMedia(bytes.NewReader(input), mil)
+			if err != nil {
+				log.Debugf("skipping %s: %s", step.Name, err)
+				continue
+			}
+		}
+
+		file, err := t.AddFileIndex(mil, conf)
+		if err != nil {
+			return nil, err
+		}
+		err = t.fileNode(file, dir, step.Name)
+		if err != nil {
+			return nil, err
+		}
+		files[step.Name] = file
+		added = true
+	}
+	if !added {
+		return nil, nil
+	}
+
+	node, err := dir.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	err = ipfs.PinNode(t.node, node, false)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -14,7 +14,9 @@ import (
 )
 
 func CreateAndStartPeer(conf InitConfig, wait bool) (*Textile, error) {
-	conf.Account = keypair.Random()
+	if conf.Account == nil {
+		conf.Account = keypair.Random()
+	}
 
 	repo, err := conf.Repo()
 	if err != nil {
@@ -16,7 +16,6 @@ import (
 	"github.com/b582q9/go-textile-sapien/repo/db"
 	"github.com/b582q9/go-textile-sapien/schema"
 	"github.com/b582q9/go-textile-sapien/util"
-	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/ipfs/go-ipfs/core"
@@ -91,6 +90,8 @@ type Thread struct {
 	PrivKey        libp2pc.PrivKey
 	Schema         *pb.Node
 	schemaId       string
+	schemas        map[string]*pb.Node // loaded schema versions
+	schemasLock    sync.Mutex
 	initiator      string
 	ttype          pb.Thread_Type
 	sharing        pb.Thread_Sharing
@@ -180,16 +181,6 @@ func (t *Thread) Decrypt(data []byte) ([]byte, error) {
 	return crypto.Decrypt(t.PrivKey, data)
 }
 
-// UpdateSchema sets a new schema hash on the model and loads its node
-func (t *Thread) UpdateSchema(hash string) error {
-	err := t.datastore.Threads().UpdateSchema(t.Id, hash)
-	if err != nil {
-		return err
-	}
-	t.Schema = nil
-	return t.loadSchema()
-}
-
 // followParents follows a list of node links, queueing block downloads along the way
 // Note: Returns a final list of existing parent hashes that were reached during the tree traversal
 func (t *Thread) followParents(parents []string) []string {
@@ -338,6 +329,14 @@ func (t *Thread) handle(bnode *blockNode, replace bool) (*pb.Block, error) {
 		res, err = t.handleCommentBlock(block)
 	case pb.Block_LIKE:
 		res, err = t.handleLikeBlock(block)
+	case pb.Block_BLOCKLIST:
+		res, err = t.handleBlocklistBlock(block)
+	case pb.Block_READ:
+		res, err = t.handleReadBlock(block)
+	case pb.Block_REACTION, pb.Block_UNREACT:
+		res, err = t.handleReactionBlock(block)
+	case pb.Block_SCHEMA:
+		res, err = t.handleSchemaBlock(block)
 	default:
 		err = fmt.Errorf("invalid type: %s", block.Type)
 	}
@@ -879,26 +878,14 @@ func (t *Thread) loadSchema() error {
 		return nil
 	}
 
-	data, err := ipfs.DataAtPath(t.node(), t.schemaId)
+	sch, err := t.loadSchemaNode(t.schemaId)
 	if err != nil {
 		if err == ipld.ErrNotFound {
 			return nil
 		}
 		return err
 	}
-
-	var sch pb.Node
-	err = jsonpb.UnmarshalString(string(data), &sch)
-	if err != nil {
-		return err
-	}
-	t.Schema = &sch
-
-	// pin/repin to ensure remotely added schemas are readily accessible
-	_, err = ipfs.AddData(t.node(), bytes.NewReader(data), true, false)
-	if err != nil {
-		return err
-	}
+	t.Schema = sch
 
 	return nil
 }
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// AddBlocklist adds an outgoing blocklist block to the account thread
+func (t *Thread) AddBlocklist(addresses []string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.Id != t.config.Account.Thread {
+		return nil, ErrInvalidThreadBlock
+	}
+
+	msg := &pb.ThreadBlocklist{
+		Addresses: addresses,
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_BLOCKLIST, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_BLOCKLIST,
+		Date:   res.header.Date,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added BLOCKLIST to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleBlocklistBlock handles an incoming blocklist block
+// The latest blocklist from an account peer replaces the local blocklist.
+func (t *Thread) handleBlocklistBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadBlocklist)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if t.Id != t.config.Account.Thread || block.Header.Address != t.config.Account.Address {
+		return res, ErrInvalidThreadBlock
+	}
+
+	// ignore blocklists older than the one we have
+	query := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_BLOCKLIST)
+	latest := t.datastore.Blocks().List("", 1, query).Items
+	if len(latest) > 0 && !util.ProtoTsIsNewer(block.Header.Date, latest[0].Date) {
+		return res, nil
+	}
+
+	err = t.datastore.BlockedAccounts().DeleteAll()
+	if err != nil {
+		return res, err
+	}
+	for _, addr := range msg.Addresses {
+		if addr == t.config.Account.Address {
+			continue
+		}
+		err = t.datastore.BlockedAccounts().Add(&pb.BlockedAccount{
+			Address: addr,
+			Date:    block.Header.Date,
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/util"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	icid "github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/mr-tron/base58/base58"
@@ -147,8 +148,8 @@ func (t *Thread) handleFilesBlock(bnode *blockNode, block *pb.ThreadBlock) (hand
 			return res, err
 		}
 
-		// validate and apply schema directives
-		err = t.processFileData(t.Schema, node, msg.Keys, true)
+		// validate and apply the schema directives current at this block
+		err = t.processVersionedFileData(block.Header.Date, node, msg.Keys, true)
 		if err != nil {
 			return res, err
 		}
@@ -247,7 +248,7 @@ func (t *Thread) processFileData(node *pb.Node, inode ipld.Node, keys map[string
 		if err != nil {
 			return err
 		}
-		err = t.processFileNode(t.Schema, nd, i, keys, inbound)
+		err = t.processFileNode(node, nd, i, keys, inbound)
 		if err != nil {
 			return err
 		}
@@ -260,7 +261,7 @@ func (t *Thread) processFileData(node *pb.Node, inode ipld.Node, keys map[string
 func (t *Thread) processFileNode(node *pb.Node, inode ipld.Node, index int, keys map[string]string, inbound bool) error {
 	if len(node.Links) == 0 {
 		key := keys["/"+strconv.Itoa(index)+"/"]
-		return t.processFileLink(inode, node.Pin, node.Mill, key, inbound)
+		return t.processFileLink(inode, node.Pin, node.Mill, node.JsonSchema, key, inbound)
 	}
 
 	for name, l := range node.Links {
@@ -275,8 +276,12 @@ func (t *Thread) processFileNode(node *pb.Node, inode ipld.Node, index int, keys
 			return err
 		}
 
+		jschema := l.JsonSchema
+		if jschema == nil {
+			jschema = node.JsonSchema
+		}
 		key := keys["/"+strconv.Itoa(index)+"/"+name+"/"]
-		err = t.processFileLink(n, l.Pin, l.Mill, key, inbound)
+		err = t.processFileLink(n, l.Pin, l.Mill, jschema, key, inbound)
 		if err != nil {
 			return err
 		}
@@ -294,7 +299,7 @@ func (t *Thread) processFileNode(node *pb.Node, inode ipld.Node, index int, keys
 }
 
 // processFileLink validates and pins file nodes
-func (t *Thread) processFileLink(inode ipld.Node, pin bool, mil string, key string, inbound bool) error {
+func (t *Thread) processFileLink(inode ipld.Node, pin bool, mil string, jschema *structpb.Struct, key string, inbound bool) error {
 	flink := schema.LinkByName(inode.Links(), ValidMetaLinkNames)
 	if flink == nil {
 		return ErrMissingMetaLink
@@ -305,7 +310,7 @@ func (t *Thread) processFileLink(inode ipld.Node, pin bool, mil string, key stri
 	}
 
 	if mil == "/json" {
-		err := t.validateJsonNode(inode, key)
+		err := t.validateJsonNode(inode, jschema, key)
 		if err != nil {
 			return err
 		}
@@ -322,9 +327,9 @@ func (t *Thread) processFileLink(inode ipld.Node, pin bool, mil string, key stri
 	return nil
 }
 
-// validateJsonNode validates the node against schema's json schema
-func (t *Thread) validateJsonNode(inode ipld.Node, key string) error {
-	if t.Schema.JsonSchema == nil {
+// validateJsonNode validates the node against a json schema
+func (t *Thread) validateJsonNode(inode ipld.Node, jschema *structpb.Struct, key string) error {
+	if jschema == nil {
 		return ErrJsonSchemaRequired
 	}
 
@@ -349,12 +354,12 @@ func (t *Thread) validateJsonNode(inode ipld.Node, key string) error {
 		plaintext = data
 	}
 
-	jschema, err := pbMarshaler.MarshalToString(t.Schema.JsonSchema)
+	jstr, err := pbMarshaler.MarshalToString(jschema)
 	if err != nil {
 		return err
 	}
 
-	sch := gojsonschema.NewStringLoader(jschema)
+	sch := gojsonschema.NewStringLoader(jstr)
 	doc := gojsonschema.NewStringLoader(string(plaintext))
 
 	result, err := gojsonschema.Validate(sch, doc)
@@ -0,0 +1,258 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/broadcast"
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+)
+
+// SendThreadRead publishes a read marker for a thread block and records our own pointer
+func (t *Textile) SendThreadRead(threadId string, blockId string) error {
+	thrd := t.Thread(threadId)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+	block := t.datastore.Blocks().Get(blockId)
+	if block == nil || block.Thread != threadId {
+		return ErrBlockNotFound
+	}
+
+	date := ptypes.TimestampNow()
+	err := t.datastore.ThreadReads().AddOrUpdate(&pb.ThreadRead{
+		Thread: threadId,
+		Peer:   t.node.Identity.Pretty(),
+		Block:  blockId,
+		Date:   date,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !t.Online() {
+		return nil
+	}
+	return thrd.publishPresence(&pb.ThreadPresence{
+		Type:  pb.ThreadPresence_READ,
+		Block: blockId,
+		Date:  date,
+	})
+}
+
+// SendThreadTyping publishes a typing indicator to a thread
+func (t *Textile) SendThreadTyping(threadId string, typing bool) error {
+	thrd := t.Thread(threadId)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+	if !t.Online() {
+		return ErrOffline
+	}
+
+	return thrd.publishPresence(&pb.ThreadPresence{
+		Type:   pb.ThreadPresence_TYPING,
+		Typing: typing,
+		Date:   ptypes.TimestampNow(),
+	})
+}
+
+// ThreadReads lists the last-read pointers of each peer in a thread
+func (t *Textile) ThreadReads(threadId string) (*pb.ThreadReadList, error) {
+	if t.Thread(threadId) == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	list := t.datastore.ThreadReads().ListByThread(threadId)
+	for _, read := range list.Items {
+		read.User = t.PeerUser(read.Peer)
+	}
+	return list, nil
+}
+
+// ThreadPresenceListener returns a listener for read markers and typing indicators
+// Values are either *pb.ThreadRead or *pb.ThreadTyping.
+func (t *Textile) ThreadPresenceListener() *broadcast.Listener {
+	return t.threadPresence.Listen()
+}
+
+// publishPresence encrypts and publishes a presence message to the thread's topic
+func (t *Thread) publishPresence(msg *pb.ThreadPresence) error {
+	if !t.readable(t.config.Account.Address) {
+		return ErrNotReadable
+	}
+
+	plaintext, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := t.Encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	return t.service().publishPresence(&pb.ThreadPresenceEnvelope{
+		Thread:     t.Id,
+		Ciphertext: ciphertext,
+	})
+}
+
+// presenceTopic returns the pubsub topic used for a thread's presence messages
+func presenceTopic(threadId string) string {
+	return string(threadsServiceProtocol) + "/presence/" + threadId
+}
+
+// publishPresence signs and publishes a presence envelope
+func (h *ThreadsService) publishPresence(penv *pb.ThreadPresenceEnvelope) error {
+	env, err := h.service.NewEnvelope(pb.Message_THREAD_PRESENCE, penv, nil, false)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	return ipfs.Publish(h.service.Node(), presenceTopic(penv.Thread), data)
+}
+
+// subscribePresence starts listening for presence messages on a thread's topic
+func (h *ThreadsService) subscribePresence(threadId string) {
+	h.presenceLock.Lock()
+	defer h.presenceLock.Unlock()
+
+	if _, ok := h.presenceSubs[threadId]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(h.service.Node().Context())
+	h.presenceSubs[threadId] = cancel
+
+	topic := presenceTopic(threadId)
+	msgs := make(chan iface.PubSubMessage, 10)
+	go func() {
+		defer close(msgs)
+		if err := ipfs.Subscribe(h.service.Node(), ctx, topic, false, msgs); err != nil {
+			log.Errorf("presence listener stopped with error: %s", err)
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				if err := h.handlePresence(threadId, msg); err != nil {
+					log.Debugf("error handling presence for %s: %s", threadId, err)
+				}
+			}
+		}
+	}()
+	log.Debugf("presence listener started for %s", topic)
+}
+
+// unsubscribePresence stops listening for presence messages on a thread's topic
+func (h *ThreadsService) unsubscribePresence(threadId string) {
+	h.presenceLock.Lock()
+	defer h.presenceLock.Unlock()
+
+	if cancel, ok := h.presenceSubs[threadId]; ok {
+		cancel()
+		delete(h.presenceSubs, threadId)
+	}
+}
+
+// handlePresence verifies, decrypts and handles a presence message
+func (h *ThreadsService) handlePresence(threadId string, msg iface.PubSubMessage) error {
+	from := msg.From()
+	if from.Pretty() == h.service.Node().Identity.Pretty() {
+		return nil
+	}
+
+	env := new(pb.Envelope)
+	err := proto.Unmarshal(msg.Data(), env)
+	if err != nil {
+		return err
+	}
+	err = h.service.VerifyEnvelope(env, from)
+	if err != nil {
+		return err
+	}
+	if env.Message.Type != pb.Message_THREAD_PRESENCE {
+		return fmt.Errorf("invalid presence message type: %s", env.Message.Type)
+	}
+	penv := new(pb.ThreadPresenceEnvelope)
+	err = ptypes.UnmarshalAny(env.Message.Payload, penv)
+	if err != nil {
+		return err
+	}
+	if penv.Thread != threadId {
+		return ErrInvalidThreadBlock
+	}
+
+	thrd := h.getThread(threadId)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+	plaintext, err := thrd.Decrypt(penv.Ciphertext)
+	if err != nil {
+		return err
+	}
+	pres := new(pb.ThreadPresence)
+	err = proto.Unmarshal(plaintext, pres)
+	if err != nil {
+		return err
+	}
+
+	// sender must be a known peer of this thread
+	peerId := from.Pretty()
+	var member bool
+	for _, tp := range h.datastore.ThreadPeers().ListById(peerId) {
+		if tp.Thread == threadId {
+			member = true
+			break
+		}
+	}
+	if !member || peerBlocked(h.datastore, peerId) {
+		return nil
+	}
+
+	user := h.datastore.Peers().GetBestUser(peerId)
+	switch pres.Type {
+	case pb.ThreadPresence_READ:
+		x := h.datastore.ThreadReads().Get(threadId, peerId)
+		if x != nil && !util.ProtoTsIsNewer(pres.Date, x.Date) {
+			return nil
+		}
+		read := &pb.ThreadRead{
+			Thread: threadId,
+			Peer:   peerId,
+			Block:  pres.Block,
+			Date:   pres.Date,
+		}
+		err = h.datastore.ThreadReads().AddOrUpdate(read)
+		if err != nil {
+			return err
+		}
+		read.User = user
+		h.presence.Send(read)
+
+	case pb.ThreadPresence_TYPING:
+		h.presence.Send(&pb.ThreadTyping{
+			Thread: threadId,
+			Peer:   peerId,
+			Typing: pres.Typing,
+			Date:   pres.Date,
+			User:   user,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// ErrInvalidReaction indicates a reaction is not a single emoji or shortcode
+var ErrInvalidReaction = fmt.Errorf("reaction must be a single emoji or :shortcode:")
+
+// LikeReaction is the reaction legacy like blocks are counted as
+const LikeReaction = "👍"
+
+// maxReactionRunes caps emoji sequences, e.g., zwj families w/ skin tones
+const maxReactionRunes = 16
+
+var shortcodeRx = regexp.MustCompile(`^:[a-z0-9_+\-]{1,32}:$`)
+
+// AddReaction adds an outgoing reaction block
+func (t *Thread) AddReaction(target string, emoji string) (mh.Multihash, error) {
+	return t.addReaction(target, emoji, pb.Block_REACTION)
+}
+
+// AddUnreact adds an outgoing un-react block, which removes our reaction
+// with the same emoji from the target
+func (t *Thread) AddUnreact(target string, emoji string) (mh.Multihash, error) {
+	return t.addReaction(target, emoji, pb.Block_UNREACT)
+}
+
+// addReaction adds an outgoing reaction or un-react block
+func (t *Thread) addReaction(target string, emoji string, btype pb.Block_BlockType) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.annotatable(t.config.Account.Address) {
+		return nil, ErrNotAnnotatable
+	}
+
+	emoji, err := validateReaction(emoji)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.ThreadReaction{
+		Emoji: emoji,
+	}
+
+	res, err := t.commitBlock(msg, btype, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   btype,
+		Date:   res.header.Date,
+		Target: target,
+		Body:   emoji,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added %s to %s: %s", btype.String(), t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleReactionBlock handles an incoming reaction or un-react block
+func (t *Thread) handleReactionBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadReaction)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if !t.annotatable(block.Header.Address) {
+		return res, ErrNotAnnotatable
+	}
+
+	res.body, err = validateReaction(msg.Emoji)
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// validateReaction trims and checks that a reaction is a single emoji
+// sequence or a :shortcode:
+func validateReaction(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if shortcodeRx.MatchString(emoji) {
+		return emoji, nil
+	}
+	if emoji == "" || utf8.RuneCountInString(emoji) > maxReactionRunes {
+		return "", ErrInvalidReaction
+	}
+
+	var base bool
+	for _, r := range emoji {
+		switch {
+		case isEmojiModifier(r):
+		case isEmojiBase(r):
+			base = true
+		default:
+			return "", ErrInvalidReaction
+		}
+	}
+	if !base {
+		return "", ErrInvalidReaction
+	}
+	return emoji, nil
+}
+
+// isEmojiBase returns whether or not r is a pictographic emoji code point
+func isEmojiBase(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // symbols, pictographs, flags, emoticons
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // misc symbols, dingbats
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // misc technical
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // arrows, stars
+		return true
+	case r >= 0x2190 && r <= 0x21FF: // arrows
+		return true
+	case r == 0x20E3: // combining keycap, e.g., 1️⃣
+		return true
+	case r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049, r == 0x2122, r == 0x2139,
+		r == 0x24C2, r == 0x25AA, r == 0x25AB, r == 0x25B6, r == 0x25C0,
+		r == 0x3030, r == 0x303D, r == 0x3297, r == 0x3299:
+		return true
+	}
+	return false
+}
+
+// isEmojiModifier returns whether or not r may only appear within an emoji sequence
+func isEmojiModifier(r rune) bool {
+	switch {
+	case r == 0x200D: // zero width joiner
+		return true
+	case r == 0xFE0F || r == 0xFE0E: // variation selectors
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // tags
+		return true
+	case r == '#' || r == '*' || (r >= '0' && r <= '9'): // keycap bases
+		return true
+	}
+	return false
+}
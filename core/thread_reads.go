@@ -0,0 +1,182 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// unreadBlockTypes are the block types which count towards a thread's unread count
+var unreadBlockTypes = []pb.Block_BlockType{
+	pb.Block_TEXT,
+	pb.Block_FILES,
+	pb.Block_COMMENT,
+	pb.Block_LIKE,
+	pb.Block_REACTION,
+}
+
+// MarkThreadRead moves the local last-read pointer of a thread up to the given block,
+// or the latest block if empty, and syncs the position to account peers
+func (t *Textile) MarkThreadRead(threadId string, upToBlock string) error {
+	thrd := t.Thread(threadId)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+
+	if upToBlock == "" {
+		latest := t.datastore.Blocks().List("", 1, fmt.Sprintf("threadId='%s'", threadId)).Items
+		if len(latest) == 0 {
+			return nil
+		}
+		upToBlock = latest[0].Id
+	}
+	block := t.datastore.Blocks().Get(upToBlock)
+	if block == nil || block.Thread != threadId {
+		return ErrBlockNotFound
+	}
+
+	// pointers only move forward
+	current := t.datastore.ThreadReads().Get(threadId, t.node.Identity.Pretty())
+	if current != nil && !readPointerAdvances(t.datastore, current, block) {
+		return nil
+	}
+
+	err := t.SendThreadRead(threadId, upToBlock)
+	if err != nil {
+		return err
+	}
+
+	if thrd.Id == t.config.Account.Thread {
+		return nil
+	}
+	account := t.AccountThread()
+	if account == nil {
+		return fmt.Errorf("account thread not found")
+	}
+	_, err = account.AddRead(threadId, upToBlock)
+	return err
+}
+
+// ThreadUnreadCount returns the number of blocks from other accounts
+// which are newer than the local last-read pointer
+func (t *Textile) ThreadUnreadCount(threadId string) int {
+	return threadUnreadCount(t.datastore, threadId, t.node.Identity.Pretty(), t.config.Account.Address)
+}
+
+// AddRead adds an outgoing read marker block to the account thread
+func (t *Thread) AddRead(threadId string, blockId string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.Id != t.config.Account.Thread {
+		return nil, ErrInvalidThreadBlock
+	}
+
+	msg := &pb.ThreadReadMarker{
+		Thread: threadId,
+		Block:  blockId,
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_READ, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// no target, thread ids aren't linkable, the read thread is only in the payload
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_READ,
+		Date:   res.header.Date,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added READ to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleReadBlock handles an incoming read marker block
+// Read positions from account peers advance the local last-read pointer.
+func (t *Thread) handleReadBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadReadMarker)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if t.Id != t.config.Account.Thread || block.Header.Address != t.config.Account.Address {
+		return res, ErrInvalidThreadBlock
+	}
+
+	if t.datastore.Threads().Get(msg.Thread) == nil {
+		return res, nil
+	}
+
+	peerId := t.node().Identity.Pretty()
+	read := &pb.ThreadRead{
+		Thread: msg.Thread,
+		Peer:   peerId,
+		Block:  msg.Block,
+		Date:   block.Header.Date,
+	}
+	current := t.datastore.ThreadReads().Get(msg.Thread, peerId)
+	if current != nil {
+		target := t.datastore.Blocks().Get(msg.Block)
+		if target != nil {
+			if !readPointerAdvances(t.datastore, current, target) {
+				return res, nil
+			}
+		} else if !util.ProtoTsIsNewer(read.Date, current.Date) {
+			return res, nil
+		}
+	}
+
+	return res, t.datastore.ThreadReads().AddOrUpdate(read)
+}
+
+// readPointerAdvances returns whether or not block is newer than the block of a read pointer
+func readPointerAdvances(datastore repo.Datastore, current *pb.ThreadRead, block *pb.Block) bool {
+	if current.Block == block.Id {
+		return false
+	}
+	last := datastore.Blocks().Get(current.Block)
+	if last == nil {
+		return true
+	}
+	return util.ProtoTsIsNewer(block.Date, last.Date)
+}
+
+// threadUnreadCount counts unread blocks in a thread relative to a peer's read pointer
+func threadUnreadCount(datastore repo.Datastore, threadId string, peerId string, address string) int {
+	types := make([]string, len(unreadBlockTypes))
+	for i, typ := range unreadBlockTypes {
+		types[i] = fmt.Sprintf("%d", typ)
+	}
+	query := fmt.Sprintf("threadId='%s' and type in (%s) and authorId!='%s'"+
+		" and authorId not in (select id from peers where address='%s')",
+		threadId, strings.Join(types, ","), peerId, address)
+
+	read := datastore.ThreadReads().Get(threadId, peerId)
+	if read != nil {
+		last := datastore.Blocks().Get(read.Block)
+		if last != nil {
+			query += fmt.Sprintf(" and date>%d", util.ProtoNanos(last.Date))
+		} else {
+			query += fmt.Sprintf(" and date>%d", util.ProtoNanos(read.Date))
+		}
+	}
+
+	return datastore.Blocks().Count(query)
+}
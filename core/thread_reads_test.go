@@ -0,0 +1,147 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/ptypes"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var readVars = struct {
+	node1InitConfig InitConfig
+	node2InitConfig InitConfig
+
+	node1  *Textile
+	node2  *Textile
+	thread *Thread
+}{
+	node1InitConfig: InitConfig{
+		BaseRepoPath: "./testdata/.textile11",
+		Debug:        true,
+		SwarmPorts:   "4301",
+	},
+	node2InitConfig: InitConfig{
+		BaseRepoPath: "./testdata/.textile12",
+		Debug:        true,
+		SwarmPorts:   "4302",
+	},
+}
+
+func TestThreadReads_Setup(t *testing.T) {
+	// two peers of the same account
+	account := keypair.Random()
+	readVars.node1InitConfig.Account = account
+	readVars.node2InitConfig.Account = account
+
+	var err error
+	readVars.node1, err = CreateAndStartPeer(readVars.node1InitConfig, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	readVars.node2, err = CreateAndStartPeer(readVars.node2InitConfig, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// both peers have the thread being read
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := pb.AddThreadConfig{
+		Key:  "reads",
+		Name: "reads",
+		Schema: &pb.AddThreadConfig_Schema{
+			Preset: pb.AddThreadConfig_Schema_BLOB,
+		},
+		Type:    pb.Thread_PRIVATE,
+		Sharing: pb.Thread_NOT_SHARED,
+	}
+	readVars.thread, err = readVars.node1.AddThread(conf, sk, account.Address(), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = readVars.node2.AddThread(conf, sk, account.Address(), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThreadReads_Sync(t *testing.T) {
+	node1 := readVars.node1
+	node2 := readVars.node2
+	node2Id := node2.Ipfs().Identity.Pretty()
+
+	// make node2 an account thread peer of node1
+	err := node1.datastore.Peers().Add(&pb.Peer{
+		Id:      node2Id,
+		Address: node2.Account().Address(),
+		Created: ptypes.TimestampNow(),
+		Updated: ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = node1.datastore.ThreadPeers().Add(&pb.ThreadPeer{
+		Id:       node2Id,
+		Thread:   node1.AccountThread().Id,
+		Welcomed: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = node1.Ipfs().PeerHost.Connect(context.Background(), peer.AddrInfo{
+		ID:    node2.Ipfs().Identity,
+		Addrs: node2.Ipfs().PeerHost.Addrs(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = node1.MarkThreadRead(readVars.thread.Id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := node1.datastore.ThreadReads().Get(readVars.thread.Id, node1.Ipfs().Identity.Pretty())
+	if read == nil {
+		t.Fatal("read position should be recorded")
+	}
+
+	// the read marker should be posted
+	node1.FlushBlocks()
+	query := fmt.Sprintf("threadId='%s' and type=%d", node1.AccountThread().Id, pb.Block_READ)
+	blocks := node1.datastore.Blocks().List("", -1, query).Items
+	if len(blocks) != 1 {
+		t.Fatal("read marker should be added to the account thread")
+	}
+	if blocks[0].Status != pb.Block_READY {
+		t.Fatal("read marker should be posted")
+	}
+
+	// and advance node2's read position
+	deadline := time.Now().Add(time.Second * 30)
+	for {
+		synced := node2.datastore.ThreadReads().Get(readVars.thread.Id, node2.Ipfs().Identity.Pretty())
+		if synced != nil && synced.Block == read.Block {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("read position should be synced to the account peer")
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+}
+
+func TestThreadReads_Teardown(t *testing.T) {
+	_ = readVars.node1.Stop()
+	_ = readVars.node2.Stop()
+	readVars.node1 = nil
+	readVars.node2 = nil
+}
@@ -0,0 +1,220 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	ipld "github.com/ipfs/go-ipld-format"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// ErrSchemaNotUpdatable indicates a peer other than the initiator changed the thread schema
+var ErrSchemaNotUpdatable = fmt.Errorf("only the thread initiator can update its schema")
+
+// UpdateSchema adds an outgoing schema block, which applies a new schema
+// to files added after it. Files added before it are still validated
+// against the schema that was current at their block.
+func (t *Thread) UpdateSchema(hash string) (mh.Multihash, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.config.Account.Address != t.initiator {
+		return nil, ErrSchemaNotUpdatable
+	}
+
+	sch, err := t.loadSchemaNode(hash)
+	if err != nil {
+		return nil, err
+	}
+	if hash == t.schemaId {
+		t.Schema = sch
+		return nil, nil
+	}
+
+	msg := &pb.ThreadSchema{
+		Schema:   hash,
+		Previous: t.schemaId,
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_SCHEMA, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.indexBlock(&pb.Block{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Author: res.header.Author,
+		Type:   pb.Block_SCHEMA,
+		Date:   res.header.Date,
+		Target: msg.Previous,
+		Data:   msg.Schema,
+		Status: pb.Block_QUEUED,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.applySchema(hash, sch)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added SCHEMA to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleSchemaBlock handles an incoming schema block
+// The latest schema block from the initiator replaces the thread schema.
+func (t *Thread) handleSchemaBlock(block *pb.ThreadBlock) (handleResult, error) {
+	var res handleResult
+
+	msg := new(pb.ThreadSchema)
+	err := ptypes.UnmarshalAny(block.Payload, msg)
+	if err != nil {
+		return res, err
+	}
+
+	if !t.readable(t.config.Account.Address) {
+		return res, ErrNotReadable
+	}
+	if block.Header.Address != t.initiator {
+		return res, ErrSchemaNotUpdatable
+	}
+
+	sch, err := t.loadSchemaNode(msg.Schema)
+	if err != nil {
+		return res, err
+	}
+
+	// ignore schemas older than the one we have
+	latest := t.schemaBlocks(1)
+	if len(latest) == 0 || util.ProtoTsIsNewer(block.Header.Date, latest[0].Date) {
+		err = t.applySchema(msg.Schema, sch)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	res.oldTarget = msg.Previous
+	res.oldData = msg.Schema
+	return res, nil
+}
+
+// applySchema sets a new schema hash on the model and attaches its node
+func (t *Thread) applySchema(hash string, sch *pb.Node) error {
+	err := t.datastore.Threads().UpdateSchema(t.Id, hash)
+	if err != nil {
+		return err
+	}
+	t.schemaId = hash
+	t.Schema = sch
+	return nil
+}
+
+// schemaBlocks returns the thread's schema blocks, newest first
+func (t *Thread) schemaBlocks(limit int) []*pb.Block {
+	query := fmt.Sprintf("threadId='%s' and type=%d", t.Id, pb.Block_SCHEMA)
+	return t.datastore.Blocks().List("", limit, query).Items
+}
+
+// schemaAt returns the hash of the schema that was current at date
+func (t *Thread) schemaAt(date *timestamp.Timestamp) string {
+	blocks := t.schemaBlocks(-1)
+	for _, b := range blocks {
+		if !util.ProtoTsIsNewer(b.Date, date) {
+			return b.Data
+		}
+	}
+	if len(blocks) > 0 {
+		return blocks[len(blocks)-1].Target
+	}
+	return t.schemaId
+}
+
+// schemaVersions returns every known schema hash, starting with the one current at date
+func (t *Thread) schemaVersions(date *timestamp.Timestamp) []string {
+	var versions []string
+	seen := make(map[string]struct{})
+	add := func(hash string) {
+		if hash == "" {
+			return
+		}
+		if _, ok := seen[hash]; ok {
+			return
+		}
+		seen[hash] = struct{}{}
+		versions = append(versions, hash)
+	}
+
+	add(t.schemaAt(date))
+	add(t.schemaId)
+	for _, b := range t.schemaBlocks(-1) {
+		add(b.Data)
+		add(b.Target)
+	}
+	return versions
+}
+
+// processVersionedFileData validates file data against the schema that was current
+// at date, falling back to other versions of the thread schema, since older
+// schema blocks may not have been handled yet during back prop
+func (t *Thread) processVersionedFileData(date *timestamp.Timestamp, inode ipld.Node, keys map[string]string, inbound bool) error {
+	var first error
+	for _, hash := range t.schemaVersions(date) {
+		sch, err := t.loadSchemaNode(hash)
+		if err == nil {
+			err = t.processFileData(sch, inode, keys, inbound)
+			if err == nil {
+				return nil
+			}
+		}
+		log.Debugf("file data does not match schema %s: %s", hash, err)
+		if first == nil {
+			first = err
+		}
+	}
+	if first == nil {
+		return ErrThreadSchemaRequired
+	}
+	return first
+}
+
+// loadSchemaNode loads a schema node from the network, caching it for later versioned validation
+func (t *Thread) loadSchemaNode(hash string) (*pb.Node, error) {
+	t.schemasLock.Lock()
+	defer t.schemasLock.Unlock()
+	if sch, ok := t.schemas[hash]; ok {
+		return sch, nil
+	}
+
+	data, err := ipfs.DataAtPath(t.node(), hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var sch pb.Node
+	err = jsonpb.UnmarshalString(string(data), &sch)
+	if err != nil {
+		return nil, err
+	}
+
+	// pin/repin to ensure remotely added schemas are readily accessible
+	_, err = ipfs.AddData(t.node(), bytes.NewReader(data), true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.schemas == nil {
+		t.schemas = make(map[string]*pb.Node)
+	}
+	t.schemas[hash] = &sch
+	return &sch, nil
+}
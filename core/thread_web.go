@@ -0,0 +1,77 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo/config"
+)
+
+// ErrThreadNotWebPublishable indicates a thread's content is not meant to be public
+var ErrThreadNotWebPublishable = fmt.Errorf("only read only and public threads can be published to the web")
+
+// PublishThreadWeb marks a thread as viewable on the gateway.
+// The gateway decrypts its content, keys are never served.
+func (t *Textile) PublishThreadWeb(id string) error {
+	err := t.setThreadWeb(id, true)
+	t.Audit(AuditThreadWebOn, id, err)
+	return err
+}
+
+// UnpublishThreadWeb removes a thread from the gateway
+func (t *Textile) UnpublishThreadWeb(id string) error {
+	err := t.setThreadWeb(id, false)
+	t.Audit(AuditThreadWebOff, id, err)
+	return err
+}
+
+// ThreadWebPublished returns whether or not a thread is viewable on the gateway
+func (t *Textile) ThreadWebPublished(id string) bool {
+	thread := t.Thread(id)
+	if thread == nil || !webPublishable(thread) {
+		return false
+	}
+	for _, tid := range t.config.Gateway.PublishedThreads {
+		if tid == id {
+			return true
+		}
+	}
+	return false
+}
+
+// setThreadWeb adds or removes a thread from the published list and saves the config
+func (t *Textile) setThreadWeb(id string, published bool) error {
+	thread := t.Thread(id)
+	if thread == nil {
+		return ErrThreadNotFound
+	}
+	if published && !webPublishable(thread) {
+		return ErrThreadNotWebPublishable
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	list := make([]string, 0)
+	for _, tid := range t.config.Gateway.PublishedThreads {
+		if tid != id {
+			list = append(list, tid)
+		}
+	}
+	if published {
+		list = append(list, id)
+	}
+	t.config.Gateway.PublishedThreads = list
+
+	err := config.Write(t.repoPath, t.config)
+	if err != nil {
+		return err
+	}
+	t.profileChanged()
+	return nil
+}
+
+// webPublishable returns whether or not a thread's type allows outside readers
+func webPublishable(thread *Thread) bool {
+	return thread.ttype == pb.Thread_READ_ONLY || thread.ttype == pb.Thread_PUBLIC
+}
@@ -285,6 +285,16 @@ func (t *Textile) RenameThread(id string, name string) error {
 	return err
 }
 
+// UpdateThreadSchema records a new schema for a thread. Only initiators can update a schema.
+func (t *Textile) UpdateThreadSchema(id string, schemaId string) (mh.Multihash, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	return thread.UpdateSchema(schemaId)
+}
+
 // Thread get a thread by id from loaded threads
 func (t *Textile) Thread(id string) *Thread {
 	for _, thread := range t.loadedThreads {
@@ -328,6 +338,13 @@ func (t *Textile) ThreadPeers(id string) (*pb.PeerList, error) {
 // RemoveThread removes a thread
 // @todo rename to abandon to be consistent with CLI+API
 func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
+	addr, err := t.removeThread(id)
+	t.Audit(AuditThreadRemove, id, err)
+	return addr, err
+}
+
+// removeThread leaves and removes a thread
+func (t *Textile) removeThread(id string) (mh.Multihash, error) {
 	var thread *Thread
 	var index int
 	for i, th := range t.loadedThreads {
@@ -358,6 +375,12 @@ func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
 		return nil, err
 	}
 
+	t.threads.unsubscribePresence(thread.Id)
+	err = t.datastore.ThreadReads().DeleteByThread(thread.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	copy(t.loadedThreads[index:], t.loadedThreads[index+1:])
 	t.loadedThreads[len(t.loadedThreads)-1] = nil
 	t.loadedThreads = t.loadedThreads[:len(t.loadedThreads)-1]
@@ -410,6 +433,7 @@ func (t *Textile) ThreadView(id string) (*pb.Thread, error) {
 	}
 	mod.BlockCount = int32(t.datastore.Blocks().Count(fmt.Sprintf("threadId='%s'", thread.Id)))
 	mod.PeerCount = int32(len(thread.Peers()) + 1)
+	mod.UnreadCount = int32(t.ThreadUnreadCount(thread.Id))
 
 	return mod, nil
 }
@@ -517,7 +541,8 @@ func (t *Textile) addAccountThread() error {
 				if err != nil {
 					return err
 				}
-				return x.UpdateSchema(sf.Hash)
+				_, err = x.UpdateSchema(sf.Hash)
+				return err
 			}
 
 			return nil
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/b582q9/go-textile-sapien/broadcast"
@@ -43,6 +44,9 @@ type ThreadsService struct {
 	removeThread     func(string) (mh.Multihash, error)
 	sendNotification func(*pb.Notification) error
 	acknowledgements *broadcast.Broadcaster
+	presence         *broadcast.Broadcaster
+	presenceSubs     map[string]context.CancelFunc
+	presenceLock     sync.Mutex
 	online           bool
 }
 
@@ -55,6 +59,7 @@ func NewThreadsService(
 	addThread func([]byte, []string) (mh.Multihash, error),
 	removeThread func(string) (mh.Multihash, error),
 	sendNotification func(*pb.Notification) error,
+	presence *broadcast.Broadcaster,
 ) *ThreadsService {
 	handler := &ThreadsService{
 		datastore:        datastore,
@@ -63,6 +68,8 @@ func NewThreadsService(
 		removeThread:     removeThread,
 		sendNotification: sendNotification,
 		acknowledgements: broadcast.NewBroadcaster(10),
+		presence:         presence,
+		presenceSubs:     make(map[string]context.CancelFunc),
 	}
 	handler.service = service.NewService(account, handler, node)
 	return handler
@@ -76,6 +83,10 @@ func (h *ThreadsService) Protocol() protocol.ID {
 // Start begins online services
 func (h *ThreadsService) Start() {
 	h.service.Start()
+
+	for _, thrd := range h.datastore.Threads().List().Items {
+		h.subscribePresence(thrd.Id)
+	}
 }
 
 // Ping pings another peer
@@ -93,6 +104,12 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 		return nil, nil
 	}
 
+	// drop envelopes from blocked accounts
+	if peerBlocked(h.datastore, pid.Pretty()) {
+		log.Debugf("dropping envelope from blocked peer %s", pid.Pretty())
+		return nil, nil
+	}
+
 	tenv := new(pb.ThreadEnvelope)
 	err := ptypes.UnmarshalAny(env.Message.Payload, tenv)
 	if err != nil {
@@ -159,6 +176,17 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 		log.Debugf("%s exists, aborting", bnode.hash)
 		return reply()
 	}
+
+	// the sender may be relaying a block authored by a blocked account
+	block, err := thread.unmarshalBlock(bnode.ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if block.Header != nil && accountBlocked(h.datastore, block.Header.Address) {
+		log.Debugf("dropping %s from blocked account %s", bnode.hash, block.Header.Address)
+		return nil, nil
+	}
+
 	index, err = thread.handle(bnode, false)
 	if err != nil {
 		return nil, err
@@ -211,6 +239,9 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 	case pb.Block_LIKE:
 		note.Type = pb.Notification_LIKE_ADDED
 		note.Body = "added a like"
+	case pb.Block_REACTION:
+		note.Type = pb.Notification_REACTION_ADDED
+		note.Body = "reacted with " + index.Body
 	default:
 		send = false
 	}
@@ -221,6 +252,19 @@ func (h *ThreadsService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, er
 		}
 	}
 
+	// warn about new peers claiming a verified contact
+	if index.Type == pb.Block_JOIN || index.Type == pb.Block_ANNOUNCE {
+		author := h.datastore.Peers().Get(index.Author)
+		if author != nil {
+			for _, n := range contactChangedNotifications(h.datastore, author.Address, []*pb.Peer{author}) {
+				err = h.sendNotification(n)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	// we may be auto-leaving
 	if index.Type == pb.Block_LEAVE && accountPeer {
 		_, err = h.removeThread(thread.Id)
@@ -378,6 +422,15 @@ func (h *ThreadsService) handleAdd(threadId string, bnode *blockNode, accountPee
 		return err
 	}
 
+	if block.Header != nil && accountBlocked(h.datastore, block.Header.Address) {
+		log.Debugf("dropping invite from blocked account %s", block.Header.Address)
+		return nil
+	}
+	if msg.Inviter != nil && accountBlocked(h.datastore, msg.Inviter.Address) {
+		log.Debugf("dropping invite from blocked account %s", msg.Inviter.Address)
+		return nil
+	}
+
 	if accountPeer {
 		log.Debugf("handling %s from account peer %s", block.Type.String(), block.Header.Author)
 
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/ssl"
+)
+
+// tlsDir is where acme accounts and certificates are kept in the repo
+const tlsDir = "tls"
+
+// TLS returns the certificate source of the cafe api and gateway,
+// nil if neither serves https
+func (t *Textile) TLS() *ssl.Server {
+	return t.tls
+}
+
+// startTLS loads the certificate source and starts the plain http listener,
+// which answers acme challenges and redirects to https
+func (t *Textile) startTLS() error {
+	conf := t.config.TLS
+	if !conf.CafeAPI && !conf.Gateway {
+		return nil
+	}
+
+	srv, err := ssl.NewServer(ssl.Options{
+		Hosts:        conf.Hosts,
+		Email:        conf.Email,
+		DirectoryURL: conf.DirectoryURL,
+		DirectoryCA:  conf.DirectoryCA,
+		CacheDir:     filepath.Join(t.repoPath, tlsDir),
+		CertFile:     conf.CertFile,
+		KeyFile:      conf.KeyFile,
+	})
+	if err != nil {
+		return err
+	}
+	t.tls = srv
+
+	if conf.RedirectAddr != "" {
+		t.tlsRedirect = &http.Server{
+			Addr:    conf.RedirectAddr,
+			Handler: srv.HTTPHandler(),
+		}
+		go func() {
+			err := t.tlsRedirect.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				log.Errorf("tls redirect error: %s", err)
+			}
+		}()
+		log.Infof("tls redirect listening at %s", conf.RedirectAddr)
+	}
+	return nil
+}
+
+// stopTLS stops the plain http listener
+func (t *Textile) stopTLS() error {
+	if t.tlsRedirect == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := t.tlsRedirect.Shutdown(ctx)
+	t.tlsRedirect = nil
+	return err
+}
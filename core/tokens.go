@@ -3,14 +3,49 @@ package core
 import (
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/b582q9/go-textile-sapien/crypto"
+	"github.com/b582q9/go-textile-sapien/ipfs"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
 	"github.com/mr-tron/base58/base58"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// CafeTokenOptions are the limits a token places on the clients it admits, zero values
+// are unlimited. Clients inherit the quotas of the token they registered with.
+type CafeTokenOptions struct {
+	Label        string    // a name for the token, i.e., the partner it was issued to
+	Expiry       time.Time // registrations with the token are rejected after
+	MaxClients   int       // clients which may register with the token
+	StorageQuota int64     // bytes each client may store
+	ThreadQuota  int       // threads each client may store
+}
+
+// CafeTokenCascade is what happens to the clients a token admitted when it's removed
+type CafeTokenCascade string
+
+const (
+	// CafeTokenCascadeNone leaves clients registered
+	CafeTokenCascadeNone CafeTokenCascade = ""
+	// CafeTokenCascadeExpire expires client sessions, so they have to register with another token
+	CafeTokenCascadeExpire CafeTokenCascade = "expire"
+	// CafeTokenCascadePurge deregisters clients and deletes their data
+	CafeTokenCascadePurge CafeTokenCascade = "purge"
+)
+
+// ParseCafeTokenCascade returns the cascade named by s
+func ParseCafeTokenCascade(s string) (CafeTokenCascade, error) {
+	switch CafeTokenCascade(s) {
+	case CafeTokenCascadeNone, CafeTokenCascadeExpire, CafeTokenCascadePurge:
+		return CafeTokenCascade(s), nil
+	default:
+		return CafeTokenCascadeNone, fmt.Errorf("invalid cascade %s, use expire or purge", s)
+	}
+}
+
 // CafeTokens lists all locally-stored (bcrypt hashed) tokens
 func (t *Textile) CafeTokens() ([]string, error) {
 	tokens := t.datastore.CafeTokens().List()
@@ -28,8 +63,15 @@ func (t *Textile) CafeTokens() ([]string, error) {
 }
 
 // CreateCafeToken creates (or uses `token`) random access token, returns base58 encoded version,
-// and stores (unless `store` is false) a bcrypt hashed version for later comparison
-func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
+// and stores (unless `store` is false) a bcrypt hashed version and its options for later comparison
+func (t *Textile) CreateCafeToken(token string, store bool, opts CafeTokenOptions) (string, error) {
+	token, err := t.createCafeToken(token, store, opts)
+	t.Audit(AuditTokenCreate, cafeTokenId(token), err)
+	return token, err
+}
+
+// createCafeToken creates and optionally stores a token
+func (t *Textile) createCafeToken(token string, store bool, opts CafeTokenOptions) (string, error) {
 	var key []byte
 	var err error
 	if token != "" {
@@ -54,11 +96,22 @@ func (t *Textile) CreateCafeToken(token string, store bool) (string, error) {
 	}
 
 	if store {
-		if err := t.datastore.CafeTokens().Add(&pb.CafeToken{
-			Id:    hex.EncodeToString(key[:12]),
-			Value: safeToken,
-			Date:  ptypes.TimestampNow(),
-		}); err != nil {
+		ctoken := &pb.CafeToken{
+			Id:           hex.EncodeToString(key[:12]),
+			Value:        safeToken,
+			Date:         ptypes.TimestampNow(),
+			Label:        opts.Label,
+			MaxClients:   int32(opts.MaxClients),
+			StorageQuota: opts.StorageQuota,
+			ThreadQuota:  int32(opts.ThreadQuota),
+		}
+		if !opts.Expiry.IsZero() {
+			ctoken.Expiry, err = ptypes.TimestampProto(opts.Expiry)
+			if err != nil {
+				return "", err
+			}
+		}
+		if err := t.datastore.CafeTokens().Add(ctoken); err != nil {
 			return "", err
 		}
 	}
@@ -88,8 +141,16 @@ func (t *Textile) ValidateCafeToken(token string) (bool, error) {
 	return true, nil
 }
 
-// RemoveCafeToken removes a given cafe token from the local store
-func (t *Textile) RemoveCafeToken(token string) error {
+// RemoveCafeToken removes a given cafe token from the local store and applies cascade
+// to the clients it admitted
+func (t *Textile) RemoveCafeToken(token string, cascade CafeTokenCascade) error {
+	err := t.removeCafeToken(token, cascade)
+	t.Audit(AuditTokenRemove, cafeTokenId(token), err)
+	return err
+}
+
+// removeCafeToken deletes a token from the local store
+func (t *Textile) removeCafeToken(token string, cascade CafeTokenCascade) error {
 	// dev tokens are actually base58(id+token)
 	plainBytes, err := base58.FastBase58Decoding(token)
 	if err != nil {
@@ -98,5 +159,266 @@ func (t *Textile) RemoveCafeToken(token string) error {
 	if len(plainBytes) < 44 {
 		return fmt.Errorf("invalid token format")
 	}
-	return t.datastore.CafeTokens().Delete(hex.EncodeToString(plainBytes[:12]))
+	id := hex.EncodeToString(plainBytes[:12])
+	err = t.datastore.CafeTokens().Delete(id)
+	if err != nil {
+		return err
+	}
+	return t.cascadeCafeToken(id, cascade)
+}
+
+// cascadeCafeToken expires or purges the clients admitted by a removed token
+func (t *Textile) cascadeCafeToken(id string, cascade CafeTokenCascade) error {
+	if cascade == CafeTokenCascadeNone {
+		return nil
+	}
+	for _, client := range t.datastore.CafeClients().ListByToken(id) {
+		var err error
+		switch cascade {
+		case CafeTokenCascadeExpire:
+			err = t.datastore.CafeClients().Expire(client.Id, time.Now())
+		case CafeTokenCascadePurge:
+			err = t.purgeCafeClient(client.Id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CafeTokenUsage returns the clients a token admitted and what they store
+func (t *Textile) CafeTokenUsage(token string) (*pb.CafeTokenUsage, error) {
+	id := cafeTokenId(token)
+	if id == "" {
+		return nil, fmt.Errorf("invalid token format")
+	}
+	ctoken := t.datastore.CafeTokens().Get(id)
+	if ctoken == nil {
+		return nil, ErrCafeTokenNotFound
+	}
+	return t.cafeTokenUsage(ctoken), nil
+}
+
+// CafeTokenUsages lists the usage of all stored tokens
+func (t *Textile) CafeTokenUsages() *pb.CafeTokenUsageList {
+	list := &pb.CafeTokenUsageList{Items: make([]*pb.CafeTokenUsage, 0)}
+	for _, ctoken := range t.datastore.CafeTokens().List() {
+		ctoken := ctoken
+		list.Items = append(list.Items, t.cafeTokenUsage(&ctoken))
+	}
+	return list
+}
+
+// cafeTokenUsage sums up the clients of a token, omitting the token hash
+func (t *Textile) cafeTokenUsage(ctoken *pb.CafeToken) *pb.CafeTokenUsage {
+	info := *ctoken
+	info.Value = nil
+	usage := &pb.CafeTokenUsage{Token: &info}
+	for _, client := range t.datastore.CafeClients().ListByToken(ctoken.Id) {
+		usage.Clients++
+		usage.Stored += client.Stored
+		usage.Threads += int32(len(t.datastore.CafeClientThreads().ListByClient(client.Id)))
+		usage.Messages += int32(t.datastore.CafeClientMessages().CountByClient(client.Id))
+	}
+	return usage
+}
+
+// cafeTokenId returns the public id part of a token, which is safe to log
+func cafeTokenId(token string) string {
+	// dev tokens are actually base58(id+token)
+	plainBytes, err := base58.FastBase58Decoding(token)
+	if err != nil || len(plainBytes) < 12 {
+		return ""
+	}
+	return hex.EncodeToString(plainBytes[:12])
+}
+
+// tokenAdmits returns whether a token may register a client. Clients already registered
+// with the token are always let back in.
+func (h *CafeService) tokenAdmits(token *pb.CafeToken, clientId string) bool {
+	if client := h.datastore.CafeClients().Get(clientId); client != nil && client.Token == token.Id {
+		return true
+	}
+	if token.Expiry != nil {
+		expiry, err := ptypes.Timestamp(token.Expiry)
+		if err != nil || time.Now().After(expiry) {
+			return false
+		}
+	}
+	if token.MaxClients > 0 && h.datastore.CafeClients().CountByToken(token.Id) >= int(token.MaxClients) {
+		return false
+	}
+	return true
+}
+
+// registerClient adds a client with the quotas of the token it registered with. A client
+// registering again keeps its data, but takes the token it used and that token's quotas.
+// Quotas stay with the client if the token is removed.
+func (h *CafeService) registerClient(id string, address string, token *pb.CafeToken) (*pb.CafeClient, error) {
+	client := h.datastore.CafeClients().Get(id)
+	if client == nil {
+		now := ptypes.TimestampNow()
+		client = &pb.CafeClient{
+			Id:           id,
+			Address:      address,
+			Created:      now,
+			Seen:         now,
+			Token:        token.Id,
+			StorageQuota: token.StorageQuota,
+			ThreadQuota:  token.ThreadQuota,
+		}
+		err := h.datastore.CafeClients().Add(client)
+		if err != nil {
+			// check if already exists
+			client = h.datastore.CafeClients().Get(id)
+			if client == nil {
+				return nil, fmt.Errorf("get or create client failed")
+			}
+			return client, nil
+		}
+		h.replicateClient(client)
+		return client, nil
+	}
+
+	if client.Token != token.Id {
+		err := h.datastore.CafeClients().UpdateToken(id, token.Id, token.StorageQuota, token.ThreadQuota)
+		if err != nil {
+			return nil, err
+		}
+		client.Token = token.Id
+		client.StorageQuota = token.StorageQuota
+		client.ThreadQuota = token.ThreadQuota
+		h.replicateClient(client)
+	}
+	return client, nil
+}
+
+// allowStore applies the storage quota a client took from its token
+func (h *CafeService) allowStore(client *pb.CafeClient, size int64) bool {
+	if client.StorageQuota <= 0 {
+		return true
+	}
+	if client.Stored+size > client.StorageQuota {
+		log.Warningf("storage quota reached for client %s", client.Id)
+		return false
+	}
+	return true
+}
+
+// allowThread applies the thread quota a client took from its token
+func (h *CafeService) allowThread(client *pb.CafeClient, id string) bool {
+	if client.ThreadQuota <= 0 {
+		return true
+	}
+	thrds := h.datastore.CafeClientThreads().ListByClient(client.Id)
+	for _, thrd := range thrds {
+		if thrd.Id == id {
+			return true
+		}
+	}
+	if len(thrds) >= int(client.ThreadQuota) {
+		log.Warningf("thread quota reached for client %s", client.Id)
+		return false
+	}
+	return true
+}
+
+// addStored records bytes received from a client, negative sizes record bytes removed
+func (h *CafeService) addStored(clientId string, size int64) {
+	if size == 0 {
+		return
+	}
+	err := h.datastore.CafeClients().AddStored(clientId, size)
+	if err != nil {
+		log.Errorf("error adding stored bytes for client %s: %s", clientId, err)
+	}
+}
+
+// clientObjectSize returns the bytes an added object counts toward a client's stored bytes,
+// zero if the client already stores it
+func (h *CafeService) clientObjectSize(clientId string, id icid.Cid) (int64, error) {
+	if h.datastore.CafeClientObjects().Get(id.Hash().B58String(), clientId) != nil {
+		return 0, nil
+	}
+	return h.objectSize(id)
+}
+
+// objectSize returns the cumulative size of a local object
+func (h *CafeService) objectSize(id icid.Cid) (int64, error) {
+	stat, err := ipfs.StatObjectAtPath(h.service.Node(), id.String())
+	if err != nil {
+		return 0, err
+	}
+	return int64(stat.CumulativeSize), nil
+}
+
+// pinAdded pins an object which was added without pinning
+func (h *CafeService) pinAdded(id icid.Cid, recursive bool) error {
+	nd, err := ipfs.NodeAtCid(h.service.Node(), id)
+	if err != nil {
+		return err
+	}
+	return ipfs.PinNode(h.service.Node(), nd, recursive)
+}
+
+// storeObject pins an added object for a client, charging it size bytes
+// unless it already stores the object
+func (h *CafeService) storeObject(clientId string, id icid.Cid, recursive bool, size int64) error {
+	h.objects.Lock()
+	defer h.objects.Unlock()
+
+	err := h.pinAdded(id, recursive)
+	if err != nil {
+		return err
+	}
+	hash := id.Hash().B58String()
+	if h.datastore.CafeClientObjects().Get(hash, clientId) != nil {
+		return nil
+	}
+	err = h.datastore.CafeClientObjects().Add(&pb.CafeClientObject{
+		Id:     hash,
+		Client: clientId,
+		Size:   size,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		return err
+	}
+	h.addStored(clientId, size)
+	return nil
+}
+
+// unstoreObjects drops objects a client stores, giving back the bytes it was charged.
+// Objects are only unpinned once no client stores them. Returns the dropped objects.
+func (h *CafeService) unstoreObjects(clientId string, cids []string) ([]string, error) {
+	h.objects.Lock()
+	defer h.objects.Unlock()
+
+	var unstored []string
+	for _, c := range cids {
+		id, err := icid.Decode(c)
+		if err != nil {
+			return unstored, err
+		}
+		hash := id.Hash().B58String()
+		obj := h.datastore.CafeClientObjects().Get(hash, clientId)
+		if obj == nil {
+			continue
+		}
+		err = h.datastore.CafeClientObjects().Delete(hash, clientId)
+		if err != nil {
+			return unstored, err
+		}
+		h.addStored(clientId, -obj.Size)
+		unstored = append(unstored, hash)
+
+		if h.datastore.CafeClientObjects().Count(hash) == 0 {
+			err = ipfs.UnpinCid(h.service.Node(), id, true)
+			if err != nil {
+				return unstored, err
+			}
+		}
+	}
+	return unstored, nil
 }
@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	m "github.com/b582q9/go-textile-sapien/mill"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+)
+
+// userContentCSP is the content security policy of active content (html, svg, xml)
+// served from user data, which may not run scripts or load anything off-origin
+const userContentCSP = "default-src 'none'; img-src 'self' data:; media-src 'self'; style-src 'self' 'unsafe-inline'; font-src 'self'; sandbox"
+
+// activeMedia are media types which browsers may run scripts in
+var activeMedia = []string{
+	"text/html",
+	"application/xhtml+xml",
+	"image/svg+xml",
+	"text/xml",
+	"application/xml",
+}
+
+// renderContent writes user data with a detected content type.
+// file is the local file index of the data, if known, and plain is
+// whether or not data is plaintext (i.e., unencrypted or decrypted).
+func renderContent(c *gin.Context, data []byte, file *pb.FileIndex, plain bool) {
+	filename := c.Query("filename")
+	media := contentType(data, file, plain, filename)
+
+	setContentHeaders(c, media)
+	if filename != "" {
+		if disp := mime.FormatMediaType("inline", map[string]string{"filename": filename}); disp != "" {
+			c.Header("Content-Disposition", disp)
+		}
+	}
+
+	c.Render(http.StatusOK, render.Data{ContentType: media, Data: data})
+}
+
+// streamContent writes user data from reader with the given content type
+func streamContent(c *gin.Context, reader io.Reader, media string) error {
+	setContentHeaders(c, media)
+	c.Header("Content-Type", media)
+	c.Status(http.StatusOK)
+	_, err := io.Copy(c.Writer, reader)
+	return err
+}
+
+// setContentHeaders stops browsers from sniffing user data and
+// sandboxes it if it's active content
+func setContentHeaders(c *gin.Context, media string) {
+	c.Header("X-Content-Type-Options", "nosniff")
+	if isActiveMedia(media) {
+		c.Header("Content-Security-Policy", userContentCSP)
+	}
+}
+
+// contentType returns the media type of data, preferring the media recorded
+// by the mill that added it, then sniffing, then the filename extension
+func contentType(data []byte, file *pb.FileIndex, plain bool, filename string) string {
+	if file != nil && file.Media != "" && (plain || file.Key == "") {
+		return file.Media
+	}
+
+	media := m.DetectMedia(data)
+	if filename != "" && (media == "application/octet-stream" || strings.HasPrefix(media, "text/plain")) {
+		if ext := mime.TypeByExtension(filepath.Ext(filename)); ext != "" {
+			return ext
+		}
+	}
+	return media
+}
+
+// isActiveMedia returns whether or not media may run scripts
+func isActiveMedia(media string) bool {
+	base, _, err := mime.ParseMediaType(media)
+	if err != nil {
+		return true
+	}
+	for _, a := range activeMedia {
+		if base == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/gin-gonic/gin"
+)
+
+func TestContentType(t *testing.T) {
+	html := []byte("<html><body>hi</body></html>")
+	png := []byte("\x89PNG\r\n\x1a\n")
+
+	if media := contentType(png, &pb.FileIndex{Media: "image/jpeg"}, true, ""); media != "image/jpeg" {
+		t.Errorf("file media was not used: %s", media)
+	}
+	if media := contentType(png, &pb.FileIndex{Media: "image/jpeg", Key: "k"}, false, ""); media != "image/png" {
+		t.Errorf("media of encrypted data was used: %s", media)
+	}
+	if media := contentType(html, nil, false, ""); !isActiveMedia(media) {
+		t.Errorf("html was not detected as active: %s", media)
+	}
+	if media := contentType([]byte("{}"), nil, false, "data.json"); media != "application/json" {
+		t.Errorf("filename extension was not used: %s", media)
+	}
+	if isActiveMedia("image/png") {
+		t.Error("png should not be active")
+	}
+}
+
+func TestStreamContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		media string
+		csp   bool
+	}{
+		{"text/html; charset=utf-8", true},
+		{"image/svg+xml", true},
+		{"image/png", false},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if err := streamContent(c, strings.NewReader("content"), test.media); err != nil {
+			t.Fatal(err)
+		}
+		if w.Code != http.StatusOK || w.Body.String() != "content" {
+			t.Errorf("%s content was not written", test.media)
+		}
+		if w.Header().Get("Content-Type") != test.media {
+			t.Errorf("%s content type was not set", test.media)
+		}
+		if w.Header().Get("X-Content-Type-Options") != "nosniff" {
+			t.Errorf("%s content may be sniffed", test.media)
+		}
+		if (w.Header().Get("Content-Security-Policy") == userContentCSP) != test.csp {
+			t.Errorf("%s content has the wrong content security policy", test.media)
+		}
+	}
+}
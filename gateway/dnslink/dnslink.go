@@ -0,0 +1,120 @@
+// Package dnslink resolves DNSLink (https://dnslink.io) TXT records and caches
+// gateway name resolutions.
+package dnslink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/simplelru"
+)
+
+// ErrNoLink indicates a domain does not have a dnslink record
+var ErrNoLink = fmt.Errorf("no dnslink record found")
+
+// prefix starts dnslink TXT record values
+const prefix = "dnslink="
+
+// maxEntries bounds the number of cached resolutions
+const maxEntries = 1024
+
+// TXTResolver looks up DNS TXT records, e.g., net.DefaultResolver.
+// Tests can provide static records.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Resolve returns the path in a domain's dnslink record, e.g., /ipfs/<cid>,
+// checking _dnslink.<domain> before the domain itself
+func Resolve(ctx context.Context, resolver TXTResolver, domain string) (string, error) {
+	domain = strings.TrimSuffix(domain, ".")
+	for _, name := range []string{"_dnslink." + domain, domain} {
+		txts, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			continue
+		}
+		for _, txt := range txts {
+			if pth, ok := Parse(txt); ok {
+				return pth, nil
+			}
+		}
+	}
+	return "", ErrNoLink
+}
+
+// Parse returns the path of a dnslink TXT record value
+func Parse(txt string) (string, bool) {
+	txt = strings.TrimSpace(txt)
+	if !strings.HasPrefix(txt, prefix) {
+		return "", false
+	}
+	pth := strings.TrimSpace(txt[len(prefix):])
+	parts := strings.SplitN(strings.TrimPrefix(pth, "/"), "/", 3)
+	if len(parts) < 2 || parts[1] == "" {
+		return "", false
+	}
+	switch parts[0] {
+	case "ipfs", "ipns":
+		return pth, true
+	default:
+		return "", false
+	}
+}
+
+// entry is a cached resolution
+type entry struct {
+	path    string
+	err     error
+	expires time.Time
+}
+
+// Cache memoizes resolutions for a TTL. Failures are cached too, so that
+// unknown names don't cause a lookup on every request. When full, the least
+// recently used resolution is evicted.
+type Cache struct {
+	ttl     time.Duration
+	entries *lru.LRU
+	now     func() time.Time
+	lock    sync.Mutex
+}
+
+// NewCache returns a cache which keeps resolutions for ttl
+func NewCache(ttl time.Duration) *Cache {
+	entries, _ := lru.NewLRU(maxEntries, nil) // only fails for a non-positive size
+	return &Cache{
+		ttl:     ttl,
+		entries: entries,
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached resolution of key, calling resolve if it's missing or expired.
+// The lock is not held while resolving.
+func (c *Cache) Get(key string, resolve func() (string, error)) (string, error) {
+	c.lock.Lock()
+	v, ok := c.entries.Get(key)
+	c.lock.Unlock()
+	if ok {
+		e := v.(entry)
+		if c.now().Before(e.expires) {
+			return e.path, e.err
+		}
+	}
+
+	pth, err := resolve()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries.Add(key, entry{path: pth, err: err, expires: c.now().Add(c.ttl)})
+	return pth, err
+}
+
+// Remove drops a cached resolution
+func (c *Cache) Remove(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries.Remove(key)
+}
@@ -0,0 +1,126 @@
+package dnslink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// staticResolver serves fixed TXT records
+type staticResolver map[string][]string
+
+func (r staticResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	txts, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("no such host")
+	}
+	return txts, nil
+}
+
+func TestResolve(t *testing.T) {
+	resolver := staticResolver{
+		"_dnslink.example.com": {"v=spf1 -all", "dnslink=/ipfs/QmSite"},
+		"other.com":            {"dnslink=/ipns/QmKey/blog"},
+		"bad.com":              {"dnslink=/http/nope"},
+	}
+
+	pth, err := Resolve(context.Background(), resolver, "example.com.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pth != "/ipfs/QmSite" {
+		t.Errorf("wrong path: %s", pth)
+	}
+
+	pth, err = Resolve(context.Background(), resolver, "other.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pth != "/ipns/QmKey/blog" {
+		t.Errorf("wrong path: %s", pth)
+	}
+
+	if _, err := Resolve(context.Background(), resolver, "bad.com"); err != ErrNoLink {
+		t.Errorf("invalid record was resolved")
+	}
+	if _, err := Resolve(context.Background(), resolver, "missing.com"); err != ErrNoLink {
+		t.Errorf("missing record was resolved")
+	}
+}
+
+func TestCache(t *testing.T) {
+	cache := NewCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	var calls int
+	resolve := func() (string, error) {
+		calls++
+		return "/ipfs/QmSite", nil
+	}
+	fail := func() (string, error) {
+		calls++
+		return "", ErrNoLink
+	}
+
+	for i := 0; i < 3; i++ {
+		if pth, _ := cache.Get("example.com", resolve); pth != "/ipfs/QmSite" {
+			t.Fatalf("wrong path: %s", pth)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("resolution was not cached: %d calls", calls)
+	}
+
+	// failures are cached
+	cache.Get("missing.com", fail)
+	if _, err := cache.Get("missing.com", fail); err != ErrNoLink {
+		t.Error("failure was not cached")
+	}
+	if calls != 2 {
+		t.Errorf("failure was not cached: %d calls", calls)
+	}
+
+	// expired entries are resolved again
+	now = now.Add(time.Minute)
+	cache.Get("example.com", resolve)
+	if calls != 3 {
+		t.Errorf("expired resolution was used: %d calls", calls)
+	}
+
+	cache.Remove("example.com")
+	cache.Get("example.com", resolve)
+	if calls != 4 {
+		t.Errorf("removed resolution was used: %d calls", calls)
+	}
+}
+
+func TestCache_Evicts(t *testing.T) {
+	cache := NewCache(time.Minute)
+
+	var calls int
+	resolve := func() (string, error) {
+		calls++
+		return "", ErrNoLink
+	}
+
+	cache.Get("first.com", resolve)
+	cache.Get("recent.com", resolve)
+	for i := 0; i < maxEntries-2; i++ {
+		cache.Get(fmt.Sprintf("%d.com", i), resolve)
+	}
+	cache.Get("recent.com", resolve)
+
+	// a new name evicts the least recently used one only
+	cache.Get("new.com", resolve)
+	calls = 0
+	cache.Get("recent.com", resolve)
+	if calls != 0 {
+		t.Error("recently used resolution was evicted")
+	}
+	cache.Get("first.com", resolve)
+	if calls != 1 {
+		t.Error("least recently used resolution was not evicted")
+	}
+}
@@ -12,9 +12,10 @@ import (
 	"github.com/b582q9/go-textile-sapien/bots"
 	"github.com/b582q9/go-textile-sapien/core"
 	"github.com/b582q9/go-textile-sapien/crypto"
+	"github.com/b582q9/go-textile-sapien/gateway/dnslink"
 	"github.com/b582q9/go-textile-sapien/gateway/static/css"
 	"github.com/b582q9/go-textile-sapien/gateway/templates"
-	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/gin-contrib/location"
 	"github.com/gin-gonic/gin"
@@ -24,7 +25,6 @@ import (
 	logging "github.com/ipfs/go-log"
 	ipfspath "github.com/ipfs/go-path"
 	iface "github.com/ipfs/interface-go-ipfs-core"
-	peer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/mr-tron/base58/base58"
 	gincors "github.com/rs/cors/wrapper/gin"
 )
@@ -36,9 +36,11 @@ var Host *Gateway
 
 // Gateway is a HTTP API for getting files and links from IPFS
 type Gateway struct {
-	Node   *core.Textile
-	Bots   *bots.Service
-	server *http.Server
+	Node     *core.Textile
+	Bots     *bots.Service
+	Resolver dnslink.TXTResolver // dnslink TXT record resolver, defaults to the system resolver
+	cache    *dnslink.Cache
+	server   *http.Server
 }
 
 // Start creates a gateway server
@@ -48,8 +50,10 @@ func (g *Gateway) Start(addr string) {
 		gin.DefaultWriter = g.Node.Writer()
 	}
 	conf := g.Node.Config()
+	g.cache = dnslink.NewCache(resolveTTL)
 
 	router := gin.Default()
+	router.Use(metrics.Gin("gateway"))
 	router.Use(location.Default())
 
 	// Add the CORS middleware
@@ -87,18 +91,32 @@ func (g *Gateway) Start(addr string) {
 
 	router.GET("/bots/:root", g.botsHandler)
 
+	router.GET("/threads/:id", g.threadHandler)
+	router.GET("/threads/:id/rss", g.threadRSSHandler)
+	router.GET("/threads/:id/atom", g.threadAtomHandler)
+	router.GET("/threads/:id/files/:block/:index", g.threadFileHandler)
+
 	router.NoRoute(func(c *gin.Context) {
 		g.render404(c)
 	})
 
 	g.server = &http.Server{
 		Addr:    addr,
-		Handler: router,
+		Handler: g.hostRouter(router),
+	}
+
+	secure := g.Node.TLS() != nil && conf.TLS.Gateway
+	if secure {
+		g.server.TLSConfig = g.Node.TLS().TLSConfig()
 	}
 
 	errc := make(chan error)
 	go func() {
-		errc <- g.server.ListenAndServe()
+		if secure {
+			errc <- g.server.ListenAndServeTLS("", "")
+		} else {
+			errc <- g.server.ListenAndServe()
+		}
 		close(errc)
 	}()
 	go func() {
@@ -142,6 +160,7 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 	if data == nil {
 		return
 	}
+	file, _ := g.Node.FileMetaAtPath(contentPath)
 
 	// attempt decrypt if key present
 	key, exists := c.GetQuery("key")
@@ -158,11 +177,11 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 			g.render404(c)
 			return
 		}
-		c.Render(200, render.Data{Data: plain})
+		renderContent(c, plain, file, true)
 		return
 	}
 
-	c.Render(200, render.Data{Data: data})
+	renderContent(c, data, file, false)
 }
 
 // ipnsHandler renders data behind an IPNS address
@@ -172,26 +191,20 @@ func (g *Gateway) ipnsHandler(c *gin.Context) {
 		pathp = pathp[:len(pathp)-1]
 	}
 
-	rootId, err := peer.IDB58Decode(c.Param("root"))
-	if err != nil {
-		log.Debugf("error decoding root %s: %s", c.Param("root"), err)
-		g.render404(c)
-		return
-	}
-
-	pth, err := ipfs.ResolveIPNS(g.Node.Ipfs(), rootId, time.Second*30)
+	pth, err := g.resolveName(c.Param("root"), 0)
 	if err != nil {
-		log.Debugf("error resolving profile %s: %s", c.Param("root"), err)
+		log.Debugf("error resolving name %s: %s", c.Param("root"), err)
 		g.render404(c)
 		return
 	}
 
-	data := g.getDataAtPath(c, pth.String()+pathp)
+	data := g.getDataAtPath(c, pth+pathp)
 	if data == nil {
 		return
 	}
+	file, _ := g.Node.FileMetaAtPath(pth + pathp)
 
-	c.Render(200, render.Data{Data: data})
+	renderContent(c, data, file, false)
 }
 
 // cafeHandler returns this peer's cafe info
@@ -330,21 +343,23 @@ func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
 func (g *Gateway) render404(c *gin.Context) {
 	if strings.Contains(c.Request.URL.String(), "small/content") ||
 		strings.Contains(c.Request.URL.String(), "large/content") {
-		var url string
-		if g.Node.Config().Cafe.Host.URL != "" {
-			url = strings.TrimRight(g.Node.Config().Cafe.Host.URL, "/")
-		} else {
-			loc := location.Get(c)
-			url = fmt.Sprintf("%s://%s", loc.Scheme, loc.Host)
-		}
 		pth := strings.Replace(c.Request.URL.String(), "/content", "/d", 1)
-		c.Redirect(http.StatusMovedPermanently, fmt.Sprintf("%s%s", url, pth))
+		c.Redirect(http.StatusMovedPermanently, fmt.Sprintf("%s%s", g.baseURL(c), pth))
 		return
 	}
 
 	c.HTML(http.StatusNotFound, "404", nil)
 }
 
+// baseURL returns the public cafe url, or the request's scheme and host
+func (g *Gateway) baseURL(c *gin.Context) string {
+	if g.Node.Config().Cafe.Host.URL != "" {
+		return strings.TrimRight(g.Node.Config().Cafe.Host.URL, "/")
+	}
+	loc := location.Get(c)
+	return fmt.Sprintf("%s://%s", loc.Scheme, loc.Host)
+}
+
 // parseTemplates loads HTML templates
 func parseTemplates() *template.Template {
 	temp, err := template.New("index").Parse(templates.Index)
@@ -355,6 +370,10 @@ func parseTemplates() *template.Template {
 	if err != nil {
 		panic(err)
 	}
+	temp, err = temp.New("thread").Parse(templates.Thread)
+	if err != nil {
+		panic(err)
+	}
 	return temp
 }
 
@@ -1,6 +1,7 @@
 package gateway_test
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
@@ -49,6 +50,17 @@ func TestGateway_Addr(t *testing.T) {
 	}
 }
 
+func TestGateway_ThreadNotPublished(t *testing.T) {
+	res, err := http.Get("http://" + Host.Addr() + "/threads/unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("unpublished thread returned status %d", res.StatusCode)
+	}
+}
+
 func TestGateway_Stop(t *testing.T) {
 	err := Host.Stop()
 	if err != nil {
@@ -0,0 +1,244 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/gateway/dnslink"
+	"github.com/b582q9/go-textile-sapien/ipfs"
+	"github.com/b582q9/go-textile-sapien/repo/config"
+	cid "github.com/ipfs/go-cid"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	mbase "github.com/multiformats/go-multibase"
+)
+
+// resolveTTL is how long ipns and dnslink resolutions are cached
+const resolveTTL = time.Minute
+
+// dnsTimeout bounds dnslink TXT lookups
+const dnsTimeout = time.Second * 10
+
+// maxResolveDepth bounds recursive ipns and dnslink resolution
+const maxResolveDepth = 4
+
+// hostRoute is where a request is routed based on its Host header
+type hostRoute struct {
+	path     string // the rewritten path
+	redirect string // host and path of a subdomain redirect
+}
+
+// hostRouter rewrites request paths by Host header before they are routed, serving
+// subdomain gateway hosts, configured hostnames and dnslink domains
+func (g *Gateway) hostRouter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, port := splitHost(r.Host)
+		route := routeHost(g.Node.Config().Gateway, host, r.URL.Path, g.hasDNSLink)
+
+		if route.redirect != "" {
+			scheme := "http"
+			if r.TLS != nil {
+				scheme = "https"
+			}
+			target := scheme + "://" + route.redirect
+			if port != "" {
+				target = scheme + "://" + addPort(route.redirect, port)
+			}
+			if r.URL.RawQuery != "" {
+				target += "?" + r.URL.RawQuery
+			}
+			http.Redirect(w, r, target, http.StatusMovedPermanently)
+			return
+		}
+
+		if route.path != r.URL.Path {
+			log.Debugf("routing %s%s to %s", host, r.URL.Path, route.path)
+			r.URL.Path = route.path
+			r.URL.RawPath = ""
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeHost returns the route of a request path on a host.
+// hasLink reports whether or not a domain has a dnslink record.
+func routeHost(conf config.Gateway, host string, pth string, hasLink func(string) bool) hostRoute {
+	host = strings.ToLower(host)
+
+	for _, base := range conf.SubdomainHosts {
+		base = strings.ToLower(base)
+
+		// give each cid and key its own origin
+		if host == base {
+			parts := strings.SplitN(strings.TrimPrefix(pth, "/"), "/", 3)
+			if len(parts) >= 2 && (parts[0] == "ipfs" || parts[0] == "ipns") {
+				if label, ok := subdomainLabel(parts[0], parts[1]); ok {
+					rest := "/"
+					if len(parts) == 3 {
+						rest += parts[2]
+					}
+					return hostRoute{redirect: label + "." + parts[0] + "." + base + rest}
+				}
+			}
+			return hostRoute{path: pth}
+		}
+
+		for _, ns := range []string{"ipfs", "ipns"} {
+			suffix := "." + ns + "." + base
+			if !strings.HasSuffix(host, suffix) {
+				continue
+			}
+			label := strings.TrimSuffix(host, suffix)
+			if label == "" || strings.Contains(label, ".") {
+				return hostRoute{path: pth}
+			}
+			if ns == "ipns" {
+				if id, err := peer.Decode(label); err == nil {
+					label = id.Pretty()
+				}
+			}
+			return hostRoute{path: joinPath("/"+ns+"/"+label, pth)}
+		}
+	}
+
+	if target, ok := conf.Hosts[host]; ok {
+		if strings.HasPrefix(target, "/threads/") {
+			// thread pages link to absolute paths and shared assets
+			if strings.HasPrefix(pth, target) || strings.HasPrefix(pth, "/static/") ||
+				pth == "/favicon.ico" || pth == "/health" {
+				return hostRoute{path: pth}
+			}
+		}
+		return hostRoute{path: joinPath(target, pth)}
+	}
+
+	if conf.DNSLink && dnslinkDomain(conf.DNSLinkDomains, host) && hasLink(host) {
+		return hostRoute{path: joinPath("/ipns/"+host, pth)}
+	}
+
+	return hostRoute{path: pth}
+}
+
+// dnslinkDomain returns whether or not host is one of domains or a subdomain of one,
+// so that arbitrary host headers can't trigger dns lookups
+func dnslinkDomain(domains []string, host string) bool {
+	if host == "localhost" || net.ParseIP(host) != nil {
+		return false
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.Trim(domain, "."))
+		if domain != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			return true
+		}
+	}
+	return false
+}
+
+// subdomainLabel returns the case-insensitive CIDv1 (base32) form of an ipfs cid or ipns key
+func subdomainLabel(ns string, root string) (string, bool) {
+	var c cid.Cid
+	switch ns {
+	case "ipfs":
+		dc, err := cid.Decode(root)
+		if err != nil {
+			return "", false
+		}
+		c = cid.NewCidV1(dc.Type(), dc.Hash())
+	case "ipns":
+		id, err := peer.Decode(root)
+		if err != nil {
+			return "", false
+		}
+		c = peer.ToCid(id)
+	default:
+		return "", false
+	}
+	label, err := c.StringOfBase(mbase.Base32)
+	if err != nil {
+		return "", false
+	}
+	return label, true
+}
+
+// hasDNSLink returns whether or not a domain resolves via dnslink
+func (g *Gateway) hasDNSLink(domain string) bool {
+	_, err := g.resolveName(domain, 0)
+	return err == nil
+}
+
+// resolveName resolves an ipns name, i.e., a peer id or a dnslink domain, to an ipfs path
+func (g *Gateway) resolveName(name string, depth int) (string, error) {
+	if depth > maxResolveDepth {
+		return "", fmt.Errorf("max resolve depth exceeded for %s", name)
+	}
+
+	return g.cache.Get("/ipns/"+name, func() (string, error) {
+		var pth string
+		if id, err := peer.Decode(name); err == nil {
+			p, err := ipfs.ResolveIPNS(g.Node.Ipfs(), id, time.Second*30)
+			if err != nil {
+				return "", err
+			}
+			pth = p.String()
+		} else {
+			ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+			defer cancel()
+			pth, err = dnslink.Resolve(ctx, g.txtResolver(), name)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		// dnslink records may point to other names
+		if strings.HasPrefix(pth, "/ipns/") {
+			parts := strings.SplitN(strings.TrimPrefix(pth, "/ipns/"), "/", 2)
+			root, err := g.resolveName(parts[0], depth+1)
+			if err != nil {
+				return "", err
+			}
+			if len(parts) == 2 {
+				root = joinPath(root, "/"+parts[1])
+			}
+			pth = root
+		}
+		return pth, nil
+	})
+}
+
+// txtResolver returns the configured dns resolver, or the system resolver
+func (g *Gateway) txtResolver() dnslink.TXTResolver {
+	if g.Resolver != nil {
+		return g.Resolver
+	}
+	return net.DefaultResolver
+}
+
+// joinPath appends a request path to a root path
+func joinPath(root string, pth string) string {
+	if pth == "" || pth == "/" {
+		return root
+	}
+	return strings.TrimSuffix(root, "/") + pth
+}
+
+// splitHost splits a Host header into hostname and port
+func splitHost(hostport string) (string, string) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport, ""
+	}
+	return host, port
+}
+
+// addPort adds a port to the host of a host and path
+func addPort(hostpath string, port string) string {
+	parts := strings.SplitN(hostpath, "/", 2)
+	if len(parts) == 1 {
+		return parts[0] + ":" + port
+	}
+	return parts[0] + ":" + port + "/" + parts[1]
+}
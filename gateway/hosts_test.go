@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/repo/config"
+)
+
+func TestRouteHost(t *testing.T) {
+	conf := config.Gateway{
+		Hosts: map[string]string{
+			"site.example.com": "/ipns/QmSiteKey",
+			"blog.example.com": "/threads/12D3KooThread",
+		},
+		DNSLink:        true,
+		DNSLinkDomains: []string{"linked.com", "unlinked.com", ".other.com"},
+		SubdomainHosts: []string{"gateway.example.com"},
+	}
+	hasLink := func(domain string) bool {
+		return domain == "linked.com" || domain == "a.other.com" || domain == "notlisted.com"
+	}
+
+	cidV0 := "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	cidV1 := "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"
+
+	tests := []struct {
+		host     string
+		path     string
+		want     string
+		redirect string
+	}{
+		{"site.example.com", "/", "/ipns/QmSiteKey", ""},
+		{"site.example.com", "/css/a.css", "/ipns/QmSiteKey/css/a.css", ""},
+		{"blog.example.com", "/", "/threads/12D3KooThread", ""},
+		{"blog.example.com", "/rss", "/threads/12D3KooThread/rss", ""},
+		{"blog.example.com", "/threads/12D3KooThread/atom", "/threads/12D3KooThread/atom", ""},
+		{"blog.example.com", "/static/css/style.css", "/static/css/style.css", ""},
+		{"linked.com", "/a", "/ipns/linked.com/a", ""},
+		{"unlinked.com", "/a", "/a", ""},
+		{"a.other.com", "/a", "/ipns/a.other.com/a", ""},
+		{"notlisted.com", "/a", "/a", ""},
+		{"127.0.0.1", "/ipfs/" + cidV0, "/ipfs/" + cidV0, ""},
+		{"gateway.example.com", "/ipfs/" + cidV0 + "/a/b", "", cidV1 + ".ipfs.gateway.example.com/a/b"},
+		{"gateway.example.com", "/cafe", "/cafe", ""},
+		{cidV1 + ".ipfs.gateway.example.com", "/a/b", "/ipfs/" + cidV1 + "/a/b", ""},
+	}
+	for _, test := range tests {
+		route := routeHost(conf, test.host, test.path, hasLink)
+		if route.path != test.want || route.redirect != test.redirect {
+			t.Errorf("%s%s routed to %+v", test.host, test.path, route)
+		}
+	}
+}
@@ -57,6 +57,29 @@ li:nth-child(odd) {
     background: none;
 }
 
+li.item {
+    padding: 1em;
+}
+
+.title span.right {
+    float: right;
+}
+
+.body, .comment {
+    color: #AAAAAA;
+    white-space: pre-wrap;
+}
+
+.comment {
+    padding-left: 1em;
+}
+
+img.thumb {
+    max-width: 320px;
+    max-height: 320px;
+    margin: 0.5em 0.5em 0 0;
+}
+
 .aligner {
     display: flex;
     align-items: center;
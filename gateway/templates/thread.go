@@ -0,0 +1,31 @@
+package templates
+
+const Thread = `
+<html>
+    <head>
+        <title>{{.name}}</title>
+        <link href="/static/css/style.css" rel="stylesheet" type="text/css">
+        <link href="{{.rss}}" rel="alternate" type="application/rss+xml" title="{{.name}}">
+        <link href="{{.atom}}" rel="alternate" type="application/atom+xml" title="{{.name}}">
+    </head>
+    <body>
+        <div class="title">{{.name}}<span class="right"><a href="{{.rss}}">rss</a> <a href="{{.atom}}">atom</a></span></div>
+        <ul>
+            {{range .items}}
+                <li class="item" id="{{.Block}}">
+                    <div>{{.Author}}<span class="right">{{.Date}}</span></div>
+                    {{if .Body}}<div class="body">{{.Body}}</div>{{end}}
+                    {{range .Files}}
+                        <a href="{{.Src}}">{{if .Thumb}}<img class="thumb" src="{{.Thumb}}" alt="{{.Name}}">{{else}}/{{.Name}}{{end}}</a>
+                    {{end}}
+                    {{if .Likes}}<div>{{.Likes}} like{{if gt .Likes 1}}s{{end}}</div>{{end}}
+                    {{range .Comments}}
+                        <div class="comment">{{.Author}}: {{.Body}}</div>
+                    {{end}}
+                </li>
+            {{end}}
+        </ul>
+        {{if .next}}<div class="title"><a href="{{.next}}">older</a></div>{{end}}
+	</body>
+</html>
+`
@@ -0,0 +1,451 @@
+package gateway
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/util"
+	"github.com/gin-gonic/gin"
+)
+
+// default and max number of feed items per page
+const (
+	threadPageSize    = 20
+	threadPageSizeMax = 100
+)
+
+// thumbLinks are schema links rendered as file previews, in order of preference
+var thumbLinks = []string{"thumb", "small"}
+
+// sourceLinks are schema links opened from a file preview, in order of preference
+var sourceLinks = []string{"large", "medium", "small", "thumb"}
+
+// webItem is a feed item for HTML and feed rendering.
+// It must never carry keys, file content is served by threadFileHandler.
+type webItem struct {
+	Block    string
+	Date     string
+	Time     time.Time
+	Author   string
+	Body     string
+	Files    []webFile
+	Comments []webComment
+	Likes    int
+}
+
+// webFile is a file in a feed item
+type webFile struct {
+	Name  string
+	Thumb string
+	Src   string
+}
+
+// webComment is a comment on a feed item
+type webComment struct {
+	Author string
+	Body   string
+}
+
+// threadHandler renders a web published thread's feed
+func (g *Gateway) threadHandler(c *gin.Context) {
+	thrd, items, next := g.threadPage(c)
+	if thrd == nil {
+		return
+	}
+
+	base := "/threads/" + thrd.Id
+	var nextURL string
+	if next != "" {
+		nextURL = base + "?" + pageQuery(c, next)
+	}
+
+	c.HTML(http.StatusOK, "thread", gin.H{
+		"name":  thrd.Name,
+		"items": items,
+		"next":  nextURL,
+		"rss":   base + "/rss",
+		"atom":  base + "/atom",
+	})
+}
+
+// rss is an RSS 2.0 document
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	PubDate     string `xml:"pubDate"`
+	GUID        string `xml:"guid"`
+}
+
+// threadRSSHandler renders a web published thread's feed as RSS
+func (g *Gateway) threadRSSHandler(c *gin.Context) {
+	thrd, items, _ := g.threadPage(c)
+	if thrd == nil {
+		return
+	}
+
+	link := g.baseURL(c) + "/threads/" + thrd.Id
+	doc := rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       thrd.Name,
+			Link:        link,
+			Description: thrd.Name,
+		},
+	}
+	for _, item := range items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       itemTitle(item),
+			Link:        link + "#" + item.Block,
+			Description: itemHTML(g.baseURL(c), item),
+			PubDate:     item.Time.Format(time.RFC1123Z),
+			GUID:        item.Block,
+		})
+	}
+
+	renderXML(c, "application/rss+xml", doc)
+}
+
+// atomFeed is an Atom (RFC 4287) document
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Link    atomLink    `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// threadAtomHandler renders a web published thread's feed as Atom
+func (g *Gateway) threadAtomHandler(c *gin.Context) {
+	thrd, items, next := g.threadPage(c)
+	if thrd == nil {
+		return
+	}
+
+	link := g.baseURL(c) + "/threads/" + thrd.Id
+	doc := atomFeed{
+		Title: thrd.Name,
+		ID:    link,
+		Links: []atomLink{
+			{Href: link},
+			{Href: link + "/atom", Rel: "self"},
+		},
+	}
+	if next != "" {
+		doc.Links = append(doc.Links, atomLink{Href: link + "/atom?" + pageQuery(c, next), Rel: "next"})
+	}
+	updated := time.Unix(0, 0)
+	for _, item := range items {
+		if item.Time.After(updated) {
+			updated = item.Time
+		}
+		doc.Entries = append(doc.Entries, atomEntry{
+			Title:   itemTitle(item),
+			ID:      link + "#" + item.Block,
+			Updated: item.Time.Format(time.RFC3339),
+			Author:  atomAuthor{Name: item.Author},
+			Link:    atomLink{Href: link + "#" + item.Block},
+			Content: atomContent{Type: "html", Body: itemHTML(g.baseURL(c), item)},
+		})
+	}
+	doc.Updated = updated.UTC().Format(time.RFC3339)
+
+	renderXML(c, "application/atom+xml", doc)
+}
+
+// threadFileHandler serves decrypted file content from a web published thread.
+// The optional link query param selects a schema link, e.g., thumb.
+func (g *Gateway) threadFileHandler(c *gin.Context) {
+	id := c.Param("id")
+	if !g.Node.ThreadWebPublished(id) {
+		g.render404(c)
+		return
+	}
+
+	block, err := g.Node.Block(c.Param("block"))
+	if err != nil || block.Thread != id || block.Type != pb.Block_FILES {
+		g.render404(c)
+		return
+	}
+	files, err := g.Node.File(block.Id)
+	if err != nil {
+		log.Debugf("error getting files %s: %s", block.Id, err)
+		g.render404(c)
+		return
+	}
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil || index < 0 || index >= len(files.Files) || files.Files[index] == nil {
+		g.render404(c)
+		return
+	}
+
+	file := files.Files[index].File
+	if name := c.Query("link"); name != "" {
+		file = files.Files[index].Links[name]
+	}
+	if file == nil {
+		g.render404(c)
+		return
+	}
+
+	reader, err := g.Node.FileIndexContent(file)
+	if err != nil {
+		log.Debugf("error getting file content %s: %s", file.Hash, err)
+		g.render404(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=172800")
+	if err := streamContent(c, reader, file.Media); err != nil {
+		log.Debugf("error writing file content %s: %s", file.Hash, err)
+	}
+}
+
+// threadPage loads a page of a web published thread's feed, rendering a 404 and
+// returning a nil thread if it's not published
+func (g *Gateway) threadPage(c *gin.Context) (*pb.Thread, []webItem, string) {
+	id := c.Param("id")
+	if !g.Node.ThreadWebPublished(id) {
+		g.render404(c)
+		return nil, nil, ""
+	}
+	thrd, err := g.Node.ThreadView(id)
+	if err != nil {
+		log.Debugf("error getting thread %s: %s", id, err)
+		g.render404(c)
+		return nil, nil, ""
+	}
+
+	limit := threadPageSize
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > threadPageSizeMax {
+		limit = threadPageSizeMax
+	}
+
+	feed, err := g.Node.Feed(&pb.FeedRequest{
+		Thread: id,
+		Offset: c.Query("offset"),
+		Limit:  int32(limit),
+		Mode:   pb.FeedRequest_ANNOTATED,
+	})
+	if err != nil {
+		log.Debugf("error getting feed %s: %s", id, err)
+		g.render404(c)
+		return nil, nil, ""
+	}
+
+	items := make([]webItem, 0)
+	for _, item := range feed.Items {
+		witem, ok := webFeedItem(id, item)
+		if ok {
+			items = append(items, witem)
+		}
+	}
+	return thrd, items, feed.Next
+}
+
+// webFeedItem converts text and files feed items, other types are skipped
+func webFeedItem(threadId string, item *pb.FeedItem) (webItem, bool) {
+	payload, err := core.GetFeedItemPayload(item)
+	if err != nil {
+		return webItem{}, false
+	}
+
+	witem := webItem{
+		Block:  item.Block,
+		Time:   util.ProtoTime(payload.GetDate()),
+		Author: userName(payload.GetUser()),
+	}
+	witem.Date = witem.Time.Format("2006-01-02 15:04")
+
+	var comments []*pb.Comment
+	switch p := payload.(type) {
+	case *pb.Text:
+		witem.Body = p.Body
+		comments = p.Comments
+		witem.Likes = len(p.Likes)
+	case *pb.Files:
+		witem.Body = p.Caption
+		comments = p.Comments
+		witem.Likes = len(p.Likes)
+		for _, f := range p.Files {
+			if wf, ok := webFileItem(threadId, item.Block, f); ok {
+				witem.Files = append(witem.Files, wf)
+			}
+		}
+	default:
+		return webItem{}, false
+	}
+
+	for _, cm := range comments {
+		witem.Comments = append(witem.Comments, webComment{
+			Author: userName(cm.User),
+			Body:   cm.Body,
+		})
+	}
+	return witem, true
+}
+
+// webFileItem returns content urls for a file, preferring schema image links
+func webFileItem(threadId string, block string, file *pb.File) (webFile, bool) {
+	if file == nil {
+		return webFile{}, false
+	}
+	base := fmt.Sprintf("/threads/%s/files/%s/%d", threadId, block, file.Index)
+
+	if file.File != nil {
+		wf := webFile{Name: file.File.Name, Src: base}
+		if strings.HasPrefix(file.File.Media, "image/") {
+			wf.Thumb = base
+		}
+		return wf, true
+	}
+	if len(file.Links) == 0 {
+		return webFile{}, false
+	}
+
+	linkURL := func(name string) string {
+		return base + "?link=" + url.QueryEscape(name)
+	}
+	var wf webFile
+	for _, name := range thumbLinks {
+		if l := file.Links[name]; l != nil && strings.HasPrefix(l.Media, "image/") {
+			wf.Thumb = linkURL(name)
+			break
+		}
+	}
+	for _, name := range sourceLinks {
+		if l := file.Links[name]; l != nil {
+			wf.Name = l.Name
+			wf.Src = linkURL(name)
+			break
+		}
+	}
+	if wf.Src == "" {
+		names := make([]string, 0, len(file.Links))
+		for name := range file.Links {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		wf.Name = file.Links[names[0]].Name
+		wf.Src = linkURL(names[0])
+	}
+	return wf, true
+}
+
+// userName returns a display name for a user
+func userName(user *pb.User) string {
+	if user == nil {
+		return ""
+	}
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.Address
+}
+
+// itemTitle returns a short title for a feed entry
+func itemTitle(item webItem) string {
+	title := item.Body
+	if title == "" {
+		title = fmt.Sprintf("%d file(s)", len(item.Files))
+	}
+	if len(title) > 80 {
+		title = title[:80] + "…"
+	}
+	if item.Author != "" {
+		title = item.Author + ": " + title
+	}
+	return title
+}
+
+// itemHTML renders a feed entry's content, with absolute file urls
+func itemHTML(base string, item webItem) string {
+	var b strings.Builder
+	if item.Body != "" {
+		b.WriteString("<p>" + htmlEscape(item.Body) + "</p>")
+	}
+	for _, f := range item.Files {
+		src := htmlEscape(base + f.Src)
+		if f.Thumb != "" {
+			b.WriteString(`<a href="` + src + `"><img src="` + htmlEscape(base+f.Thumb) + `" alt="` + htmlEscape(f.Name) + `"></a>`)
+		} else {
+			b.WriteString(`<a href="` + src + `">` + htmlEscape(f.Name) + `</a>`)
+		}
+	}
+	return b.String()
+}
+
+// htmlEscape escapes text for html content
+func htmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// pageQuery returns the query string of the page starting at offset
+func pageQuery(c *gin.Context, offset string) string {
+	q := url.Values{}
+	q.Set("offset", offset)
+	if limit := c.Query("limit"); limit != "" {
+		q.Set("limit", limit)
+	}
+	return q.Encode()
+}
+
+// renderXML writes an xml document
+func renderXML(c *gin.Context, contentType string, doc interface{}) {
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Data(http.StatusOK, contentType+"; charset=utf-8", append([]byte(xml.Header), data...))
+}
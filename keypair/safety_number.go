@@ -0,0 +1,70 @@
+package keypair
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/strkey"
+)
+
+// safetyNumberIterations is the number of hash rounds used to derive a fingerprint
+const safetyNumberIterations = 5200
+
+// safetyNumberChunks is the number of 5-digit groups in each address fingerprint
+const safetyNumberChunks = 6
+
+// SafetyNumber returns a 60-digit number derived from both account addresses.
+// Two parties will compute the same number regardless of argument order,
+// which allows them to compare keys out of band.
+func SafetyNumber(a string, b string) (string, error) {
+	fa, err := safetyFingerprint(a)
+	if err != nil {
+		return "", err
+	}
+	fb, err := safetyFingerprint(b)
+	if err != nil {
+		return "", err
+	}
+
+	if a > b {
+		fa, fb = fb, fa
+	}
+	return fa + fb, nil
+}
+
+// FormatSafetyNumber splits a safety number into space separated groups of 5 digits
+func FormatSafetyNumber(num string) string {
+	var groups []string
+	for i := 0; i < len(num); i += 5 {
+		end := i + 5
+		if end > len(num) {
+			end = len(num)
+		}
+		groups = append(groups, num[i:end])
+	}
+	return strings.Join(groups, " ")
+}
+
+// safetyFingerprint returns a 30-digit fingerprint for a single address
+func safetyFingerprint(address string) (string, error) {
+	pub, err := strkey.Decode(strkey.VersionByteAccountID, address)
+	if err != nil {
+		return "", ErrInvalidKey
+	}
+
+	hash := append(pub, []byte(address)...)
+	for i := 0; i < safetyNumberIterations; i++ {
+		sum := sha512.Sum512(append(hash, pub...))
+		hash = sum[:]
+	}
+
+	var b strings.Builder
+	for i := 0; i < safetyNumberChunks; i++ {
+		chunk := hash[i*5 : i*5+5]
+		val := uint64(chunk[0])<<32 | uint64(chunk[1])<<24 | uint64(chunk[2])<<16 |
+			uint64(chunk[3])<<8 | uint64(chunk[4])
+		b.WriteString(fmt.Sprintf("%05d", val%100000))
+	}
+	return b.String(), nil
+}
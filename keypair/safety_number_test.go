@@ -0,0 +1,44 @@
+package keypair
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SafetyNumber", func() {
+	other := Random().Address()
+
+	It("returns 60 digits", func() {
+		num, err := SafetyNumber(address, other)
+		Expect(err).To(BeNil())
+		Expect(num).To(HaveLen(60))
+		Expect(strings.Trim(num, "0123456789")).To(BeEmpty())
+	})
+
+	It("is independent of argument order", func() {
+		a, err := SafetyNumber(address, other)
+		Expect(err).To(BeNil())
+		b, err := SafetyNumber(other, address)
+		Expect(err).To(BeNil())
+		Expect(a).To(Equal(b))
+	})
+
+	It("differs for different addresses", func() {
+		a, err := SafetyNumber(address, other)
+		Expect(err).To(BeNil())
+		b, err := SafetyNumber(address, Random().Address())
+		Expect(err).To(BeNil())
+		Expect(a).ToNot(Equal(b))
+	})
+
+	It("fails for a seed", func() {
+		_, err := SafetyNumber(seed, other)
+		Expect(err).To(Equal(ErrInvalidKey))
+	})
+
+	It("formats into groups", func() {
+		Expect(FormatSafetyNumber("1234567890")).To(Equal("12345 67890"))
+	})
+})
@@ -0,0 +1,95 @@
+// Package metrics exposes textile collectors in the prometheus text format.
+// Metric names are part of the public interface and should not change.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes all textile metric names
+const Namespace = "textile"
+
+// Registry holds the textile collectors, separate from the ipfs defaults
+var Registry = prometheus.NewRegistry()
+
+// EnvelopesHandled counts envelopes received by a service, by protocol, message type and result
+var EnvelopesHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: Namespace,
+	Subsystem: "service",
+	Name:      "envelopes_handled_total",
+	Help:      "Number of envelopes handled by protocol, message type and result.",
+}, []string{"protocol", "type", "result"})
+
+// CafeRequestDuration observes the time a cafe takes to handle client requests, by message type and result
+var CafeRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Subsystem: "cafe",
+	Name:      "request_duration_seconds",
+	Help:      "Time spent handling cafe requests by message type and result.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"type", "result"})
+
+// BlockDownloadFailures counts failed block downloads, by whether or not the download was dropped
+var BlockDownloadFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: Namespace,
+	Subsystem: "block_downloads",
+	Name:      "failures_total",
+	Help:      "Number of failed block downloads by whether or not the download was dropped.",
+}, []string{"dropped"})
+
+// HTTPRequestDuration observes http handler latencies, by server, method, route and status code
+var HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Subsystem: "http",
+	Name:      "request_duration_seconds",
+	Help:      "Time spent handling http requests by server, method, route and status code.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"server", "method", "route", "code"})
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		EnvelopesHandled,
+		CafeRequestDuration,
+		BlockDownloadFailures,
+		HTTPRequestDuration,
+	)
+}
+
+// Handler returns an http handler which serves the registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// Result returns the result label for an error
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// Gin returns middleware which observes request latencies for the named server.
+// Routes are labeled by their pattern, e.g., /api/v0/threads/:id, so ids don't
+// blow up the number of series.
+func Gin(server string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		start := time.Now()
+		g.Next()
+
+		route := g.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		HTTPRequestDuration.
+			WithLabelValues(server, g.Request.Method, route, strconv.Itoa(g.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
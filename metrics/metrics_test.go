@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGin(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	router.Use(Gin("test"))
+	router.GET("/things/:id", func(g *gin.Context) {
+		g.Status(http.StatusNoContent)
+	})
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", fmt.Sprintf("/things/%d", i), nil))
+	}
+
+	body := scrape(t)
+	series := `textile_http_request_duration_seconds_count{code="204",method="GET",route="/things/:id",server="test"} 3`
+	if !strings.Contains(body, series) {
+		t.Fatalf("expected series %s", series)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	EnvelopesHandled.WithLabelValues("/textile/threads/2.0.0", "THREAD_ENVELOPE", Result(nil)).Inc()
+	BlockDownloadFailures.WithLabelValues("false").Inc()
+
+	body := scrape(t)
+	for _, name := range []string{
+		"textile_service_envelopes_handled_total",
+		"textile_block_downloads_failures_total",
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, name) {
+			t.Fatalf("expected metric %s", name)
+		}
+	}
+}
+
+func scrape(t *testing.T) string {
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
@@ -0,0 +1,181 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"strings"
+
+	"github.com/chai2010/webp"
+	"github.com/disintegration/imaging"
+	"github.com/rwcarlsen/goexif/exif"
+)
+
+// Format enumerates the type of images currently supported
+type Format string
+
+const (
+	JPEG Format = "jpeg"
+	PNG  Format = "png"
+	GIF  Format = "gif"
+	WEBP Format = "webp"
+	HEIC Format = "heic"
+)
+
+// ErrUnsupportedFormat indicates an image can't be written in the requested format
+var ErrUnsupportedFormat = fmt.Errorf("unsupported output format")
+
+// imageMedia lists the media types accepted by the image mills
+var imageMedia = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"image/heic",
+	"image/heif",
+}
+
+// decodeImage decodes an image, applying its exif orientation.
+// Only the first frame of a gif is returned.
+func decodeImage(input []byte) (image.Image, Format, error) {
+	img, formatStr, err := image.Decode(bytes.NewReader(input))
+	if err != nil {
+		return nil, "", err
+	}
+	format := Format(formatStr)
+
+	return reverseOrientation(img, imageOrientation(input, format)), format, nil
+}
+
+// imageOrientation returns the exif orientation (1-8) of an image
+func imageOrientation(input []byte, format Format) string {
+	var exf *exif.Exif
+	switch format {
+	case JPEG:
+		exf, _ = exif.Decode(bytes.NewReader(input))
+	case WEBP:
+		if data := webpExif(input); data != nil {
+			exf, _ = exif.Decode(bytes.NewReader(data))
+		}
+	default:
+		// heic decoding already applies its rotation and mirror properties
+		return "1"
+	}
+	if exf == nil {
+		return "1"
+	}
+
+	orient, err := exf.Get(exif.Orientation)
+	if err != nil {
+		return "1"
+	}
+	return orient.String()
+}
+
+// webpExif returns the raw exif data of a webp image, if any
+func webpExif(input []byte) []byte {
+	if len(input) < 12 || string(input[:4]) != "RIFF" || string(input[8:12]) != "WEBP" {
+		return nil
+	}
+	for pos := 12; pos+8 <= len(input); {
+		id := string(input[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(input[pos+4 : pos+8]))
+		start := pos + 8
+		if size < 0 || size > len(input)-start {
+			return nil
+		}
+		if id == "EXIF" {
+			return bytes.TrimPrefix(input[start:start+size], []byte("Exif\x00\x00"))
+		}
+		pos = start + size + size%2
+	}
+	return nil
+}
+
+// outputFormat returns the format an image should be written in,
+// which defaults to the source format, or jpeg for heic sources
+func outputFormat(source Format, requested string) (Format, error) {
+	switch Format(strings.ToLower(requested)) {
+	case "":
+		if source == HEIC {
+			return JPEG, nil
+		}
+		return source, nil
+	case JPEG, "jpg":
+		return JPEG, nil
+	case PNG:
+		return PNG, nil
+	case GIF:
+		return GIF, nil
+	case WEBP:
+		return WEBP, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// encodeImage writes a single image (quality applies to jpeg and webp only)
+// NOTE: re-encoding removes any exif data
+func encodeImage(img image.Image, format Format, quality int) (*bytes.Buffer, error) {
+	buff := new(bytes.Buffer)
+	var err error
+
+	switch format {
+	case JPEG:
+		err = jpeg.Encode(buff, img, &jpeg.Options{Quality: quality})
+	case PNG:
+		// NOTE: while PNGs don't technically have exif data,
+		// they can contain meta data with sensitive info
+		err = png.Encode(buff, img)
+	case GIF:
+		err = gif.Encode(buff, imageToPaletted(img), nil)
+	case WEBP:
+		err = webp.Encode(buff, img, &webp.Options{Quality: float32(quality)})
+	default:
+		err = ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// reverseOrientation transforms the given orientation to 1
+func reverseOrientation(img image.Image, orientation string) image.Image {
+	switch orientation {
+	case "1":
+		return img
+	case "2":
+		return imaging.FlipH(img)
+	case "3":
+		return imaging.Rotate180(img)
+	case "4":
+		return imaging.FlipV(img)
+	case "5":
+		return imaging.Transpose(img)
+	case "6":
+		return imaging.Rotate270(img)
+	case "7":
+		return imaging.Transverse(img)
+	case "8":
+		return imaging.Rotate90(img)
+	}
+
+	log.Warningf("unknown orientation %s, expected 1-8", orientation)
+	return img
+}
+
+// orientedSize returns the displayed size of an image with the given exif orientation
+func orientedSize(width int, height int, orientation string) (int, int) {
+	switch orientation {
+	case "5", "6", "7", "8":
+		return height, width
+	default:
+		return width, height
+	}
+}
@@ -0,0 +1,227 @@
+package mill
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/disintegration/imaging"
+)
+
+// Crop strategies choose which part of an image is kept
+const (
+	CropCenter  = "center"
+	CropEntropy = "entropy"
+)
+
+// entropySample is the max dimension of the copy used to score entropy crops
+const entropySample = 256
+
+// entropySteps is the max number of window positions scored along the cropped axis
+const entropySteps = 32
+
+type ImageCropOpts struct {
+	Aspect   string `json:"aspect"`
+	Square   string `json:"square,omitempty"`
+	Strategy string `json:"strategy,omitempty"`
+	Width    string `json:"width,omitempty"`
+	Quality  string `json:"quality"`
+	Format   string `json:"format,omitempty"`
+}
+
+// ImageCrop crops an image to a fixed aspect ratio, keeping either the center
+// or the most detailed (highest entropy) region, and optionally scales it down
+type ImageCrop struct {
+	Opts ImageCropOpts
+}
+
+func (m *ImageCrop) ID() string {
+	return "/image/crop"
+}
+
+func (m *ImageCrop) Encrypt() bool {
+	return true
+}
+
+func (m *ImageCrop) Pin() bool {
+	return false
+}
+
+func (m *ImageCrop) AcceptMedia(media string) error {
+	return accepts(imageMedia, media)
+}
+
+func (m *ImageCrop) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(m.Opts, add)
+}
+
+func (m *ImageCrop) Mill(input []byte, name string) (*Result, error) {
+	aw, ah, err := m.aspect()
+	if err != nil {
+		return nil, err
+	}
+	width, err := optionalSize(m.Opts.Width)
+	if err != nil {
+		return nil, fmt.Errorf("invalid width: " + m.Opts.Width)
+	}
+	quality, err := strconv.Atoi(m.Opts.Quality)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quality: " + m.Opts.Quality)
+	}
+
+	img, format, err := decodeImage(input)
+	if err != nil {
+		return nil, err
+	}
+	out, err := outputFormat(format, m.Opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	b := img.Bounds()
+	cw, ch := cropSize(b.Dx(), b.Dy(), aw, ah)
+
+	var rect image.Rectangle
+	switch m.Opts.Strategy {
+	case "", CropCenter:
+		rect = centerCrop(b, cw, ch)
+	case CropEntropy:
+		rect = entropyCrop(img, cw, ch)
+	default:
+		return nil, fmt.Errorf("invalid strategy: " + m.Opts.Strategy)
+	}
+
+	cropped := fitImage(imaging.Crop(img, rect), width, 0)
+	buff, err := encodeImage(cropped, out, quality)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		File: buff.Bytes(),
+		Meta: map[string]interface{}{
+			"width":  cropped.Bounds().Dx(),
+			"height": cropped.Bounds().Dy(),
+			"format": string(out),
+		},
+	}, nil
+}
+
+// aspect parses the aspect option, e.g., "16:9", which is 1:1 for square crops
+func (m *ImageCrop) aspect() (int, int, error) {
+	if m.Opts.Square == "true" {
+		return 1, 1, nil
+	}
+	parts := strings.Split(m.Opts.Aspect, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid aspect: " + m.Opts.Aspect)
+	}
+	w, err := strconv.Atoi(parts[0])
+	if err != nil || w < 1 {
+		return 0, 0, fmt.Errorf("invalid aspect: " + m.Opts.Aspect)
+	}
+	h, err := strconv.Atoi(parts[1])
+	if err != nil || h < 1 {
+		return 0, 0, fmt.Errorf("invalid aspect: " + m.Opts.Aspect)
+	}
+	return w, h, nil
+}
+
+// cropSize returns the largest size with the given aspect ratio that fits in an image
+func cropSize(srcW int, srcH int, aw int, ah int) (int, int) {
+	if srcW*ah > srcH*aw {
+		w := int(float64(srcH)*float64(aw)/float64(ah) + 0.5)
+		if w < 1 {
+			w = 1
+		}
+		return w, srcH
+	}
+	h := int(float64(srcW)*float64(ah)/float64(aw) + 0.5)
+	if h < 1 {
+		h = 1
+	}
+	return srcW, h
+}
+
+// centerCrop returns a centered rectangle of the given size
+func centerCrop(b image.Rectangle, cw int, ch int) image.Rectangle {
+	x := b.Min.X + (b.Dx()-cw)/2
+	y := b.Min.Y + (b.Dy()-ch)/2
+	return image.Rect(x, y, x+cw, y+ch)
+}
+
+// entropyCrop returns the rectangle of the given size with the most detail,
+// scored by the entropy of its grayscale histogram
+func entropyCrop(img image.Image, cw int, ch int) image.Rectangle {
+	b := img.Bounds()
+	scale := 1.0
+	if max := maxInt(b.Dx(), b.Dy()); max > entropySample {
+		scale = float64(entropySample) / float64(max)
+	}
+	sample := imaging.Grayscale(imaging.Resize(img, maxInt(1, int(float64(b.Dx())*scale)), 0, imaging.Box))
+	sb := sample.Bounds()
+	sw := minInt(sb.Dx(), maxInt(1, int(float64(cw)*scale)))
+	sh := minInt(sb.Dy(), maxInt(1, int(float64(ch)*scale)))
+
+	dx, dy := sb.Dx()-sw, sb.Dy()-sh
+	best := -1.0
+	var bx, by int
+	for x := 0; x <= dx; x += cropStep(dx) {
+		for y := 0; y <= dy; y += cropStep(dy) {
+			e := entropy(sample, image.Rect(x, y, x+sw, y+sh))
+			if e > best {
+				best, bx, by = e, x, y
+			}
+		}
+	}
+
+	x := minInt(int(float64(bx)/scale+0.5), b.Dx()-cw)
+	y := minInt(int(float64(by)/scale+0.5), b.Dy()-ch)
+	return image.Rect(b.Min.X+x, b.Min.Y+y, b.Min.X+x+cw, b.Min.Y+y+ch)
+}
+
+// cropStep returns the distance between scored window positions
+func cropStep(free int) int {
+	if free < entropySteps {
+		return 1
+	}
+	return free / entropySteps
+}
+
+// entropy returns the shannon entropy of a grayscale region
+func entropy(img *image.NRGBA, r image.Rectangle) float64 {
+	var hist [256]int
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		row := img.Pix[y*img.Stride:]
+		for x := r.Min.X; x < r.Max.X; x++ {
+			hist[row[x*4]]++
+		}
+	}
+
+	total := float64(r.Dx() * r.Dy())
+	var e float64
+	for _, n := range hist {
+		if n == 0 {
+			continue
+		}
+		p := float64(n) / total
+		e -= p * math.Log2(p)
+	}
+	return e
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+package mill
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/mill/testdata"
+)
+
+func TestImageCrop_Mill(t *testing.T) {
+	m := &ImageCrop{
+		Opts: ImageCropOpts{
+			Aspect:  "16:9",
+			Quality: "80",
+		},
+	}
+
+	for _, i := range testdata.Images {
+		file, err := os.Open(i.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		input, err := ioutil.ReadAll(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+
+		res, err := m.Mill(input, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		width := res.Meta["width"].(int)
+		height := res.Meta["height"].(int)
+		if width != i.Width {
+			t.Errorf("wrong width: %d", width)
+		}
+		if d := width*9 - height*16; d > 16 || d < -16 {
+			t.Errorf("wrong aspect: %dx%d", width, height)
+		}
+		if res.Meta["format"] != i.Format {
+			t.Errorf("wrong format")
+		}
+	}
+}
+
+func TestImageCrop_MillSquare(t *testing.T) {
+	input, err := ioutil.ReadFile("testdata/image.jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &ImageCrop{
+		Opts: ImageCropOpts{
+			Square:   "true",
+			Strategy: CropEntropy,
+			Width:    "100",
+			Quality:  "80",
+			Format:   "webp",
+		},
+	}
+	res, err := m.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != string(WEBP) {
+		t.Errorf("wrong format: %s", format)
+	}
+	if conf.Width != 100 || conf.Height != 100 {
+		t.Errorf("wrong size: %dx%d", conf.Width, conf.Height)
+	}
+}
+
+func TestImageCrop_MillEntropy(t *testing.T) {
+	// flat on the left, noisy on the right
+	img := image.NewGray(image.Rect(0, 0, 400, 100))
+	rnd := rand.New(rand.NewSource(1))
+	for x := 0; x < 400; x++ {
+		for y := 0; y < 100; y++ {
+			v := uint8(128)
+			if x >= 300 {
+				v = uint8(rnd.Intn(256))
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	buff := new(bytes.Buffer)
+	if err := png.Encode(buff, img); err != nil {
+		t.Fatal(err)
+	}
+
+	rect := entropyCrop(img, 100, 100)
+	if rect.Min.X < 280 {
+		t.Errorf("entropy crop missed the detailed region: %v", rect)
+	}
+
+	m := &ImageCrop{Opts: ImageCropOpts{Square: "true", Strategy: CropCenter, Quality: "80"}}
+	res, err := m.Mill(buff.Bytes(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Meta["width"] != 100 || res.Meta["height"] != 100 {
+		t.Errorf("wrong size: %v", res.Meta)
+	}
+
+	m.Opts.Strategy = "nope"
+	if _, err := m.Mill(buff.Bytes(), "test"); err == nil {
+		t.Errorf("invalid strategy was accepted")
+	}
+}
@@ -37,11 +37,7 @@ func (m *ImageExif) Pin() bool {
 }
 
 func (m *ImageExif) AcceptMedia(media string) error {
-	return accepts([]string{
-		"image/jpeg",
-		"image/png",
-		"image/gif",
-	}, media)
+	return accepts(imageMedia, media)
 }
 
 func (m *ImageExif) Options(add map[string]interface{}) (string, error) {
@@ -55,6 +51,9 @@ func (m *ImageExif) Mill(input []byte, name string) (*Result, error) {
 	}
 	format := Format(formatStr)
 
+	// report the displayed size
+	width, height := orientedSize(conf.Width, conf.Height, imageOrientation(input, format))
+
 	var created time.Time
 	var lat, lon float64
 
@@ -76,8 +75,8 @@ func (m *ImageExif) Mill(input []byte, name string) (*Result, error) {
 		Name:      name,
 		Ext:       strings.ToLower(filepath.Ext(name)),
 		Format:    string(format),
-		Width:     conf.Width,
-		Height:    conf.Height,
+		Width:     width,
+		Height:    height,
 		Latitude:  lat,
 		Longitude: lon,
 	}
@@ -87,5 +86,20 @@ func (m *ImageExif) Mill(input []byte, name string) (*Result, error) {
 		return nil, err
 	}
 
-	return &Result{File: data}, nil
+	orientation := "square"
+	if height > width {
+		orientation = "portrait"
+	} else if width > height {
+		orientation = "landscape"
+	}
+
+	return &Result{
+		File: data,
+		Meta: map[string]interface{}{
+			"format":      res.Format,
+			"width":       res.Width,
+			"height":      res.Height,
+			"orientation": orientation,
+		},
+	}, nil
 }
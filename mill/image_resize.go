@@ -7,22 +7,9 @@ import (
 	"image/color/palette"
 	"image/draw"
 	"image/gif"
-	"image/jpeg"
-	"image/png"
-	"io"
 	"strconv"
 
 	"github.com/disintegration/imaging"
-	"github.com/rwcarlsen/goexif/exif"
-)
-
-// Format enumerates the type of images currently supported
-type Format string
-
-const (
-	JPEG Format = "jpeg"
-	PNG  Format = "png"
-	GIF  Format = "gif"
 )
 
 type ImageSize struct {
@@ -32,7 +19,9 @@ type ImageSize struct {
 
 type ImageResizeOpts struct {
 	Width   string `json:"width"`
+	Height  string `json:"height,omitempty"`
 	Quality string `json:"quality"`
+	Format  string `json:"format,omitempty"`
 }
 
 type ImageResize struct {
@@ -52,11 +41,7 @@ func (m *ImageResize) Pin() bool {
 }
 
 func (m *ImageResize) AcceptMedia(media string) error {
-	return accepts([]string{
-		"image/jpeg",
-		"image/png",
-		"image/gif",
-	}, media)
+	return accepts(imageMedia, media)
 }
 
 func (m *ImageResize) Options(add map[string]interface{}) (string, error) {
@@ -64,186 +49,138 @@ func (m *ImageResize) Options(add map[string]interface{}) (string, error) {
 }
 
 func (m *ImageResize) Mill(input []byte, name string) (*Result, error) {
-	img, formatStr, err := image.Decode(bytes.NewReader(input))
+	width, err := optionalSize(m.Opts.Width)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid width: " + m.Opts.Width)
 	}
-	format := Format(formatStr)
-
-	clean, err := removeExif(bytes.NewReader(input), img, format)
+	height, err := optionalSize(m.Opts.Height)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid height: " + m.Opts.Height)
 	}
-
-	width, err := strconv.Atoi(m.Opts.Width)
-	if err != nil {
-		return nil, fmt.Errorf("invalid width: " + m.Opts.Width)
+	if width == 0 && height == 0 {
+		return nil, fmt.Errorf("missing width or height")
 	}
 	quality, err := strconv.Atoi(m.Opts.Quality)
 	if err != nil {
 		return nil, fmt.Errorf("invalid quality: " + m.Opts.Quality)
 	}
 
-	buff, rect, err := encodeImage(clean, format, width, quality)
+	img, format, err := decodeImage(input)
 	if err != nil {
 		return nil, err
 	}
-
-	return &Result{
-		File: buff.Bytes(),
-		Meta: map[string]interface{}{
-			"width":  rect.Dx(),
-			"height": rect.Dy(),
-		},
-	}, nil
-}
-
-// removeExif strips exif data from an image
-func removeExif(reader io.Reader, img image.Image, format Format) (io.Reader, error) {
-	if format == GIF {
-		return reader, nil
-	}
-
-	exf, _ := exif.Decode(reader)
-	var err error
-	img, err = correctOrientation(img, exf)
+	out, err := outputFormat(format, m.Opts.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	// re-encoding will remove any exif
-	return encodeSingleImage(img, format)
-}
-
-// encodeImage creates a jpeg|gif from reader (quality applies to jpeg only)
-// NOTE: format is the reader image format, destination format is chosen accordingly.
-func encodeImage(reader io.Reader, format Format, width int, quality int) (*bytes.Buffer, *image.Rectangle, error) {
-	buff := new(bytes.Buffer)
+	var buff *bytes.Buffer
 	var size image.Rectangle
-
-	if format != GIF {
-		// encode to png or jpeg
-		img, _, err := image.Decode(reader)
+	if format == GIF && out == GIF {
+		// keep animations
+		buff, size, err = resizeGif(input, width, height)
 		if err != nil {
-			return nil, nil, err
-		}
-
-		if img.Bounds().Size().X < width {
-			width = img.Bounds().Size().X
-		}
-
-		resized := imaging.Resize(img, width, 0, imaging.Lanczos)
-
-		if format == PNG {
-			if err = png.Encode(buff, resized); err != nil {
-				return nil, nil, err
-			}
-		} else {
-			if err = jpeg.Encode(buff, resized, &jpeg.Options{Quality: quality}); err != nil {
-				return nil, nil, err
-			}
+			return nil, err
 		}
-		size = resized.Rect
-
 	} else {
-		// encode to gif
-		img, err := gif.DecodeAll(reader)
+		resized := fitImage(img, width, height)
+		buff, err = encodeImage(resized, out, quality)
 		if err != nil {
-			return nil, nil, err
-		}
-		if len(img.Image) == 0 {
-			return nil, nil, fmt.Errorf("gif does not have any frames")
-		}
-
-		firstFrame := img.Image[0].Bounds()
-		if firstFrame.Dx() < width {
-			width = firstFrame.Dx()
+			return nil, err
 		}
-		rect := image.Rect(0, 0, firstFrame.Dx(), firstFrame.Dy())
-		rgba := image.NewRGBA(rect)
-		for index, frame := range img.Image {
-			bounds := frame.Bounds()
-			draw.Draw(rgba, bounds, frame, bounds.Min, draw.Over)
-			img.Image[index] = imageToPaletted(imaging.Resize(rgba, width, 0, imaging.Lanczos))
-		}
-
-		img.Config.Width = img.Image[0].Bounds().Dx()
-		img.Config.Height = img.Image[0].Bounds().Dy()
-
-		if err = gif.EncodeAll(buff, img); err != nil {
-			return nil, nil, err
-		}
-
-		size = img.Image[0].Bounds()
+		size = resized.Bounds()
 	}
 
-	return buff, &size, nil
+	return &Result{
+		File: buff.Bytes(),
+		Meta: map[string]interface{}{
+			"width":  size.Dx(),
+			"height": size.Dy(),
+			"format": string(out),
+		},
+	}, nil
 }
 
-// correctOrientation returns a copy of an image (jpg|png|gif) with exif removed
-func correctOrientation(img image.Image, exf *exif.Exif) (image.Image, error) {
-	if exf == nil {
-		return img, nil
+// optionalSize parses a dimension option, zero if empty
+func optionalSize(opt string) (int, error) {
+	if opt == "" {
+		return 0, nil
 	}
-
-	orient, err := exf.Get(exif.Orientation)
-	if err != nil && err != exif.TagNotPresentError(exif.Orientation) {
-		return nil, err
+	size, err := strconv.Atoi(opt)
+	if err != nil {
+		return 0, err
 	}
-	if orient != nil {
-		img = reverseOrientation(img, orient.String())
-	} else {
-		img = reverseOrientation(img, "1")
+	if size < 0 {
+		return 0, fmt.Errorf("negative size")
 	}
+	return size, nil
+}
 
-	return img, nil
+// fitSize scales a size down to fit within width and/or height (zero means unbounded),
+// preserving its aspect ratio. Images are never enlarged.
+func fitSize(srcW int, srcH int, width int, height int) (int, int) {
+	if srcW == 0 || srcH == 0 {
+		return srcW, srcH
+	}
+	scale := 1.0
+	if width > 0 && width < srcW {
+		scale = float64(width) / float64(srcW)
+	}
+	if height > 0 && height < srcH {
+		if s := float64(height) / float64(srcH); s < scale {
+			scale = s
+		}
+	}
+	w := int(float64(srcW)*scale + 0.5)
+	h := int(float64(srcH)*scale + 0.5)
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	return w, h
 }
 
-// encodeSingleImage creates a reader from an image
-func encodeSingleImage(img image.Image, format Format) (*bytes.Reader, error) {
-	writer := &bytes.Buffer{}
-	var err error
-
-	switch format {
-	case JPEG:
-		err = jpeg.Encode(writer, img, &jpeg.Options{Quality: 100})
-	case PNG:
-		// NOTE: while PNGs don't technically have exif data,
-		// they can contain meta data with sensitive info
-		err = png.Encode(writer, img)
-	default:
-		err = fmt.Errorf("unrecognized image format")
+// fitImage resizes an image to fit within width and/or height
+func fitImage(img image.Image, width int, height int) image.Image {
+	b := img.Bounds()
+	w, h := fitSize(b.Dx(), b.Dy(), width, height)
+	if w == b.Dx() && h == b.Dy() {
+		return img
 	}
+	return imaging.Resize(img, w, h, imaging.Lanczos)
+}
+
+// resizeGif resizes each frame of a (possibly animated) gif
+func resizeGif(input []byte, width int, height int) (*bytes.Buffer, image.Rectangle, error) {
+	img, err := gif.DecodeAll(bytes.NewReader(input))
 	if err != nil {
-		return nil, err
+		return nil, image.Rectangle{}, err
+	}
+	if len(img.Image) == 0 {
+		return nil, image.Rectangle{}, fmt.Errorf("gif does not have any frames")
 	}
 
-	return bytes.NewReader(writer.Bytes()), nil
-}
+	firstFrame := img.Image[0].Bounds()
+	w, h := fitSize(firstFrame.Dx(), firstFrame.Dy(), width, height)
+	rect := image.Rect(0, 0, firstFrame.Dx(), firstFrame.Dy())
+	rgba := image.NewRGBA(rect)
+	for index, frame := range img.Image {
+		bounds := frame.Bounds()
+		draw.Draw(rgba, bounds, frame, bounds.Min, draw.Over)
+		img.Image[index] = imageToPaletted(imaging.Resize(rgba, w, h, imaging.Lanczos))
+	}
+
+	img.Config.Width = img.Image[0].Bounds().Dx()
+	img.Config.Height = img.Image[0].Bounds().Dy()
+
+	buff := new(bytes.Buffer)
+	if err = gif.EncodeAll(buff, img); err != nil {
+		return nil, image.Rectangle{}, err
+	}
 
-// reverseOrientation transforms the given orientation to 1
-func reverseOrientation(img image.Image, orientation string) *image.NRGBA {
-	switch orientation {
-	case "1":
-		return imaging.Clone(img)
-	case "2":
-		return imaging.FlipV(img)
-	case "3":
-		return imaging.Rotate180(img)
-	case "4":
-		return imaging.Rotate180(imaging.FlipV(img))
-	case "5":
-		return imaging.Rotate270(imaging.FlipV(img))
-	case "6":
-		return imaging.Rotate270(img)
-	case "7":
-		return imaging.Rotate90(imaging.FlipV(img))
-	case "8":
-		return imaging.Rotate90(img)
-	}
-
-	log.Warningf("unknown orientation %s, expected 1-8", orientation)
-	return imaging.Clone(img)
+	return buff, img.Image[0].Bounds(), nil
 }
 
 // imageToPaletted convert Image to Paletted for GIF handling
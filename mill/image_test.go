@@ -0,0 +1,185 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"testing"
+
+	"github.com/chai2010/webp"
+)
+
+func TestDetectMedia(t *testing.T) {
+	input, err := ioutil.ReadFile("testdata/image.heic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if media := DetectMedia(input); media != "image/heic" {
+		t.Errorf("wrong heic media type: %s", media)
+	}
+
+	buff := new(bytes.Buffer)
+	if err := webp.Encode(buff, testImage(10, 10), nil); err != nil {
+		t.Fatal(err)
+	}
+	if media := DetectMedia(buff.Bytes()); media != "image/webp" {
+		t.Errorf("wrong webp media type: %s", media)
+	}
+}
+
+func TestImageResize_MillHeic(t *testing.T) {
+	input, err := ioutil.ReadFile("testdata/image.heic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &ImageResize{Opts: ImageResizeOpts{Width: "100", Quality: "80"}}
+	res, err := m.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Meta["width"] != 100 {
+		t.Errorf("wrong width")
+	}
+
+	// heic is written as jpeg by default
+	_, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != string(JPEG) {
+		t.Errorf("wrong format: %s", format)
+	}
+}
+
+func TestImageResize_MillWebp(t *testing.T) {
+	input, err := ioutil.ReadFile("testdata/image.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &ImageResize{Opts: ImageResizeOpts{Height: "150", Quality: "80", Format: "webp"}}
+	res, err := m.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != string(WEBP) {
+		t.Errorf("wrong format: %s", format)
+	}
+	if conf.Width != 150 || conf.Height != 150 {
+		t.Errorf("wrong size: %dx%d", conf.Width, conf.Height)
+	}
+
+	// webp input
+	m.Opts = ImageResizeOpts{Width: "50", Quality: "80"}
+	res, err = m.Mill(res.File, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Meta["width"] != 50 || res.Meta["format"] != string(WEBP) {
+		t.Errorf("wrong webp resize result: %v", res.Meta)
+	}
+}
+
+func TestImageOrientation(t *testing.T) {
+	buff := new(bytes.Buffer)
+	if err := webp.Encode(buff, testImage(40, 20), nil); err != nil {
+		t.Fatal(err)
+	}
+	input := webpWithOrientation(t, buff.Bytes(), 6)
+
+	if o := imageOrientation(input, WEBP); o != "6" {
+		t.Fatalf("wrong orientation: %s", o)
+	}
+
+	img, _, err := decodeImage(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds().Dx() != 20 || img.Bounds().Dy() != 40 {
+		t.Errorf("orientation was not applied: %v", img.Bounds())
+	}
+
+	res, err := (&ImageExif{}).Mill(input, "test.webp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Meta["orientation"] != "portrait" {
+		t.Errorf("wrong exif orientation: %v", res.Meta["orientation"])
+	}
+}
+
+func TestReverseOrientation(t *testing.T) {
+	// a marked top-left pixel should end up top-left after correction
+	for o, at := range map[string]image.Point{
+		"1": {0, 0},
+		"2": {3, 0},
+		"3": {3, 1},
+		"4": {0, 1},
+		"5": {0, 0},
+		"6": {0, 3},
+		"7": {1, 3},
+		"8": {1, 0},
+	} {
+		img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+		if o == "5" || o == "6" || o == "7" || o == "8" {
+			img = image.NewNRGBA(image.Rect(0, 0, 2, 4))
+		}
+		img.Set(at.X, at.Y, color.White)
+
+		fixed := reverseOrientation(img, o)
+		r, _, _, _ := fixed.At(0, 0).RGBA()
+		if r == 0 {
+			t.Errorf("orientation %s was not reversed", o)
+		}
+	}
+}
+
+func TestWebpExif_Truncated(t *testing.T) {
+	// a chunk claiming more bytes than remain must not be read
+	input := make([]byte, 20)
+	copy(input, "RIFF")
+	copy(input[8:], "WEBPEXIF")
+	binary.LittleEndian.PutUint32(input[16:], 0xffffffff)
+	if exf := webpExif(input); exf != nil {
+		t.Error("truncated exif chunk should be ignored")
+	}
+}
+
+// testImage returns an image with a gradient
+func testImage(width int, height int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 255 / width), G: uint8(y * 255 / height), A: 255})
+		}
+	}
+	return img
+}
+
+// webpWithOrientation appends an exif chunk with an orientation tag to a webp image
+func webpWithOrientation(t *testing.T, input []byte, orientation uint16) []byte {
+	tiff := []byte{
+		'I', 'I', 0x2a, 0x00, 0x08, 0x00, 0x00, 0x00, // header, ifd at offset 8
+		0x01, 0x00, // one entry
+		0x12, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00, 0x00, // orientation, short, count 1
+		byte(orientation), byte(orientation >> 8), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // no next ifd
+	}
+
+	out := append([]byte{}, input...)
+	chunk := make([]byte, 8)
+	copy(chunk, "EXIF")
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(len(tiff)))
+	out = append(out, chunk...)
+	out = append(out, tiff...)
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out
+}
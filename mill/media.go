@@ -0,0 +1,39 @@
+package mill
+
+import (
+	"bytes"
+	"image"
+	"net/http"
+
+	"github.com/gen2brain/heic"
+)
+
+// heifBrands are ftyp brands of HEIC / HEIF images, e.g., from iPhone cameras
+var heifBrands = []string{"heic", "heix", "hevc", "hevx", "heim", "heis", "mif1", "msf1"}
+
+func init() {
+	// the heic package only registers the heic brand
+	for _, brand := range heifBrands[1:] {
+		image.RegisterFormat("heic", "????ftyp"+brand, heic.Decode, heic.DecodeConfig)
+	}
+}
+
+// DetectMedia returns the media type of data, adding image types
+// which are not known to http.DetectContentType
+func DetectMedia(data []byte) string {
+	if len(data) > 512 {
+		data = data[:512]
+	}
+	if len(data) >= 12 && bytes.Equal(data[4:8], []byte("ftyp")) {
+		brand := string(data[8:12])
+		for _, b := range heifBrands {
+			if brand == b {
+				if brand == "mif1" || brand == "msf1" {
+					return "image/heif"
+				}
+				return "image/heic"
+			}
+		}
+	}
+	return http.DetectContentType(data)
+}
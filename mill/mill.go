@@ -56,3 +56,52 @@ func hashOpts(opts interface{}, add map[string]interface{}) (string, error) {
 	sum := sha256.Sum256(data)
 	return base58.FastBase58Encoding(sum[:]), nil
 }
+
+// New returns the mill with the given id configured by schema opts,
+// or nil if the id is not a known mill
+func New(id string, opts map[string]string) (Mill, error) {
+	switch id {
+	case "/blob":
+		return &Blob{}, nil
+	case "/image/resize":
+		if opts["width"] == "" && opts["height"] == "" {
+			return nil, fmt.Errorf("missing width or height")
+		}
+		return &ImageResize{
+			Opts: ImageResizeOpts{
+				Width:   opts["width"],
+				Height:  opts["height"],
+				Quality: imageQuality(opts),
+				Format:  opts["format"],
+			},
+		}, nil
+	case "/image/crop":
+		if opts["aspect"] == "" && opts["square"] != "true" {
+			return nil, fmt.Errorf("missing aspect")
+		}
+		return &ImageCrop{
+			Opts: ImageCropOpts{
+				Aspect:   opts["aspect"],
+				Square:   opts["square"],
+				Strategy: opts["strategy"],
+				Width:    opts["width"],
+				Quality:  imageQuality(opts),
+				Format:   opts["format"],
+			},
+		}, nil
+	case "/image/exif":
+		return &ImageExif{}, nil
+	case "/json":
+		return &Json{}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// imageQuality returns the quality opt of an image mill, which defaults to 75
+func imageQuality(opts map[string]string) string {
+	if opts["quality"] == "" {
+		return "75"
+	}
+	return opts["quality"]
+}
@@ -0,0 +1,26 @@
+package mill
+
+import "testing"
+
+func TestNew(t *testing.T) {
+	m, err := New("/image/resize", map[string]string{"width": "320"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resize, ok := m.(*ImageResize)
+	if !ok {
+		t.Fatal("expected an image resize mill")
+	}
+	if resize.Opts.Width != "320" || resize.Opts.Quality != "75" {
+		t.Fatal("resize opts were not applied")
+	}
+
+	if _, err := New("/image/resize", nil); err == nil {
+		t.Fatal("resize without a width should fail")
+	}
+
+	m, err = New("/unknown", nil)
+	if err != nil || m != nil {
+		t.Fatal("unknown mill should be nil")
+	}
+}
@@ -0,0 +1,97 @@
+package mill
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/schema"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// emptyOptions describes mills without options
+const emptyOptions = `{"type": "object", "additionalProperties": false}`
+
+// optionSchemas are json schemas (json-schema.org) describing the typed options of each mill
+var optionSchemas = map[string]string{
+	"/blob": emptyOptions,
+	"/image/resize": `{
+		"type": "object",
+		"properties": {
+			"width": {"type": "integer", "minimum": 1},
+			"height": {"type": "integer", "minimum": 1},
+			"quality": {"type": "integer", "minimum": 1, "maximum": 100},
+			"format": {"enum": ["jpeg", "png", "gif", "webp"]}
+		},
+		"anyOf": [{"required": ["width"]}, {"required": ["height"]}],
+		"additionalProperties": false
+	}`,
+	"/image/crop": `{
+		"type": "object",
+		"properties": {
+			"aspect": {"type": "string", "pattern": "^[1-9][0-9]*:[1-9][0-9]*$"},
+			"square": {"type": "boolean"},
+			"strategy": {"enum": ["center", "entropy"]},
+			"width": {"type": "integer", "minimum": 1},
+			"quality": {"type": "integer", "minimum": 1, "maximum": 100},
+			"format": {"enum": ["jpeg", "png", "gif", "webp"]}
+		},
+		"anyOf": [{"required": ["aspect"]}, {"required": ["square"], "properties": {"square": {"const": true}}}],
+		"additionalProperties": false
+	}`,
+	"/image/exif": emptyOptions,
+	"/json":       emptyOptions,
+	"/schema":     emptyOptions,
+}
+
+// ValidateOptions validates typed options against the mill's option schema
+func ValidateOptions(id string, opts map[string]interface{}) error {
+	sch, ok := optionSchemas[id]
+	if !ok {
+		return schema.ErrSchemaInvalidMill
+	}
+	if opts == nil {
+		opts = make(map[string]interface{})
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewStringLoader(sch), gojsonschema.NewGoLoader(opts))
+	if err != nil {
+		return err
+	}
+	if !result.Valid() {
+		var errs []string
+		for _, err := range result.Errors() {
+			errs = append(errs, err.String())
+		}
+		return fmt.Errorf("invalid %s options: %s", id, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// NewTyped returns the mill with the given id configured by typed options
+func NewTyped(id string, opts map[string]interface{}) (Mill, error) {
+	if err := ValidateOptions(id, opts); err != nil {
+		return nil, err
+	}
+
+	sopts := make(map[string]string)
+	for k, v := range opts {
+		sopts[k] = formatOption(v)
+	}
+	return New(id, sopts)
+}
+
+// formatOption converts a typed option to the string form used by mill opts
+func formatOption(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,288 @@
+package mill
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/schema"
+)
+
+// PipelineID is the mill id of files created by a pipeline
+const PipelineID = "/pipeline"
+
+// ErrEmptyPipeline indicates a pipeline has no steps
+var ErrEmptyPipeline = fmt.Errorf("pipeline does not have any steps")
+
+// PipelineStep is a mill which only runs if its condition holds
+type PipelineStep struct {
+	Mill Mill
+	When *pb.MillCondition
+}
+
+// Pipeline runs an ordered list of mills, each milling the output of the
+// last step that ran. Steps whose condition does not hold are skipped.
+type Pipeline struct {
+	Steps []PipelineStep
+	Links map[string]map[string]interface{} // result meta of earlier schema links
+}
+
+// NewPipeline returns a pipeline for schema steps, links holds the result meta
+// of earlier schema links, which may be tested by conditions
+func NewPipeline(steps []*pb.MillStep, links map[string]map[string]interface{}) (*Pipeline, error) {
+	if len(steps) == 0 {
+		return nil, ErrEmptyPipeline
+	}
+
+	p := &Pipeline{Links: links}
+	for _, s := range steps {
+		mil, err := NewTyped(s.Mill, pb.ToMap(s.Opts))
+		if err != nil {
+			return nil, err
+		}
+		if mil == nil {
+			return nil, schema.ErrSchemaInvalidMill
+		}
+		p.Steps = append(p.Steps, PipelineStep{Mill: mil, When: s.When})
+	}
+	return p, nil
+}
+
+// ForNode returns the mill a single file schema node runs, nil if it has none
+func ForNode(node *pb.Node) (Mill, error) {
+	if len(node.Pipeline) > 0 {
+		p, err := NewPipeline(node.Pipeline, nil)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	return New(node.Mill, node.Opts)
+}
+
+// ForLink returns the mill a schema link runs, nil if it has none.
+// Files are those already added for earlier links.
+func ForLink(link *pb.Link, files map[string]*pb.FileIndex) (Mill, error) {
+	if len(link.Pipeline) > 0 {
+		links := make(map[string]map[string]interface{})
+		for name, file := range files {
+			if file != nil && file.Meta != nil {
+				links[name] = pb.ToMap(file.Meta)
+			}
+		}
+		p, err := NewPipeline(link.Pipeline, links)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	return New(link.Mill, link.Opts)
+}
+
+func (m *Pipeline) ID() string {
+	return PipelineID
+}
+
+func (m *Pipeline) Encrypt() bool {
+	for _, s := range m.Steps {
+		if !s.Mill.Encrypt() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Pipeline) Pin() bool {
+	for _, s := range m.Steps {
+		if s.Mill.Pin() {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Pipeline) AcceptMedia(media string) error {
+	if len(m.Steps) == 0 {
+		return ErrEmptyPipeline
+	}
+	return m.Steps[0].Mill.AcceptMedia(media)
+}
+
+// Options hashes each step's options and condition, along with the earlier
+// link values tested by conditions, since they determine which steps run
+func (m *Pipeline) Options(add map[string]interface{}) (string, error) {
+	type stepOpts struct {
+		Mill string            `json:"mill"`
+		Opts string            `json:"opts"`
+		When *pb.MillCondition `json:"when,omitempty"`
+		Link interface{}       `json:"link,omitempty"`
+	}
+	var steps []stepOpts
+	for _, s := range m.Steps {
+		opts, err := s.Mill.Options(nil)
+		if err != nil {
+			return "", err
+		}
+		so := stepOpts{Mill: s.Mill.ID(), Opts: opts, When: s.When}
+		if s.When != nil && s.When.Link != "" {
+			so.Link, _ = lookupMeta(m.Links[s.When.Link], s.When.Key)
+		}
+		steps = append(steps, so)
+	}
+	return hashOpts(struct {
+		Steps []stepOpts `json:"steps"`
+	}{Steps: steps}, add)
+}
+
+// Mill runs each step whose condition holds, merging result meta.
+// The ids of the mills that ran are listed under the "pipeline" meta key.
+func (m *Pipeline) Mill(input []byte, name string) (*Result, error) {
+	file := input
+	meta := make(map[string]interface{})
+	var prev map[string]interface{}
+	ran := make([]interface{}, 0)
+
+	for _, s := range m.Steps {
+		if s.When != nil {
+			ok, err := m.holds(s.When, prev)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		res, err := s.Mill.Mill(file, name)
+		if err != nil {
+			return nil, err
+		}
+		file = res.File
+		for k, v := range res.Meta {
+			meta[k] = v
+		}
+		prev = res.Meta
+		if prev == nil {
+			prev = make(map[string]interface{})
+		}
+		ran = append(ran, s.Mill.ID())
+	}
+	meta["pipeline"] = ran
+
+	return &Result{File: file, Meta: meta}, nil
+}
+
+// holds evaluates a condition against an earlier link's meta or the meta of the last step that ran
+func (m *Pipeline) holds(c *pb.MillCondition, prev map[string]interface{}) (bool, error) {
+	meta := prev
+	if c.Link != "" {
+		meta = m.Links[c.Link]
+	}
+	if c.Key == "" {
+		return false, schema.ErrInvalidCondition
+	}
+
+	val, ok := lookupMeta(meta, c.Key)
+	if c.Op == pb.MillCondition_EXISTS {
+		return ok, nil
+	}
+	if !ok {
+		return false, nil
+	}
+	if c.Value == nil {
+		return false, schema.ErrInvalidCondition
+	}
+	want := pb.ToInterface(c.Value)
+
+	switch c.Op {
+	case pb.MillCondition_EQ:
+		return equalMeta(val, want), nil
+	case pb.MillCondition_NE:
+		return !equalMeta(val, want), nil
+	}
+
+	cmp, ok := compareMeta(val, want)
+	if !ok {
+		return false, nil
+	}
+	switch c.Op {
+	case pb.MillCondition_GT:
+		return cmp > 0, nil
+	case pb.MillCondition_GTE:
+		return cmp >= 0, nil
+	case pb.MillCondition_LT:
+		return cmp < 0, nil
+	case pb.MillCondition_LTE:
+		return cmp <= 0, nil
+	default:
+		return false, schema.ErrInvalidCondition
+	}
+}
+
+// lookupMeta returns the value at a dot separated key
+func lookupMeta(meta map[string]interface{}, key string) (interface{}, bool) {
+	var val interface{} = meta
+	for _, part := range strings.Split(key, ".") {
+		m, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		val, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return val, true
+}
+
+// equalMeta compares meta values, treating all numbers alike
+func equalMeta(a interface{}, b interface{}) bool {
+	if an, ok := toNumber(a); ok {
+		bn, ok := toNumber(b)
+		return ok && an == bn
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compareMeta orders two numbers or two strings
+func compareMeta(a interface{}, b interface{}) (int, bool) {
+	if an, ok := toNumber(a); ok {
+		bn, ok := toNumber(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case an < bn:
+			return -1, true
+		case an > bn:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	as, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	bs, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(as, bs), true
+}
+
+// toNumber converts numeric meta values to float64
+func toNumber(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,147 @@
+package mill
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/schema"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+var pipelineSchema = `
+{
+  "links": {
+    "exif": {
+      "use": ":file",
+      "mill": "/image/exif"
+    },
+    "thumb": {
+      "use": ":file",
+      "pipeline": [
+        {
+          "mill": "/image/resize",
+          "opts": {"width": 500},
+          "when": {"link": "exif", "key": "orientation", "op": "EQ", "value": "landscape"}
+        },
+        {
+          "mill": "/image/resize",
+          "opts": {"width": 100, "quality": 50},
+          "when": {"key": "width", "op": "GT", "value": 200}
+        }
+      ]
+    }
+  }
+}
+`
+
+func TestPipeline_Mill(t *testing.T) {
+	var node pb.Node
+	if err := jsonpb.UnmarshalString(pipelineSchema, &node); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&Schema{}).Mill([]byte(pipelineSchema), "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	steps, err := schema.Steps(node.Links)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if steps[0].Name != "exif" {
+		t.Fatal("conditions should order links")
+	}
+
+	input, err := ioutil.ReadFile("testdata/image.jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]*pb.FileIndex)
+	for _, step := range steps {
+		mil, err := ForLink(step.Link, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := mil.Mill(input, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[step.Name] = &pb.FileIndex{Meta: pb.ToStruct(res.Meta)}
+	}
+
+	meta := pb.ToMap(files["thumb"].Meta)
+	if meta["width"] != float64(100) {
+		t.Fatalf("expected both resizes to run, got width %v", meta["width"])
+	}
+	if len(meta["pipeline"].([]interface{})) != 2 {
+		t.Fatal("expected two steps to run")
+	}
+
+	// a portrait condition skips the first step, leaving nothing for the second to test
+	thumb := node.Links["thumb"]
+	thumb.Pipeline[0].When.Value = pb.ToValue("portrait")
+	mil, err := ForLink(thumb, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := mil.Mill(input, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Meta["pipeline"].([]interface{})) != 0 {
+		t.Fatal("expected both steps to be skipped")
+	}
+	if len(res.File) != len(input) {
+		t.Fatal("expected input to pass through")
+	}
+}
+
+func TestPipeline_Validation(t *testing.T) {
+	m := &Schema{}
+
+	invalid := []string{
+		// untyped width
+		`{"pipeline": [{"mill": "/image/resize", "opts": {"width": "big"}}]}`,
+		// unknown option
+		`{"pipeline": [{"mill": "/blob", "opts": {"width": 100}}]}`,
+		// first step cannot test a previous step
+		`{"pipeline": [{"mill": "/blob", "when": {"key": "width", "op": "GT", "value": 1}}]}`,
+		// unknown link
+		`{"links": {"a": {"use": ":file", "pipeline": [{"mill": "/blob", "when": {"link": "b", "key": "x", "op": "EXISTS"}}]}}}`,
+		// both mill and pipeline
+		`{"mill": "/blob", "pipeline": [{"mill": "/blob"}]}`,
+	}
+	for _, s := range invalid {
+		if _, err := m.Mill([]byte(s), "test"); err == nil {
+			t.Fatalf("expected schema to be invalid: %s", s)
+		}
+	}
+}
+
+func TestNewTyped(t *testing.T) {
+	mil, err := NewTyped("/image/resize", map[string]interface{}{"width": float64(320)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mil.(*ImageResize).Opts.Width != "320" {
+		t.Fatal("typed width was not converted")
+	}
+	if _, err := NewTyped("/image/resize", nil); err == nil {
+		t.Fatal("expected missing width to fail")
+	}
+
+	mil, err = NewTyped("/image/crop", map[string]interface{}{"square": true, "format": "webp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crop := mil.(*ImageCrop); crop.Opts.Square != "true" || crop.Opts.Format != "webp" {
+		t.Fatal("typed crop opts were not converted")
+	}
+	if _, err := NewTyped("/image/crop", map[string]interface{}{"square": false}); err == nil {
+		t.Fatal("expected missing aspect to fail")
+	}
+	if _, err := NewTyped("/image/resize", map[string]interface{}{"width": float64(320), "format": "bmp"}); err == nil {
+		t.Fatal("expected unknown format to fail")
+	}
+}
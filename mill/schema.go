@@ -42,12 +42,22 @@ func (m *Schema) Mill(input []byte, name string) (*Result, error) {
 		return nil, err
 	}
 
-	if node.Mill == "" {
+	if node.Mill == "" && len(node.Pipeline) == 0 {
 		if len(node.Links) == 0 {
 			return nil, schema.ErrEmptySchema
 		}
 
-		for _, link := range node.Links {
+		for name, link := range node.Links {
+			if len(link.Pipeline) > 0 {
+				if link.Mill != "" {
+					return nil, schema.ErrMillAndPipeline
+				}
+				if err := validatePipeline(link.Pipeline, node.Links, name); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
 			if !schema.ValidateMill(link.Mill) {
 				return nil, schema.ErrSchemaInvalidMill
 			}
@@ -68,6 +78,14 @@ func (m *Schema) Mill(input []byte, name string) (*Result, error) {
 			return nil, err
 		}
 
+	} else if len(node.Pipeline) > 0 {
+		if node.Mill != "" {
+			return nil, schema.ErrMillAndPipeline
+		}
+		if err := validatePipeline(node.Pipeline, nil, ""); err != nil {
+			return nil, err
+		}
+
 	} else {
 		if !schema.ValidateMill(node.Mill) {
 			return nil, schema.ErrSchemaInvalidMill
@@ -92,6 +110,45 @@ func (m *Schema) Mill(input []byte, name string) (*Result, error) {
 	return &Result{File: []byte(data)}, nil
 }
 
+// validatePipeline ensures each step runs a known file mill w/ valid options,
+// and that conditions only test the previous step or another link
+func validatePipeline(steps []*pb.MillStep, links map[string]*pb.Link, self string) error {
+	for i, step := range steps {
+		switch step.Mill {
+		case "/json", "/schema":
+			return schema.ErrSchemaInvalidMill
+		}
+		if !schema.ValidateMill(step.Mill) {
+			return schema.ErrSchemaInvalidMill
+		}
+		if err := ValidateOptions(step.Mill, pb.ToMap(step.Opts)); err != nil {
+			return err
+		}
+
+		c := step.When
+		if c == nil {
+			continue
+		}
+		if c.Key == "" {
+			return schema.ErrInvalidCondition
+		}
+		if c.Op != pb.MillCondition_EXISTS && c.Value == nil {
+			return schema.ErrInvalidCondition
+		}
+		if _, ok := pb.MillCondition_Op_name[int32(c.Op)]; !ok {
+			return schema.ErrInvalidCondition
+		}
+		if c.Link == "" {
+			if i == 0 {
+				return schema.ErrInvalidCondition
+			}
+		} else if c.Link == self || links[c.Link] == nil {
+			return schema.ErrInvalidCondition
+		}
+	}
+	return nil
+}
+
 func validateJsonSchema(jschema map[string]interface{}) error {
 	data, err := json.Marshal(&jschema)
 	if err != nil {
@@ -89,6 +89,20 @@ func (m *Mobile) AccountContact() ([]byte, error) {
 	return proto.Marshal(contact)
 }
 
+// ContactCard calls core ContactCard
+func (m *Mobile) ContactCard() ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	card, err := m.node.ContactCard()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(card)
+}
+
 // SyncAccount calls core SyncAccount
 func (m *Mobile) SyncAccount(options []byte) (*SearchHandle, error) {
 	if !m.node.Online() {
@@ -0,0 +1,49 @@
+package mobile
+
+import (
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// BlockAccount calls core BlockAccount
+func (m *Mobile) BlockAccount(address string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.BlockAccount(address)
+}
+
+// UnblockAccount calls core UnblockAccount
+func (m *Mobile) UnblockAccount(address string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.UnblockAccount(address)
+}
+
+// BlockedAccounts calls core BlockedAccounts
+func (m *Mobile) BlockedAccounts() ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	return proto.Marshal(m.node.BlockedAccounts())
+}
+
+// PublishBlocklist calls core PublishBlocklist
+func (m *Mobile) PublishBlocklist() (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	hash, err := m.node.PublishBlocklist()
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
@@ -52,7 +52,7 @@ func TestMobile_SetupCafes(t *testing.T) {
 }
 
 func TestMobile_RegisterCafe(t *testing.T) {
-	token, err := cafesTestVars.cafe.CreateCafeToken("", true)
+	token, err := cafesTestVars.cafe.CreateCafeToken("", true, core.CafeTokenOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -102,3 +102,30 @@ func (m *Mobile) SearchContacts(query []byte, options []byte) (*SearchHandle, er
 
 	return m.handleSearchStream(resCh, errCh, cancel)
 }
+
+// ContactSafetyNumber calls core ContactSafetyNumber
+func (m *Mobile) ContactSafetyNumber(address string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	return m.node.ContactSafetyNumber(address)
+}
+
+// VerifyContact calls core VerifyContact
+func (m *Mobile) VerifyContact(address string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.VerifyContact(address)
+}
+
+// UnverifyContact calls core UnverifyContact
+func (m *Mobile) UnverifyContact(address string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.UnverifyContact(address)
+}
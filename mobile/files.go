@@ -332,7 +332,7 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 		Files: make(map[string]*pb.FileIndex),
 	}
 
-	mil, err := getMill(thrd.Schema.Mill, thrd.Schema.Opts)
+	mil, err := mill.ForNode(thrd.Schema)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +362,7 @@ func (m *Mobile) buildDirectory(data []byte, path string, threadId string) (*pb.
 
 		// send each link
 		for _, step := range steps {
-			mil, err := getMill(step.Link.Mill, step.Link.Opts)
+			mil, err := mill.ForLink(step.Link, dir.Files)
 			if err != nil {
 				return nil, err
 			}
@@ -467,34 +467,6 @@ func (m *Mobile) getFileConfig(mil mill.Mill, opts ...fileConfigOption) (*core.A
 	return conf, nil
 }
 
-func getMill(id string, opts map[string]string) (mill.Mill, error) {
-	switch id {
-	case "/blob":
-		return &mill.Blob{}, nil
-	case "/image/resize":
-		width := opts["width"]
-		if width == "" {
-			return nil, fmt.Errorf("missing width")
-		}
-		quality := opts["quality"]
-		if quality == "" {
-			quality = "75"
-		}
-		return &mill.ImageResize{
-			Opts: mill.ImageResizeOpts{
-				Width:   width,
-				Quality: quality,
-			},
-		}, nil
-	case "/image/exif":
-		return &mill.ImageExif{}, nil
-	case "/json":
-		return &mill.Json{}, nil
-	default:
-		return nil, nil
-	}
-}
-
 func (m *Mobile) writeFiles(dirs *pb.DirectoryList, threadId string, caption string) (mh.Multihash, error) {
 	if !m.node.Started() {
 		return nil, core.ErrStopped
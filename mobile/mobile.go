@@ -71,6 +71,8 @@ type InitConfig struct {
 	BaseRepoPath string
 	LogToDisk    bool
 	Debug        bool
+	LAN          bool   // apply the LAN-only profile for direct messaging w/o internet
+	MetricsAddr  string // serve prometheus metrics on this address, disabled if empty
 }
 
 // MigrateConfig is used to define options during a major migration
@@ -91,6 +93,7 @@ type Mobile struct {
 	node      *core.Textile
 	messenger Messenger
 	listener  *broadcast.Listener
+	presence  *broadcast.Listener
 }
 
 // Repo returns the actual location of the configured repo
@@ -148,6 +151,8 @@ func (conf InitConfig) coreInitConfig() (core.InitConfig, error) {
 		RepoPath:     conf.RepoPath,
 		BaseRepoPath: conf.BaseRepoPath,
 		IsMobile:     true,
+		IsLAN:        conf.LAN,
+		MetricsAddr:  conf.MetricsAddr,
 		LogToDisk:    conf.LogToDisk,
 		Debug:        conf.Debug,
 	}, nil
@@ -200,6 +205,7 @@ func NewTextile(config *RunConfig, messenger Messenger) (*Mobile, error) {
 
 	mobile.node = node
 	mobile.listener = node.ThreadUpdateListener()
+	mobile.presence = node.ThreadPresenceListener()
 
 	return mobile, nil
 }
@@ -244,6 +250,24 @@ func (m *Mobile) Start() error {
 			}
 		}()
 
+		// subscribe to thread read markers and typing indicators
+		go func() {
+			for {
+				select {
+				case value, ok := <-m.presence.Ch:
+					if !ok {
+						return
+					}
+					switch v := value.(type) {
+					case *pb.ThreadRead:
+						m.notify(pb.MobileEventType_THREAD_READ, v)
+					case *pb.ThreadTyping:
+						m.notify(pb.MobileEventType_THREAD_TYPING, v)
+					}
+				}
+			}
+		}()
+
 		// subscribe to notifications
 		go func() {
 			for {
@@ -284,6 +308,11 @@ func (m *Mobile) stop() error {
 	return nil
 }
 
+// ChangePin calls core ChangePin, the node must be stopped
+func (m *Mobile) ChangePin(oldPin string, newPin string) error {
+	return m.node.ChangePin(oldPin, newPin)
+}
+
 // Online returns core Online
 func (m *Mobile) Online() bool {
 	return m.node.Online()
@@ -0,0 +1,45 @@
+package mobile
+
+import (
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// MarkThreadRead calls core MarkThreadRead
+func (m *Mobile) MarkThreadRead(threadId string, upToBlock string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	err := m.node.MarkThreadRead(threadId, upToBlock)
+	if err != nil {
+		return err
+	}
+
+	m.node.FlushCafes()
+
+	return nil
+}
+
+// SendThreadTyping calls core SendThreadTyping
+func (m *Mobile) SendThreadTyping(threadId string, typing bool) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.SendThreadTyping(threadId, typing)
+}
+
+// ThreadReads calls core ThreadReads
+func (m *Mobile) ThreadReads(threadId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	reads, err := m.node.ThreadReads(threadId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(reads)
+}
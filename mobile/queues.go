@@ -0,0 +1,78 @@
+package mobile
+
+import (
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// QueueItems calls core QueueItems, an empty queue lists all queues
+func (m *Mobile) QueueItems(queue string, failedOnly bool) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	queues, err := parseQueues(queue)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(m.node.QueueItems(failedOnly, queues...))
+}
+
+// RetryQueueItem calls core RetryQueueItem
+func (m *Mobile) RetryQueueItem(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.RetryQueueItem(id)
+}
+
+// RetryQueues calls core RetryQueues, an empty queue retries all queues
+func (m *Mobile) RetryQueues(queue string) (int, error) {
+	if !m.node.Started() {
+		return 0, core.ErrStopped
+	}
+
+	queues, err := parseQueues(queue)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.node.RetryQueues(queues...)
+}
+
+// RemoveQueueItem calls core RemoveQueueItem
+func (m *Mobile) RemoveQueueItem(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.RemoveQueueItem(id)
+}
+
+// PurgeQueues calls core PurgeQueues, an empty queue purges all queues
+func (m *Mobile) PurgeQueues(queue string, failedOnly bool) (int, error) {
+	if !m.node.Started() {
+		return 0, core.ErrStopped
+	}
+
+	queues, err := parseQueues(queue)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.node.PurgeQueues(failedOnly, queues...)
+}
+
+func parseQueues(queue string) ([]pb.QueueItem_Queue, error) {
+	if queue == "" {
+		return nil, nil
+	}
+	q, err := core.ParseQueue(queue)
+	if err != nil {
+		return nil, err
+	}
+	return []pb.QueueItem_Queue{q}, nil
+}
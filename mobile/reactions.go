@@ -0,0 +1,76 @@
+package mobile
+
+import (
+	"github.com/b582q9/go-textile-sapien/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// AddReaction adds an emoji reaction targeted at the given block
+func (m *Mobile) AddReaction(blockId string, emoji string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd, err := m.blockThread(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := thrd.AddReaction(blockId, emoji)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// RemoveReaction removes own reactions w/ the given emoji from a block
+func (m *Mobile) RemoveReaction(blockId string, emoji string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd, err := m.blockThread(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := thrd.AddUnreact(blockId, emoji)
+	if err != nil {
+		return "", err
+	}
+
+	m.node.FlushCafes()
+
+	return hash.B58String(), nil
+}
+
+// Reactions calls core Reactions
+func (m *Mobile) Reactions(blockId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	reactions, err := m.node.Reactions(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(reactions)
+}
+
+// blockThread returns the thread of a block
+func (m *Mobile) blockThread(blockId string) (*core.Thread, error) {
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return nil, core.ErrThreadNotFound
+	}
+	return thrd, nil
+}
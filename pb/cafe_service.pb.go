@@ -20,6 +20,58 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type CafeReplicate_Type int32
+
+const (
+	CafeReplicate_CLIENT          CafeReplicate_Type = 0
+	CafeReplicate_DELETE_CLIENT   CafeReplicate_Type = 1
+	CafeReplicate_OBJECT          CafeReplicate_Type = 2
+	CafeReplicate_UNSTORE         CafeReplicate_Type = 3
+	CafeReplicate_THREAD          CafeReplicate_Type = 4
+	CafeReplicate_DELETE_THREAD   CafeReplicate_Type = 5
+	CafeReplicate_MESSAGE         CafeReplicate_Type = 6
+	CafeReplicate_DELETE_MESSAGES CafeReplicate_Type = 7
+	CafeReplicate_EXPIRE_CLIENT   CafeReplicate_Type = 8
+	CafeReplicate_BAN             CafeReplicate_Type = 9
+	CafeReplicate_UNBAN           CafeReplicate_Type = 10
+)
+
+var CafeReplicate_Type_name = map[int32]string{
+	0:  "CLIENT",
+	1:  "DELETE_CLIENT",
+	2:  "OBJECT",
+	3:  "UNSTORE",
+	4:  "THREAD",
+	5:  "DELETE_THREAD",
+	6:  "MESSAGE",
+	7:  "DELETE_MESSAGES",
+	8:  "EXPIRE_CLIENT",
+	9:  "BAN",
+	10: "UNBAN",
+}
+
+var CafeReplicate_Type_value = map[string]int32{
+	"CLIENT":          0,
+	"DELETE_CLIENT":   1,
+	"OBJECT":          2,
+	"UNSTORE":         3,
+	"THREAD":          4,
+	"DELETE_THREAD":   5,
+	"MESSAGE":         6,
+	"DELETE_MESSAGES": 7,
+	"EXPIRE_CLIENT":   8,
+	"BAN":             9,
+	"UNBAN":           10,
+}
+
+func (x CafeReplicate_Type) String() string {
+	return proto.EnumName(CafeReplicate_Type_name, int32(x))
+}
+
+func (CafeReplicate_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{23, 0}
+}
+
 type CafeChallenge struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1045,7 +1097,150 @@ func (m *CafeDeleteMessagesAck) GetMore() bool {
 	return false
 }
 
+type CafeReplicate struct {
+	Type                 CafeReplicate_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeReplicate_Type" json:"type,omitempty"`
+	Client               *CafeClient        `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Object               *CafeObject        `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Cids                 []string           `protobuf:"bytes,4,rep,name=cids,proto3" json:"cids,omitempty"`
+	Thread               *CafeClientThread  `protobuf:"bytes,5,opt,name=thread,proto3" json:"thread,omitempty"`
+	Message              *CafeClientMessage `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Env                  []byte             `protobuf:"bytes,7,opt,name=env,proto3" json:"env,omitempty"`
+	Messages             []string           `protobuf:"bytes,8,rep,name=messages,proto3" json:"messages,omitempty"`
+	Ban                  *CafeBan           `protobuf:"bytes,9,opt,name=ban,proto3" json:"ban,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeReplicate) Reset()         { *m = CafeReplicate{} }
+func (m *CafeReplicate) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicate) ProtoMessage()    {}
+func (*CafeReplicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{23}
+}
+
+func (m *CafeReplicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicate.Unmarshal(m, b)
+}
+func (m *CafeReplicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicate.Marshal(b, m, deterministic)
+}
+func (m *CafeReplicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicate.Merge(m, src)
+}
+func (m *CafeReplicate) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicate.Size(m)
+}
+func (m *CafeReplicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicate proto.InternalMessageInfo
+
+func (m *CafeReplicate) GetType() CafeReplicate_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplicate_CLIENT
+}
+
+func (m *CafeReplicate) GetClient() *CafeClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetObject() *CafeObject {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetCids() []string {
+	if m != nil {
+		return m.Cids
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetThread() *CafeClientThread {
+	if m != nil {
+		return m.Thread
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetMessage() *CafeClientMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetEnv() []byte {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetMessages() []string {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetBan() *CafeBan {
+	if m != nil {
+		return m.Ban
+	}
+	return nil
+}
+
+type CafeReplicateAck struct {
+	Type                 CafeReplicate_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeReplicate_Type" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeReplicateAck) Reset()         { *m = CafeReplicateAck{} }
+func (m *CafeReplicateAck) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicateAck) ProtoMessage()    {}
+func (*CafeReplicateAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{24}
+}
+
+func (m *CafeReplicateAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicateAck.Unmarshal(m, b)
+}
+func (m *CafeReplicateAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicateAck.Marshal(b, m, deterministic)
+}
+func (m *CafeReplicateAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicateAck.Merge(m, src)
+}
+func (m *CafeReplicateAck) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicateAck.Size(m)
+}
+func (m *CafeReplicateAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicateAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicateAck proto.InternalMessageInfo
+
+func (m *CafeReplicateAck) GetType() CafeReplicate_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplicate_CLIENT
+}
+
 func init() {
+	proto.RegisterEnum("CafeReplicate_Type", CafeReplicate_Type_name, CafeReplicate_Type_value)
 	proto.RegisterType((*CafeChallenge)(nil), "CafeChallenge")
 	proto.RegisterType((*CafeNonce)(nil), "CafeNonce")
 	proto.RegisterType((*CafeRegistration)(nil), "CafeRegistration")
@@ -1069,44 +1264,62 @@ func init() {
 	proto.RegisterType((*CafeMessages)(nil), "CafeMessages")
 	proto.RegisterType((*CafeDeleteMessages)(nil), "CafeDeleteMessages")
 	proto.RegisterType((*CafeDeleteMessagesAck)(nil), "CafeDeleteMessagesAck")
+	proto.RegisterType((*CafeReplicate)(nil), "CafeReplicate")
+	proto.RegisterType((*CafeReplicateAck)(nil), "CafeReplicateAck")
 }
 
 func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_af259e22dc6e576e) }
 
 var fileDescriptor_af259e22dc6e576e = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x51, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0x95, 0x74, 0x1d, 0xeb, 0xb5, 0x1b, 0x25, 0x0c, 0x14, 0x78, 0x98, 0x8a, 0x35, 0x41,
-	0x07, 0x52, 0x1f, 0x86, 0x10, 0xf0, 0xc8, 0x8a, 0x78, 0x82, 0x31, 0x65, 0x20, 0x24, 0x84, 0x84,
-	0x5c, 0xe7, 0xda, 0x98, 0xa6, 0x49, 0x65, 0x7b, 0x15, 0x8f, 0x7c, 0x74, 0x74, 0xb6, 0xd3, 0x46,
-	0x34, 0x15, 0xda, 0xdb, 0xdd, 0xe5, 0xe7, 0xff, 0x9d, 0xef, 0xce, 0x81, 0x48, 0xf0, 0x29, 0xfe,
-	0xd4, 0xa8, 0x56, 0x52, 0xe0, 0x68, 0xa9, 0x4a, 0x53, 0x3e, 0xee, 0x2e, 0xca, 0x14, 0x73, 0xe7,
-	0xb0, 0x33, 0x38, 0x1c, 0xf3, 0x29, 0x8e, 0x33, 0x9e, 0xe7, 0x58, 0xcc, 0x30, 0x8a, 0xe1, 0x0e,
-	0x4f, 0x53, 0x85, 0x5a, 0xc7, 0xc1, 0x20, 0x18, 0x76, 0x92, 0xca, 0x65, 0x4f, 0xa0, 0x43, 0xe8,
-	0x65, 0x59, 0x08, 0x8c, 0x8e, 0xa1, 0xbd, 0xe2, 0xf9, 0x0d, 0x7a, 0xc8, 0x39, 0xec, 0x4f, 0x00,
-	0x7d, 0x62, 0x12, 0x9c, 0x49, 0x6d, 0x14, 0x37, 0xb2, 0x2c, 0x76, 0x2b, 0x6e, 0x44, 0xc2, 0x9a,
-	0x08, 0x45, 0x0b, 0xca, 0x11, 0xb7, 0x5c, 0xd4, 0x3a, 0x51, 0x1f, 0x5a, 0x5a, 0xce, 0xe2, 0xbd,
-	0x41, 0x30, 0xec, 0x25, 0x64, 0x12, 0x67, 0xca, 0x39, 0x16, 0x71, 0xdb, 0x71, 0xd6, 0x61, 0xcf,
-	0x21, 0xa2, 0x0a, 0xde, 0xa3, 0xaa, 0xd7, 0xb0, 0x66, 0x83, 0x3a, 0xfb, 0x0c, 0x1e, 0x6c, 0xb3,
-	0xef, 0xc4, 0x3c, 0x3a, 0x82, 0x50, 0xa6, 0x9e, 0x0d, 0x65, 0xca, 0x3e, 0x38, 0xd1, 0x04, 0xa7,
-	0x0a, 0x75, 0x76, 0x8d, 0x5a, 0x93, 0xe8, 0x43, 0xd8, 0xe7, 0x42, 0x6c, 0xee, 0xe5, 0x3d, 0xba,
-	0xb0, 0x72, 0xa4, 0xbf, 0x58, 0xe5, 0xb2, 0x0b, 0xb8, 0x4b, 0x3a, 0x57, 0x37, 0x93, 0x5c, 0xea,
-	0xec, 0x0a, 0x51, 0x35, 0x57, 0x16, 0x3d, 0x82, 0xbd, 0x25, 0xa2, 0xb2, 0xe7, 0xbb, 0xe7, 0xed,
-	0x11, 0xa1, 0x89, 0x0d, 0xb1, 0x53, 0x57, 0x4b, 0x4d, 0xa3, 0xa9, 0xe2, 0x57, 0x6e, 0x58, 0xd7,
-	0xa6, 0x54, 0xb8, 0x23, 0x47, 0x04, 0x7b, 0x42, 0xa6, 0x3a, 0x0e, 0x07, 0xad, 0x61, 0x27, 0xb1,
-	0x36, 0x3b, 0x81, 0xde, 0xfa, 0x58, 0x93, 0xec, 0x6b, 0xe8, 0xd2, 0xf7, 0xaf, 0x85, 0xbe, 0xa5,
-	0xf0, 0x29, 0x1c, 0xd5, 0x0e, 0x92, 0x74, 0x45, 0x05, 0xdb, 0xd4, 0xe7, 0xc9, 0x2f, 0x14, 0xe6,
-	0xa3, 0xd4, 0xa6, 0x91, 0xfa, 0x01, 0xb0, 0xa1, 0x76, 0xd4, 0xd0, 0x87, 0x96, 0x90, 0xa9, 0xef,
-	0x3f, 0x99, 0xa4, 0x94, 0x72, 0xc3, 0xed, 0x56, 0xf5, 0x12, 0x6b, 0x53, 0xac, 0x28, 0x53, 0xf4,
-	0x5b, 0x65, 0x6d, 0xf6, 0xcd, 0xcd, 0xc8, 0xb6, 0xe0, 0x4b, 0xa6, 0x90, 0xa7, 0x3b, 0x52, 0xb8,
-	0xde, 0x84, 0x55, 0x6f, 0xa2, 0x13, 0x00, 0x21, 0x97, 0x19, 0x2a, 0x83, 0xbf, 0x8d, 0x4f, 0x53,
-	0x8b, 0x54, 0x83, 0xab, 0x09, 0x37, 0x75, 0xf8, 0x2d, 0xdc, 0xab, 0x35, 0xea, 0x36, 0x05, 0xb0,
-	0xa7, 0x70, 0xbc, 0x75, 0xb4, 0x29, 0xc5, 0x65, 0xf5, 0x44, 0x72, 0xb9, 0x42, 0xf5, 0x09, 0xb5,
-	0xe6, 0x33, 0xfc, 0x97, 0xa2, 0xed, 0x16, 0xb9, 0xc4, 0xc2, 0xf8, 0x0c, 0xde, 0xa3, 0xce, 0x62,
-	0xb1, 0xf2, 0xf7, 0x23, 0x93, 0x9d, 0xb9, 0x92, 0xc7, 0x19, 0x8a, 0xb9, 0x57, 0xd3, 0x3b, 0x5e,
-	0xdc, 0x1b, 0xb7, 0x5f, 0x6b, 0x6a, 0x08, 0x07, 0x0b, 0x6f, 0xdb, 0x11, 0x77, 0xcf, 0x7b, 0xa3,
-	0x1a, 0x90, 0xac, 0xbf, 0x6e, 0xde, 0x75, 0x8e, 0x06, 0xff, 0x93, 0xe5, 0x45, 0xf5, 0xae, 0xeb,
-	0xac, 0xdf, 0xb9, 0x45, 0xa9, 0xdc, 0x4f, 0xeb, 0x20, 0xb1, 0xf6, 0xc5, 0x7d, 0x38, 0x94, 0xe5,
-	0x88, 0x26, 0x24, 0x73, 0x1c, 0x2d, 0x27, 0xdf, 0xc3, 0xe5, 0x64, 0xb2, 0x6f, 0xff, 0x8e, 0x2f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xc5, 0x28, 0x58, 0x40, 0x05, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdf, 0x4f, 0xdb, 0x48,
+	0x10, 0xc7, 0xcf, 0x71, 0x7e, 0x8e, 0x03, 0x98, 0x05, 0x4e, 0x3e, 0x1e, 0x10, 0x67, 0xd0, 0x11,
+	0xee, 0x4e, 0x79, 0xc8, 0xe9, 0x74, 0x57, 0xf5, 0x29, 0x09, 0x6e, 0x4b, 0x05, 0x01, 0x6d, 0x82,
+	0x5a, 0x55, 0x95, 0x90, 0x63, 0x0f, 0xc9, 0x16, 0x63, 0x5b, 0xb6, 0x89, 0xca, 0x5b, 0xff, 0x9a,
+	0xfe, 0x61, 0xfd, 0x4b, 0xaa, 0x5d, 0xaf, 0x1d, 0x43, 0x12, 0xb5, 0xbc, 0xcd, 0xcc, 0x7e, 0xf6,
+	0x3b, 0xe3, 0x99, 0xd9, 0x04, 0x88, 0x63, 0xdf, 0xe0, 0x75, 0x8c, 0xd1, 0x8c, 0x39, 0xd8, 0x0e,
+	0xa3, 0x20, 0x09, 0x76, 0xb5, 0xbb, 0xc0, 0x45, 0x2f, 0x75, 0xcc, 0x63, 0x58, 0xeb, 0xdb, 0x37,
+	0xd8, 0x9f, 0xda, 0x9e, 0x87, 0xfe, 0x04, 0x89, 0x01, 0x35, 0xdb, 0x75, 0x23, 0x8c, 0x63, 0x43,
+	0xd9, 0x57, 0x5a, 0x0d, 0x9a, 0xb9, 0xe6, 0xef, 0xd0, 0xe0, 0xe8, 0x20, 0xf0, 0x1d, 0x24, 0xdb,
+	0x50, 0x99, 0xd9, 0xde, 0x3d, 0x4a, 0x28, 0x75, 0xcc, 0x2f, 0x0a, 0xe8, 0x9c, 0xa1, 0x38, 0x61,
+	0x71, 0x12, 0xd9, 0x09, 0x0b, 0xfc, 0xd5, 0x8a, 0x73, 0x91, 0x52, 0x41, 0x84, 0x47, 0x7d, 0x9e,
+	0xc3, 0x50, 0xd3, 0xa8, 0x70, 0x88, 0x0e, 0x6a, 0xcc, 0x26, 0x46, 0x79, 0x5f, 0x69, 0x35, 0x29,
+	0x37, 0x39, 0x97, 0x04, 0xb7, 0xe8, 0x1b, 0x95, 0x94, 0x13, 0x8e, 0xf9, 0x27, 0x10, 0x5e, 0xc1,
+	0x09, 0x46, 0xc5, 0x1a, 0x72, 0x56, 0x29, 0xb2, 0x47, 0xb0, 0xb3, 0xc8, 0x76, 0x9d, 0x5b, 0xb2,
+	0x0e, 0x25, 0xe6, 0x4a, 0xb6, 0xc4, 0x5c, 0xf3, 0x55, 0x2a, 0x4a, 0xf1, 0x26, 0xc2, 0x78, 0x3a,
+	0xc4, 0x38, 0xe6, 0xa2, 0xbf, 0x42, 0xd5, 0x76, 0x9c, 0xf9, 0x77, 0x49, 0x8f, 0x7f, 0x70, 0x94,
+	0x92, 0xf2, 0xc3, 0x32, 0xd7, 0xec, 0xc1, 0x06, 0xd7, 0xb9, 0xbc, 0x1f, 0x7b, 0x2c, 0x9e, 0x5e,
+	0x22, 0x46, 0xcb, 0x2b, 0x23, 0xbf, 0x41, 0x39, 0x44, 0x8c, 0xc4, 0x7d, 0xad, 0x53, 0x69, 0x73,
+	0x94, 0x8a, 0x90, 0x79, 0x08, 0xe4, 0x89, 0xc6, 0xb2, 0x8a, 0xff, 0x4d, 0x87, 0x35, 0x4c, 0x82,
+	0x08, 0x57, 0xe4, 0x20, 0x50, 0x76, 0x98, 0x1b, 0x1b, 0xa5, 0x7d, 0xb5, 0xd5, 0xa0, 0xc2, 0x36,
+	0xf7, 0xa0, 0x99, 0x5f, 0x5b, 0x26, 0xfb, 0x1f, 0x68, 0xfc, 0xfc, 0xca, 0x8f, 0x9f, 0x29, 0x7c,
+	0x08, 0xeb, 0x85, 0x8b, 0x5c, 0x3a, 0xa3, 0x94, 0x45, 0xea, 0x62, 0xfc, 0x09, 0x9d, 0xe4, 0x8c,
+	0xc5, 0xc9, 0x52, 0xea, 0x23, 0xc0, 0x9c, 0x5a, 0x51, 0x83, 0x0e, 0xaa, 0xc3, 0x5c, 0xd9, 0x7f,
+	0x6e, 0x72, 0x25, 0xd7, 0x4e, 0x6c, 0xb1, 0x55, 0x4d, 0x2a, 0x6c, 0x1e, 0xf3, 0x03, 0x17, 0xe5,
+	0x56, 0x09, 0xdb, 0x7c, 0x07, 0x1b, 0x79, 0x0b, 0x46, 0xd3, 0x08, 0x6d, 0x77, 0x45, 0x8a, 0xb4,
+	0x37, 0xa5, 0xac, 0x37, 0x64, 0x0f, 0xc0, 0x61, 0xe1, 0x14, 0xa3, 0x04, 0x3f, 0x27, 0x32, 0x4d,
+	0x21, 0x92, 0x0d, 0xae, 0x20, 0xbc, 0xac, 0xc3, 0x2f, 0x60, 0xb3, 0xd0, 0xa8, 0xe7, 0x14, 0x60,
+	0xfe, 0x01, 0xdb, 0x0b, 0x57, 0x97, 0xa5, 0x18, 0x64, 0x4f, 0xc4, 0x63, 0x33, 0x8c, 0xce, 0x31,
+	0x8e, 0xed, 0x09, 0x3e, 0xa5, 0xf8, 0x76, 0x3b, 0x1e, 0x43, 0x3f, 0x91, 0x19, 0xa4, 0xc7, 0x3b,
+	0x8b, 0xfe, 0x4c, 0x7e, 0x1f, 0x37, 0xcd, 0xe3, 0xb4, 0xe4, 0xfe, 0x14, 0x9d, 0x5b, 0xa9, 0x16,
+	0xaf, 0x78, 0x71, 0xff, 0xa7, 0xfb, 0x95, 0x53, 0x2d, 0xa8, 0xdf, 0x49, 0x5b, 0x8c, 0x58, 0xeb,
+	0x34, 0xdb, 0x05, 0x80, 0xe6, 0xa7, 0xf3, 0x77, 0xed, 0x61, 0x82, 0x3f, 0xc8, 0xf2, 0x17, 0xec,
+	0x2c, 0xb2, 0x72, 0xe7, 0xee, 0x82, 0x28, 0xfd, 0xd1, 0xaa, 0x53, 0x61, 0x9b, 0xdf, 0xd4, 0xf4,
+	0x27, 0x90, 0x62, 0xe8, 0x31, 0xc7, 0x4e, 0x90, 0x1c, 0x41, 0x39, 0x79, 0x08, 0x53, 0x6a, 0xbd,
+	0xb3, 0xd5, 0x7e, 0x74, 0xda, 0x1e, 0x3d, 0x84, 0x48, 0x05, 0x40, 0x0e, 0x1e, 0xb5, 0x48, 0xeb,
+	0x68, 0x02, 0xed, 0x8b, 0x50, 0xde, 0xaf, 0x03, 0xa8, 0x06, 0x62, 0x53, 0x0d, 0xb5, 0x00, 0xa5,
+	0xcb, 0x4b, 0xe5, 0x51, 0xbe, 0xe6, 0xe5, 0xf9, 0x9a, 0x93, 0x63, 0xa8, 0x26, 0x62, 0x86, 0xe2,
+	0x07, 0x4e, 0xeb, 0x6c, 0x16, 0xd4, 0xd3, 0xe1, 0x52, 0x09, 0x90, 0xbf, 0xa1, 0x26, 0x1b, 0x65,
+	0x54, 0x05, 0x4b, 0x0a, 0x6c, 0xd6, 0xcb, 0x0c, 0xc9, 0x26, 0x58, 0xcb, 0x27, 0x48, 0x76, 0x0b,
+	0x63, 0xa8, 0x8b, 0x12, 0x72, 0x9f, 0xec, 0x82, 0x3a, 0xb6, 0x7d, 0xa3, 0x21, 0x74, 0xeb, 0x42,
+	0xb7, 0x67, 0xfb, 0x94, 0x07, 0xcd, 0xaf, 0x0a, 0x94, 0x79, 0x3f, 0x08, 0x40, 0xb5, 0x7f, 0x76,
+	0x6a, 0x0d, 0x46, 0xfa, 0x2f, 0x64, 0x13, 0xd6, 0x4e, 0xac, 0x33, 0x6b, 0x64, 0x5d, 0xcb, 0x90,
+	0xc2, 0x8f, 0x2f, 0x7a, 0x6f, 0xad, 0xfe, 0x48, 0x2f, 0x11, 0x0d, 0x6a, 0x57, 0x83, 0xe1, 0xe8,
+	0x82, 0x5a, 0xba, 0xca, 0x0f, 0x46, 0x6f, 0xa8, 0xd5, 0x3d, 0xd1, 0xcb, 0x85, 0x7b, 0x32, 0x54,
+	0xe1, 0xec, 0xb9, 0x35, 0x1c, 0x76, 0x5f, 0x5b, 0x7a, 0x95, 0x6c, 0xc1, 0x86, 0x3c, 0x97, 0xb1,
+	0xa1, 0x5e, 0xe3, 0x97, 0xac, 0xf7, 0x97, 0xa7, 0x34, 0x4f, 0x56, 0x27, 0x35, 0x50, 0x7b, 0xdd,
+	0x81, 0xde, 0x20, 0x0d, 0xa8, 0x5c, 0x0d, 0xb8, 0x09, 0xe6, 0x4b, 0xd0, 0x1f, 0x4d, 0x91, 0x2f,
+	0xc3, 0xcf, 0x8e, 0xb9, 0xb7, 0x05, 0x6b, 0x2c, 0x68, 0xf3, 0x37, 0xcc, 0x3c, 0x6c, 0x87, 0xe3,
+	0x0f, 0xa5, 0x70, 0x3c, 0xae, 0x8a, 0xff, 0xcf, 0x7f, 0xbe, 0x0f, 0x00, 0xa9, 0x38, 0x76, 0x1b,
+	0x62, 0x07, 0x00, 0x00,
 }
@@ -28,6 +28,7 @@ const (
 	Message_PONG                          Message_Type = 1
 	Message_THREAD_ENVELOPE               Message_Type = 10
 	Message_THREAD_ENVELOPE_ACK           Message_Type = 11
+	Message_THREAD_PRESENCE               Message_Type = 12
 	Message_CAFE_CHALLENGE                Message_Type = 50
 	Message_CAFE_NONCE                    Message_Type = 51
 	Message_CAFE_REGISTRATION             Message_Type = 52
@@ -55,6 +56,8 @@ const (
 	Message_CAFE_PUBLISH_PEER_ACK         Message_Type = 67
 	Message_CAFE_QUERY                    Message_Type = 70
 	Message_CAFE_QUERY_RES                Message_Type = 71
+	Message_CAFE_REPLICATE                Message_Type = 79
+	Message_CAFE_REPLICATE_ACK            Message_Type = 80
 	Message_CAFE_PUBSUB_QUERY             Message_Type = 102
 	Message_CAFE_PUBSUB_QUERY_RES         Message_Type = 103
 	Message_ERROR                         Message_Type = 500
@@ -69,6 +72,7 @@ var Message_Type_name = map[int32]string{
 	1:   "PONG",
 	10:  "THREAD_ENVELOPE",
 	11:  "THREAD_ENVELOPE_ACK",
+	12:  "THREAD_PRESENCE",
 	50:  "CAFE_CHALLENGE",
 	51:  "CAFE_NONCE",
 	52:  "CAFE_REGISTRATION",
@@ -96,6 +100,8 @@ var Message_Type_name = map[int32]string{
 	67:  "CAFE_PUBLISH_PEER_ACK",
 	70:  "CAFE_QUERY",
 	71:  "CAFE_QUERY_RES",
+	79:  "CAFE_REPLICATE",
+	80:  "CAFE_REPLICATE_ACK",
 	102: "CAFE_PUBSUB_QUERY",
 	103: "CAFE_PUBSUB_QUERY_RES",
 	500: "ERROR",
@@ -110,6 +116,7 @@ var Message_Type_value = map[string]int32{
 	"PONG":                          1,
 	"THREAD_ENVELOPE":               10,
 	"THREAD_ENVELOPE_ACK":           11,
+	"THREAD_PRESENCE":               12,
 	"CAFE_CHALLENGE":                50,
 	"CAFE_NONCE":                    51,
 	"CAFE_REGISTRATION":             52,
@@ -137,6 +144,8 @@ var Message_Type_value = map[string]int32{
 	"CAFE_PUBLISH_PEER_ACK":         67,
 	"CAFE_QUERY":                    70,
 	"CAFE_QUERY_RES":                71,
+	"CAFE_REPLICATE":                79,
+	"CAFE_REPLICATE_ACK":            80,
 	"CAFE_PUBSUB_QUERY":             102,
 	"CAFE_PUBSUB_QUERY_RES":         103,
 	"ERROR":                         500,
@@ -321,45 +330,47 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5b, 0x6f, 0xda, 0x4c,
-	0x10, 0x86, 0x3f, 0x12, 0x12, 0xf8, 0x86, 0x90, 0x6c, 0x26, 0x27, 0xc2, 0x77, 0x10, 0x41, 0xaa,
-	0xc4, 0x95, 0x23, 0x91, 0xa6, 0xe7, 0x43, 0x8c, 0x99, 0x80, 0x13, 0x63, 0xd3, 0xb5, 0x89, 0x94,
-	0xde, 0x58, 0xd0, 0x38, 0x28, 0x52, 0x8a, 0x29, 0x90, 0xaa, 0xfc, 0x80, 0xfe, 0xdf, 0x5e, 0xf4,
-	0x07, 0x54, 0x1e, 0xe3, 0x95, 0xd3, 0xa4, 0x77, 0x9e, 0xf7, 0x7d, 0xe7, 0x99, 0xdd, 0xb5, 0x34,
-	0x50, 0xfc, 0x1c, 0x4c, 0xa7, 0xfd, 0x61, 0xa0, 0x8d, 0x27, 0xe1, 0x2c, 0x2c, 0xef, 0x0f, 0xc3,
-	0x70, 0x78, 0x1b, 0x1c, 0x72, 0x35, 0xb8, 0xbb, 0x3e, 0xec, 0x8f, 0xe6, 0xb1, 0x55, 0xfd, 0x9e,
-	0x87, 0x5c, 0x27, 0x0e, 0xe3, 0x01, 0x64, 0x67, 0xf3, 0x71, 0x50, 0xca, 0x54, 0x32, 0xb5, 0xf5,
-	0x7a, 0x51, 0x5b, 0xe8, 0x9a, 0x37, 0x1f, 0x07, 0x92, 0x2d, 0xd4, 0x20, 0x37, 0xee, 0xcf, 0x6f,
-	0xc3, 0xfe, 0x55, 0x69, 0xa9, 0x92, 0xa9, 0x15, 0xea, 0xdb, 0x5a, 0xcc, 0xd6, 0x12, 0xb6, 0xa6,
-	0x8f, 0xe6, 0x32, 0x09, 0x61, 0x09, 0x72, 0x93, 0xe0, 0xcb, 0x5d, 0x30, 0x9d, 0x95, 0x96, 0x2b,
-	0x99, 0xda, 0x8a, 0x4c, 0x4a, 0x2c, 0x43, 0x7e, 0x12, 0x4c, 0xc7, 0xe1, 0x68, 0x1a, 0x94, 0xb2,
-	0x95, 0x4c, 0x2d, 0x2f, 0x55, 0x5d, 0xfd, 0xb1, 0x0a, 0xd9, 0x68, 0x28, 0xe6, 0x21, 0xdb, 0x35,
-	0xed, 0x96, 0xf8, 0x8b, 0xbf, 0x1c, 0xbb, 0x25, 0x32, 0xb8, 0x05, 0x1b, 0x5e, 0x5b, 0x92, 0xde,
-	0xf4, 0xc9, 0xbe, 0x20, 0xcb, 0xe9, 0x92, 0x00, 0xdc, 0x83, 0xad, 0xdf, 0x44, 0x5f, 0x37, 0xce,
-	0x45, 0x01, 0x11, 0xd6, 0x0d, 0xfd, 0x94, 0x7c, 0xa3, 0xad, 0x5b, 0x16, 0xd9, 0x2d, 0x12, 0x75,
-	0x5c, 0x07, 0x60, 0xcd, 0x76, 0x6c, 0x83, 0xc4, 0x11, 0xee, 0xc0, 0x26, 0xd7, 0x92, 0x5a, 0xa6,
-	0xeb, 0x49, 0xdd, 0x33, 0x1d, 0x5b, 0x3c, 0x8d, 0x98, 0x2c, 0x37, 0xe9, 0x9e, 0xd1, 0xc6, 0x7f,
-	0x60, 0xef, 0x11, 0x83, 0x07, 0x9a, 0x28, 0x60, 0x8d, 0x4d, 0x97, 0x5c, 0x37, 0x8a, 0x1f, 0x63,
-	0x09, 0xb6, 0x17, 0xf8, 0x53, 0x49, 0x6e, 0x5b, 0x39, 0xcf, 0xd4, 0x41, 0x5c, 0xcf, 0x91, 0x24,
-	0x9e, 0xab, 0xc3, 0x72, 0xcd, 0xbc, 0xd7, 0x8a, 0xd7, 0xb3, 0xe3, 0xd4, 0x19, 0x6e, 0x83, 0x48,
-	0x2b, 0x9c, 0x3b, 0xc7, 0x0d, 0x28, 0xb0, 0xea, 0x34, 0xce, 0xc8, 0xf0, 0xc4, 0x0b, 0x15, 0x8b,
-	0x05, 0xdf, 0x32, 0x5d, 0x4f, 0xbc, 0x54, 0x77, 0x8d, 0x5b, 0xe3, 0x37, 0x13, 0xaf, 0x70, 0x1f,
-	0x76, 0x1e, 0xc8, 0x0c, 0xb6, 0xd4, 0x33, 0x24, 0xe3, 0x16, 0x3d, 0x1d, 0xf5, 0x0c, 0xf7, 0x0d,
-	0xee, 0xb2, 0xd5, 0xa5, 0x9b, 0x64, 0x99, 0x17, 0x24, 0xfd, 0x0e, 0xb9, 0xae, 0xde, 0x22, 0xf1,
-	0x46, 0xf1, 0x8c, 0x36, 0x19, 0xe7, 0x89, 0xee, 0x8a, 0xb7, 0xb8, 0x09, 0x45, 0x36, 0x94, 0xf4,
-	0x2e, 0x4d, 0x21, 0x2f, 0xe5, 0xbc, 0xc7, 0x7f, 0xa1, 0xf4, 0x98, 0xc3, 0xd3, 0x4f, 0x70, 0x17,
-	0x90, 0xdd, 0x4b, 0xa7, 0xe7, 0xb7, 0xf5, 0x0b, 0xf2, 0x3b, 0xba, 0x69, 0x09, 0x5d, 0xdd, 0xbe,
-	0xdb, 0x6b, 0x58, 0xa6, 0xdb, 0xf6, 0xbb, 0x44, 0x52, 0x34, 0xd4, 0xed, 0xd3, 0x32, 0x93, 0x0c,
-	0xf5, 0x8b, 0x3e, 0xf4, 0x48, 0x5e, 0x8a, 0x53, 0xf5, 0x8b, 0xb8, 0xf6, 0x25, 0xb9, 0xa2, 0x95,
-	0xa6, 0xba, 0xbd, 0xc6, 0x22, 0x7a, 0x9d, 0xa6, 0x2a, 0x99, 0x3b, 0x86, 0x08, 0xb0, 0x42, 0x52,
-	0x3a, 0x52, 0xfc, 0x5c, 0xc6, 0xf2, 0xe2, 0xac, 0x86, 0x63, 0x7b, 0xba, 0xe1, 0x2d, 0xda, 0x9b,
-	0xe5, 0xa5, 0x7c, 0x06, 0xff, 0x87, 0xdd, 0x87, 0x1e, 0x33, 0x88, 0xfd, 0x03, 0xd8, 0x4f, 0x8f,
-	0xb8, 0x8f, 0xb8, 0xe2, 0xc8, 0x13, 0xf8, 0xef, 0x8f, 0x11, 0x26, 0x05, 0x51, 0xac, 0x7a, 0x02,
-	0x79, 0x1a, 0x7d, 0x0d, 0x6e, 0xc3, 0x71, 0x80, 0x55, 0xc8, 0x2d, 0xf6, 0x07, 0xaf, 0x82, 0x42,
-	0x3d, 0x9f, 0xac, 0x02, 0x99, 0x18, 0x28, 0x60, 0x79, 0x7a, 0x33, 0xe4, 0x25, 0xb0, 0x26, 0xa3,
-	0xcf, 0xea, 0x31, 0xac, 0xd0, 0x64, 0x12, 0x4e, 0x10, 0x21, 0xfb, 0x29, 0xbc, 0x8a, 0x7b, 0x8b,
-	0x92, 0xbf, 0xa3, 0x3d, 0x90, 0x20, 0xa3, 0x96, 0xbf, 0x15, 0xa8, 0xb1, 0x05, 0xc5, 0x9b, 0x50,
-	0x9b, 0x05, 0xdf, 0x66, 0x37, 0xd1, 0x16, 0x19, 0x7c, 0x5c, 0x1a, 0x0f, 0x06, 0xab, 0xbc, 0x4d,
-	0x8e, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x25, 0xb0, 0x61, 0x52, 0xc8, 0x04, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xed, 0x6e, 0xda, 0x4a,
+	0x10, 0x86, 0x0f, 0x09, 0x39, 0x70, 0x86, 0x90, 0x6c, 0x26, 0x5f, 0x84, 0xd3, 0x56, 0x04, 0xa9,
+	0x12, 0xbf, 0x1c, 0x89, 0x34, 0xfd, 0xfe, 0x88, 0x31, 0x13, 0x70, 0x62, 0x6c, 0xba, 0x36, 0x91,
+	0xd2, 0x3f, 0x16, 0x34, 0x0e, 0x8a, 0x94, 0x62, 0x0a, 0xa4, 0x2a, 0x97, 0xd5, 0x9b, 0xe8, 0x15,
+	0xf5, 0x02, 0x2a, 0x8f, 0xf1, 0xca, 0x69, 0xd2, 0x7f, 0x9e, 0xf7, 0x7d, 0xe7, 0xd9, 0xd9, 0xb5,
+	0x34, 0x50, 0xfc, 0x12, 0x4c, 0xa7, 0xfd, 0x61, 0xa0, 0x8d, 0x27, 0xe1, 0x2c, 0x2c, 0xef, 0x0d,
+	0xc3, 0x70, 0x78, 0x13, 0x1c, 0x70, 0x35, 0xb8, 0xbd, 0x3a, 0xe8, 0x8f, 0xe6, 0xb1, 0x55, 0xfd,
+	0x99, 0x87, 0x5c, 0x27, 0x0e, 0xe3, 0x3e, 0x64, 0x67, 0xf3, 0x71, 0x50, 0xca, 0x54, 0x32, 0xb5,
+	0xb5, 0x7a, 0x51, 0x5b, 0xe8, 0x9a, 0x37, 0x1f, 0x07, 0x92, 0x2d, 0xd4, 0x20, 0x37, 0xee, 0xcf,
+	0x6f, 0xc2, 0xfe, 0x65, 0x69, 0xa9, 0x92, 0xa9, 0x15, 0xea, 0x5b, 0x5a, 0xcc, 0xd6, 0x12, 0xb6,
+	0xa6, 0x8f, 0xe6, 0x32, 0x09, 0x61, 0x09, 0x72, 0x93, 0xe0, 0xeb, 0x6d, 0x30, 0x9d, 0x95, 0x96,
+	0x2b, 0x99, 0xda, 0x8a, 0x4c, 0x4a, 0x2c, 0x43, 0x7e, 0x12, 0x4c, 0xc7, 0xe1, 0x68, 0x1a, 0x94,
+	0xb2, 0x95, 0x4c, 0x2d, 0x2f, 0x55, 0x5d, 0xfd, 0x91, 0x83, 0x6c, 0x74, 0x28, 0xe6, 0x21, 0xdb,
+	0x35, 0xed, 0x96, 0xf8, 0x87, 0xbf, 0x1c, 0xbb, 0x25, 0x32, 0xb8, 0x09, 0xeb, 0x5e, 0x5b, 0x92,
+	0xde, 0xf4, 0xc9, 0x3e, 0x27, 0xcb, 0xe9, 0x92, 0x00, 0xdc, 0x85, 0xcd, 0x3f, 0x44, 0x5f, 0x37,
+	0xce, 0x44, 0x21, 0x95, 0xee, 0x4a, 0x72, 0xc9, 0x36, 0x48, 0xac, 0x22, 0xc2, 0x9a, 0xa1, 0x9f,
+	0x90, 0x6f, 0xb4, 0x75, 0xcb, 0x22, 0xbb, 0x45, 0xa2, 0x8e, 0x6b, 0x00, 0xac, 0xd9, 0x4e, 0x94,
+	0x39, 0xc4, 0x6d, 0xd8, 0xe0, 0x5a, 0x52, 0xcb, 0x74, 0x3d, 0xa9, 0x7b, 0xa6, 0x63, 0x8b, 0x67,
+	0xd1, 0x41, 0x2c, 0x37, 0xe9, 0x8e, 0xd1, 0xc6, 0xff, 0x61, 0xf7, 0x01, 0x83, 0xa7, 0x30, 0x51,
+	0xc0, 0x2a, 0x9b, 0x2e, 0xb9, 0x6e, 0x14, 0x3f, 0xc2, 0x12, 0x6c, 0x2d, 0xf0, 0x27, 0x92, 0xdc,
+	0xb6, 0x72, 0x9e, 0xab, 0x41, 0x5c, 0xcf, 0x91, 0x24, 0x5e, 0xa8, 0x61, 0xb9, 0x66, 0xde, 0x1b,
+	0xc5, 0xeb, 0xd9, 0x71, 0xea, 0x14, 0xb7, 0x40, 0xa4, 0x15, 0xce, 0x9d, 0xe1, 0x3a, 0x14, 0x58,
+	0x75, 0x1a, 0xa7, 0x64, 0x78, 0xe2, 0xa5, 0x8a, 0xc5, 0x82, 0x6f, 0x99, 0xae, 0x27, 0x5e, 0xa9,
+	0xbb, 0xc6, 0xad, 0xf1, 0x7b, 0x89, 0xd7, 0xb8, 0x07, 0xdb, 0xf7, 0x64, 0x06, 0x5b, 0xea, 0x19,
+	0x7a, 0x76, 0xda, 0x14, 0x1d, 0xf5, 0x0c, 0x3d, 0xfb, 0x5e, 0x97, 0xad, 0x2e, 0xdd, 0x24, 0xcb,
+	0x3c, 0x27, 0xe9, 0x77, 0xc8, 0x75, 0xf5, 0x16, 0x89, 0xb7, 0x8a, 0x67, 0xb4, 0xc9, 0x38, 0x4b,
+	0x74, 0x57, 0xbc, 0xc3, 0x0d, 0x28, 0xb2, 0xa1, 0xa4, 0xf7, 0x69, 0x0a, 0x79, 0x29, 0xe7, 0x03,
+	0x3e, 0x82, 0xd2, 0x43, 0x0e, 0x9f, 0x7e, 0x8c, 0x3b, 0x80, 0xec, 0x5e, 0x38, 0x3d, 0xbf, 0xad,
+	0x9f, 0x93, 0xdf, 0xd1, 0x4d, 0x4b, 0xe8, 0xea, 0xf6, 0xdd, 0x5e, 0xc3, 0x32, 0xdd, 0xb6, 0xdf,
+	0x25, 0x92, 0xa2, 0xa1, 0x6e, 0x9f, 0x96, 0x99, 0x64, 0xa8, 0x5f, 0xf4, 0xb1, 0x47, 0xf2, 0x42,
+	0x9c, 0xa8, 0x5f, 0xc4, 0xb5, 0x2f, 0xc9, 0x15, 0x2d, 0xa5, 0x49, 0xea, 0x5a, 0xa6, 0xa1, 0x7b,
+	0x24, 0x1c, 0x35, 0x81, 0xd2, 0x98, 0xd7, 0x4d, 0x4f, 0xe0, 0xf6, 0x1a, 0x0b, 0xec, 0x55, 0x7a,
+	0x02, 0x25, 0x33, 0x7d, 0x88, 0x00, 0x2b, 0x24, 0xa5, 0x23, 0xc5, 0xaf, 0x65, 0x2c, 0x2f, 0xa8,
+	0x86, 0x63, 0x7b, 0xba, 0xe1, 0x2d, 0xda, 0x9b, 0xe5, 0xa5, 0x7c, 0x06, 0x9f, 0xc0, 0xce, 0x7d,
+	0x8f, 0x19, 0xc4, 0xfe, 0x3e, 0xec, 0xa5, 0x8f, 0xb8, 0x8b, 0xb8, 0xe4, 0xc8, 0x53, 0x78, 0xfc,
+	0xd7, 0x08, 0x93, 0x82, 0x28, 0x56, 0x3d, 0x86, 0x3c, 0x8d, 0xbe, 0x05, 0x37, 0xe1, 0x38, 0xc0,
+	0x2a, 0xe4, 0x16, 0x0b, 0x88, 0x77, 0x49, 0xa1, 0x9e, 0x4f, 0x76, 0x89, 0x4c, 0x0c, 0x14, 0xb0,
+	0x3c, 0xbd, 0x1e, 0xf2, 0x16, 0x59, 0x95, 0xd1, 0x67, 0xf5, 0x08, 0x56, 0x68, 0x32, 0x09, 0x27,
+	0x88, 0x90, 0xfd, 0x1c, 0x5e, 0xc6, 0xbd, 0x45, 0xc9, 0xdf, 0xd1, 0x22, 0x49, 0x90, 0x51, 0xcb,
+	0x7f, 0x0a, 0xd4, 0xd8, 0x84, 0xe2, 0x75, 0xa8, 0xcd, 0x82, 0xef, 0xb3, 0xeb, 0x68, 0x0d, 0x0d,
+	0x3e, 0x2d, 0x8d, 0x07, 0x83, 0x7f, 0x79, 0x1d, 0x1d, 0xfe, 0x1e, 0x00, 0x15, 0xe0, 0x94, 0xbd,
+	0x09, 0x05, 0x00, 0x00,
 }
@@ -29,6 +29,8 @@ const (
 	MobileEventType_ACCOUNT_UPDATE           MobileEventType = 10
 	MobileEventType_THREAD_UPDATE            MobileEventType = 11
 	MobileEventType_NOTIFICATION             MobileEventType = 12
+	MobileEventType_THREAD_READ              MobileEventType = 13
+	MobileEventType_THREAD_TYPING            MobileEventType = 14
 	MobileEventType_QUERY_RESPONSE           MobileEventType = 20
 	MobileEventType_CAFE_SYNC_GROUP_UPDATE   MobileEventType = 30
 	MobileEventType_CAFE_SYNC_GROUP_COMPLETE MobileEventType = 31
@@ -42,6 +44,8 @@ var MobileEventType_name = map[int32]string{
 	10: "ACCOUNT_UPDATE",
 	11: "THREAD_UPDATE",
 	12: "NOTIFICATION",
+	13: "THREAD_READ",
+	14: "THREAD_TYPING",
 	20: "QUERY_RESPONSE",
 	30: "CAFE_SYNC_GROUP_UPDATE",
 	31: "CAFE_SYNC_GROUP_COMPLETE",
@@ -55,6 +59,8 @@ var MobileEventType_value = map[string]int32{
 	"ACCOUNT_UPDATE":           10,
 	"THREAD_UPDATE":            11,
 	"NOTIFICATION":             12,
+	"THREAD_READ":              13,
+	"THREAD_TYPING":            14,
 	"QUERY_RESPONSE":           20,
 	"CAFE_SYNC_GROUP_UPDATE":   30,
 	"CAFE_SYNC_GROUP_COMPLETE": 31,
@@ -217,30 +223,31 @@ func init() {
 func init() { proto.RegisterFile("mobile.proto", fileDescriptor_3486309221f3b440) }
 
 var fileDescriptor_3486309221f3b440 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x6a, 0xdb, 0x30,
-	0x14, 0xc6, 0x6b, 0xcf, 0xed, 0x96, 0x93, 0x3f, 0xd5, 0x4e, 0x47, 0x31, 0xa5, 0x6c, 0x21, 0x30,
-	0x28, 0xbd, 0xf0, 0x45, 0xf7, 0x04, 0x9a, 0xad, 0x6c, 0x86, 0x54, 0x72, 0x15, 0x85, 0xd1, 0xdd,
-	0x18, 0xbb, 0x16, 0xc3, 0xe0, 0xd6, 0x9e, 0xad, 0x8c, 0xe5, 0xc1, 0xf6, 0x46, 0x7b, 0x90, 0x61,
-	0x25, 0xbe, 0x09, 0xbb, 0x3b, 0xdf, 0xf9, 0xbe, 0xdf, 0xe7, 0x03, 0x16, 0x4c, 0x9e, 0xeb, 0xbc,
-	0xac, 0x74, 0xd0, 0xb4, 0xb5, 0xa9, 0xaf, 0xc6, 0x3f, 0xb7, 0xba, 0xdd, 0x1d, 0xc4, 0xf4, 0x59,
-	0x77, 0x5d, 0xf6, 0xe3, 0xe0, 0x2d, 0x42, 0xb8, 0xb8, 0xb7, 0xd9, 0x6f, 0x59, 0x55, 0x69, 0x43,
-	0x9f, 0x9e, 0xea, 0xed, 0x8b, 0x41, 0x04, 0xaf, 0xd3, 0xba, 0xf0, 0x9d, 0xb9, 0x73, 0x33, 0x92,
-	0x76, 0x46, 0x1f, 0x5e, 0x67, 0x45, 0xd1, 0xea, 0xae, 0xf3, 0x5d, 0xbb, 0x1e, 0xe4, 0xe2, 0x8f,
-	0x03, 0x64, 0xdf, 0xf2, 0xd0, 0x7f, 0x89, 0xfd, 0xd2, 0x2f, 0x06, 0x67, 0xe0, 0x96, 0x43, 0x81,
-	0x5b, 0x16, 0x78, 0x0b, 0x9e, 0xd9, 0x35, 0xda, 0xb2, 0xb3, 0xbb, 0xcb, 0xe0, 0x18, 0x08, 0xd4,
-	0xae, 0xd1, 0xd2, 0x66, 0x70, 0x0e, 0x5e, 0x91, 0x99, 0xcc, 0x7f, 0x35, 0x77, 0x6e, 0xc6, 0x77,
-	0x93, 0xc0, 0xa6, 0xa4, 0xee, 0xb6, 0x95, 0x91, 0xd6, 0xc1, 0x6b, 0x38, 0xd5, 0x6d, 0x5b, 0xb7,
-	0xbe, 0x67, 0x23, 0x67, 0x01, 0xeb, 0x95, 0xdc, 0x2f, 0x17, 0x1f, 0xc1, 0xeb, 0xdb, 0xf0, 0x0d,
-	0x78, 0x11, 0x55, 0x94, 0x9c, 0xd8, 0x49, 0x70, 0x46, 0x1c, 0x1c, 0xc1, 0x29, 0x93, 0x52, 0x48,
-	0xe2, 0xde, 0xfe, 0x75, 0xe0, 0x7c, 0x7f, 0x86, 0xbd, 0xc0, 0x22, 0x33, 0x00, 0x2e, 0x22, 0x96,
-	0xae, 0x15, 0x95, 0x8a, 0x9c, 0xe0, 0x39, 0x8c, 0xad, 0x16, 0x7c, 0x15, 0x5b, 0x7e, 0x0a, 0xa3,
-	0x43, 0x40, 0x24, 0xc4, 0x45, 0x84, 0x19, 0x0d, 0x43, 0xb1, 0xe1, 0x2a, 0xdd, 0x24, 0x11, 0x55,
-	0x8c, 0x00, 0xbe, 0x85, 0xa9, 0xfa, 0x2a, 0x19, 0x8d, 0x86, 0xd5, 0x18, 0x09, 0x4c, 0xb8, 0x50,
-	0xf1, 0x32, 0x0e, 0xa9, 0x8a, 0x05, 0x27, 0x93, 0x1e, 0x7c, 0xd8, 0x30, 0xf9, 0x98, 0x4a, 0xb6,
-	0x4e, 0x04, 0x5f, 0x33, 0xf2, 0x0e, 0xaf, 0xe0, 0x32, 0xa4, 0x4b, 0x96, 0xae, 0x1f, 0x79, 0x98,
-	0x7e, 0x91, 0x62, 0x93, 0x0c, 0x0d, 0xef, 0xf1, 0x1a, 0xfc, 0x63, 0x2f, 0x14, 0xf7, 0xc9, 0x8a,
-	0x29, 0x46, 0x3e, 0xfc, 0x8f, 0x5c, 0xd2, 0x78, 0xc5, 0x22, 0x32, 0xff, 0x7c, 0x01, 0xd3, 0xb2,
-	0x0e, 0x8c, 0xfe, 0x6d, 0xec, 0x9b, 0xc8, 0xbf, 0xbb, 0x4d, 0x9e, 0x9f, 0xd9, 0xff, 0xff, 0xe9,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x14, 0x44, 0x17, 0x2b, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xd1, 0x6a, 0xdb, 0x30,
+	0x14, 0x86, 0x6b, 0xcf, 0xed, 0x96, 0x93, 0xd8, 0xd5, 0x4e, 0x47, 0x31, 0xa5, 0x6c, 0x21, 0x30,
+	0x28, 0xbd, 0xf0, 0x45, 0xf7, 0x04, 0x9a, 0xad, 0x74, 0x86, 0x54, 0x72, 0x15, 0x85, 0x91, 0xdd,
+	0x18, 0xa7, 0x16, 0x23, 0x90, 0xd6, 0x99, 0xad, 0x8c, 0xe5, 0x0d, 0xf6, 0x42, 0x7b, 0xbf, 0x61,
+	0xc5, 0x86, 0x11, 0x76, 0x23, 0xf4, 0x9f, 0xf3, 0x7f, 0xff, 0x39, 0x17, 0x07, 0x46, 0xcf, 0xd5,
+	0x6a, 0xbd, 0xd1, 0xd1, 0xb6, 0xae, 0x4c, 0x75, 0x35, 0xfc, 0xb1, 0xd3, 0xf5, 0xbe, 0x13, 0xfe,
+	0xb3, 0x6e, 0x9a, 0xe2, 0x7b, 0xd7, 0x9b, 0xc4, 0x70, 0xf1, 0x60, 0xbd, 0x5f, 0x8b, 0xcd, 0x46,
+	0x1b, 0xfa, 0xf4, 0x54, 0xed, 0x5e, 0x0c, 0x22, 0x78, 0x8d, 0xd6, 0x65, 0xe8, 0x8c, 0x9d, 0x9b,
+	0x81, 0xb4, 0x7f, 0x0c, 0xe1, 0x75, 0x51, 0x96, 0xb5, 0x6e, 0x9a, 0xd0, 0xb5, 0xe5, 0x5e, 0x4e,
+	0xfe, 0x38, 0x40, 0x0e, 0x29, 0x8f, 0xed, 0x24, 0xf6, 0x53, 0xbf, 0x18, 0x0c, 0xc0, 0x5d, 0xf7,
+	0x01, 0xee, 0xba, 0xc4, 0x5b, 0xf0, 0xcc, 0x7e, 0xab, 0x2d, 0x1b, 0xdc, 0x5d, 0x46, 0xc7, 0x40,
+	0xa4, 0xf6, 0x5b, 0x2d, 0xad, 0x07, 0xc7, 0xe0, 0x95, 0x85, 0x29, 0xc2, 0x57, 0x63, 0xe7, 0x66,
+	0x78, 0x37, 0x8a, 0xac, 0x4b, 0xea, 0x66, 0xb7, 0x31, 0xd2, 0x76, 0xf0, 0x1a, 0x4e, 0x75, 0x5d,
+	0x57, 0x75, 0xe8, 0x59, 0xcb, 0x59, 0xc4, 0x5a, 0x25, 0x0f, 0xc5, 0xc9, 0x47, 0xf0, 0xda, 0x34,
+	0x7c, 0x03, 0x5e, 0x42, 0x15, 0x25, 0x27, 0xf6, 0x27, 0x38, 0x23, 0x0e, 0x0e, 0xe0, 0x94, 0x49,
+	0x29, 0x24, 0x71, 0x6f, 0x7f, 0xbb, 0x70, 0x7e, 0x58, 0xc3, 0x6e, 0x60, 0x91, 0x00, 0x80, 0x8b,
+	0x84, 0xe5, 0x73, 0x45, 0xa5, 0x22, 0x27, 0x78, 0x0e, 0x43, 0xab, 0x05, 0x9f, 0xa5, 0x96, 0xf7,
+	0x61, 0xd0, 0x19, 0x44, 0x46, 0x5c, 0x44, 0x08, 0x68, 0x1c, 0x8b, 0x05, 0x57, 0xf9, 0x22, 0x4b,
+	0xa8, 0x62, 0x04, 0xf0, 0x2d, 0xf8, 0xea, 0x8b, 0x64, 0x34, 0xe9, 0x4b, 0x43, 0x24, 0x30, 0xe2,
+	0x42, 0xa5, 0xd3, 0x34, 0xa6, 0x2a, 0x15, 0x9c, 0x8c, 0xda, 0xe0, 0xce, 0xd4, 0x3e, 0xc4, 0xff,
+	0x87, 0x52, 0xcb, 0x2c, 0xe5, 0xf7, 0x24, 0x68, 0xc3, 0x1f, 0x17, 0x4c, 0x2e, 0x73, 0xc9, 0xe6,
+	0x99, 0xe0, 0x73, 0x46, 0xde, 0xe1, 0x15, 0x5c, 0xc6, 0x74, 0xca, 0xf2, 0xf9, 0x92, 0xc7, 0xf9,
+	0xbd, 0x14, 0x8b, 0xac, 0x9f, 0xf2, 0x1e, 0xaf, 0x21, 0x3c, 0xee, 0xc5, 0xe2, 0x21, 0x9b, 0x31,
+	0xc5, 0xc8, 0x87, 0xff, 0x91, 0x53, 0x9a, 0xce, 0x58, 0x42, 0xc6, 0x9f, 0x2f, 0xc0, 0x5f, 0x57,
+	0x91, 0xd1, 0xbf, 0x8c, 0xbd, 0x9b, 0xd5, 0x37, 0x77, 0xbb, 0x5a, 0x9d, 0xd9, 0x1b, 0xf9, 0xf4,
+	0x77, 0x00, 0x6c, 0x2e, 0xbf, 0x22, 0x4f, 0x02, 0x00, 0x00,
 }
@@ -51,7 +51,7 @@ func (x Thread_Type) String() string {
 }
 
 func (Thread_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{8, 0}
 }
 
 // Sharing controls if (Y/N) a thread can be shared
@@ -80,7 +80,7 @@ func (x Thread_Sharing) String() string {
 }
 
 func (Thread_Sharing) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{8, 1}
 }
 
 // State indicates the loading state
@@ -109,23 +109,28 @@ func (x Thread_State) String() string {
 }
 
 func (Thread_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 2}
+	return fileDescriptor_4c16552f9fdb66d8, []int{8, 2}
 }
 
 type Block_BlockType int32
 
 const (
-	Block_MERGE    Block_BlockType = 0 // Deprecated: Do not use.
-	Block_IGNORE   Block_BlockType = 1
-	Block_FLAG     Block_BlockType = 2
-	Block_JOIN     Block_BlockType = 3
-	Block_ANNOUNCE Block_BlockType = 4
-	Block_LEAVE    Block_BlockType = 5
-	Block_TEXT     Block_BlockType = 6
-	Block_FILES    Block_BlockType = 7
-	Block_COMMENT  Block_BlockType = 8 // Deprecated: Do not use.
-	Block_LIKE     Block_BlockType = 9
-	Block_ADD      Block_BlockType = 50
+	Block_MERGE     Block_BlockType = 0 // Deprecated: Do not use.
+	Block_IGNORE    Block_BlockType = 1
+	Block_FLAG      Block_BlockType = 2
+	Block_JOIN      Block_BlockType = 3
+	Block_ANNOUNCE  Block_BlockType = 4
+	Block_LEAVE     Block_BlockType = 5
+	Block_TEXT      Block_BlockType = 6
+	Block_FILES     Block_BlockType = 7
+	Block_COMMENT   Block_BlockType = 8 // Deprecated: Do not use.
+	Block_LIKE      Block_BlockType = 9
+	Block_BLOCKLIST Block_BlockType = 10
+	Block_READ      Block_BlockType = 11
+	Block_REACTION  Block_BlockType = 12
+	Block_UNREACT   Block_BlockType = 13
+	Block_SCHEMA    Block_BlockType = 14
+	Block_ADD       Block_BlockType = 50
 )
 
 var Block_BlockType_name = map[int32]string{
@@ -139,21 +144,31 @@ var Block_BlockType_name = map[int32]string{
 	7:  "FILES",
 	8:  "COMMENT",
 	9:  "LIKE",
+	10: "BLOCKLIST",
+	11: "READ",
+	12: "REACTION",
+	13: "UNREACT",
+	14: "SCHEMA",
 	50: "ADD",
 }
 
 var Block_BlockType_value = map[string]int32{
-	"MERGE":    0,
-	"IGNORE":   1,
-	"FLAG":     2,
-	"JOIN":     3,
-	"ANNOUNCE": 4,
-	"LEAVE":    5,
-	"TEXT":     6,
-	"FILES":    7,
-	"COMMENT":  8,
-	"LIKE":     9,
-	"ADD":      50,
+	"MERGE":     0,
+	"IGNORE":    1,
+	"FLAG":      2,
+	"JOIN":      3,
+	"ANNOUNCE":  4,
+	"LEAVE":     5,
+	"TEXT":      6,
+	"FILES":     7,
+	"COMMENT":   8,
+	"LIKE":      9,
+	"BLOCKLIST": 10,
+	"READ":      11,
+	"REACTION":  12,
+	"UNREACT":   13,
+	"SCHEMA":    14,
+	"ADD":       50,
 }
 
 func (x Block_BlockType) String() string {
@@ -161,7 +176,7 @@ func (x Block_BlockType) String() string {
 }
 
 func (Block_BlockType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{14, 0}
 }
 
 type Block_BlockStatus int32
@@ -189,7 +204,47 @@ func (x Block_BlockStatus) String() string {
 }
 
 func (Block_BlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{14, 1}
+}
+
+type MillCondition_Op int32
+
+const (
+	MillCondition_EQ     MillCondition_Op = 0
+	MillCondition_NE     MillCondition_Op = 1
+	MillCondition_GT     MillCondition_Op = 2
+	MillCondition_GTE    MillCondition_Op = 3
+	MillCondition_LT     MillCondition_Op = 4
+	MillCondition_LTE    MillCondition_Op = 5
+	MillCondition_EXISTS MillCondition_Op = 6
+)
+
+var MillCondition_Op_name = map[int32]string{
+	0: "EQ",
+	1: "NE",
+	2: "GT",
+	3: "GTE",
+	4: "LT",
+	5: "LTE",
+	6: "EXISTS",
+}
+
+var MillCondition_Op_value = map[string]int32{
+	"EQ":     0,
+	"NE":     1,
+	"GT":     2,
+	"GTE":    3,
+	"LT":     4,
+	"LTE":    5,
+	"EXISTS": 6,
+}
+
+func (x MillCondition_Op) String() string {
+	return proto.EnumName(MillCondition_Op_name, int32(x))
+}
+
+func (MillCondition_Op) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{25, 0}
 }
 
 type Notification_Type int32
@@ -204,18 +259,24 @@ const (
 	Notification_FILES_ADDED         Notification_Type = 5
 	Notification_COMMENT_ADDED       Notification_Type = 6
 	Notification_LIKE_ADDED          Notification_Type = 7
+	Notification_CONTACT_CHANGED     Notification_Type = 9
+	Notification_REACTION_ADDED      Notification_Type = 10
+	Notification_QUEUE_ITEM_FAILED   Notification_Type = 11
 )
 
 var Notification_Type_name = map[int32]string{
-	0: "INVITE_RECEIVED",
-	1: "ACCOUNT_PEER_JOINED",
-	8: "ACCOUNT_PEER_LEFT",
-	2: "PEER_JOINED",
-	3: "PEER_LEFT",
-	4: "MESSAGE_ADDED",
-	5: "FILES_ADDED",
-	6: "COMMENT_ADDED",
-	7: "LIKE_ADDED",
+	0:  "INVITE_RECEIVED",
+	1:  "ACCOUNT_PEER_JOINED",
+	8:  "ACCOUNT_PEER_LEFT",
+	2:  "PEER_JOINED",
+	3:  "PEER_LEFT",
+	4:  "MESSAGE_ADDED",
+	5:  "FILES_ADDED",
+	6:  "COMMENT_ADDED",
+	7:  "LIKE_ADDED",
+	9:  "CONTACT_CHANGED",
+	10: "REACTION_ADDED",
+	11: "QUEUE_ITEM_FAILED",
 }
 
 var Notification_Type_value = map[string]int32{
@@ -228,6 +289,9 @@ var Notification_Type_value = map[string]int32{
 	"FILES_ADDED":         5,
 	"COMMENT_ADDED":       6,
 	"LIKE_ADDED":          7,
+	"CONTACT_CHANGED":     9,
+	"REACTION_ADDED":      10,
+	"QUEUE_ITEM_FAILED":   11,
 }
 
 func (x Notification_Type) String() string {
@@ -235,7 +299,7 @@ func (x Notification_Type) String() string {
 }
 
 func (Notification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{16, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{28, 0}
 }
 
 type CafeRequest_Type int32
@@ -269,7 +333,7 @@ func (x CafeRequest_Type) String() string {
 }
 
 func (CafeRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{33, 0}
 }
 
 type CafeRequest_Status int32
@@ -278,18 +342,21 @@ const (
 	CafeRequest_NEW      CafeRequest_Status = 0
 	CafeRequest_PENDING  CafeRequest_Status = 1
 	CafeRequest_COMPLETE CafeRequest_Status = 2
+	CafeRequest_FAILED   CafeRequest_Status = 3
 )
 
 var CafeRequest_Status_name = map[int32]string{
 	0: "NEW",
 	1: "PENDING",
 	2: "COMPLETE",
+	3: "FAILED",
 }
 
 var CafeRequest_Status_value = map[string]int32{
 	"NEW":      0,
 	"PENDING":  1,
 	"COMPLETE": 2,
+	"FAILED":   3,
 }
 
 func (x CafeRequest_Status) String() string {
@@ -297,7 +364,7 @@ func (x CafeRequest_Status) String() string {
 }
 
 func (CafeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{33, 1}
 }
 
 type CafeHTTPRequest_Type int32
@@ -325,7 +392,7 @@ func (x CafeHTTPRequest_Type) String() string {
 }
 
 func (CafeHTTPRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{24, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{36, 0}
 }
 
 type Peer struct {
@@ -336,6 +403,7 @@ type Peer struct {
 	Inboxes              []*Cafe              `protobuf:"bytes,5,rep,name=inboxes,proto3" json:"inboxes,omitempty"`
 	Created              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
 	Updated              *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	Profile              string               `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -415,6 +483,13 @@ func (m *Peer) GetUpdated() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Peer) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
 type PeerList struct {
 	Items                []*Peer  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -454,6 +529,140 @@ func (m *PeerList) GetItems() []*Peer {
 	return nil
 }
 
+type Profile struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar               string               `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Threads              []*ProfileThread     `protobuf:"bytes,5,rep,name=threads,proto3" json:"threads,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{2}
+}
+
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (m *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(m, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Profile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Profile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Profile) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *Profile) GetThreads() []*ProfileThread {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *Profile) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type ProfileThread struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Heads                []string `protobuf:"bytes,3,rep,name=heads,proto3" json:"heads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileThread) Reset()         { *m = ProfileThread{} }
+func (m *ProfileThread) String() string { return proto.CompactTextString(m) }
+func (*ProfileThread) ProtoMessage()    {}
+func (*ProfileThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{3}
+}
+
+func (m *ProfileThread) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileThread.Unmarshal(m, b)
+}
+func (m *ProfileThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileThread.Marshal(b, m, deterministic)
+}
+func (m *ProfileThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileThread.Merge(m, src)
+}
+func (m *ProfileThread) XXX_Size() int {
+	return xxx_messageInfo_ProfileThread.Size(m)
+}
+func (m *ProfileThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileThread.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileThread proto.InternalMessageInfo
+
+func (m *ProfileThread) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProfileThread) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileThread) GetHeads() []string {
+	if m != nil {
+		return m.Heads
+	}
+	return nil
+}
+
 type User struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -467,7 +676,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{2}
+	return fileDescriptor_4c16552f9fdb66d8, []int{4}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -510,11 +719,14 @@ func (m *User) GetAvatar() string {
 }
 
 type Contact struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Avatar               string   `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Peers                []*Peer  `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
-	Threads              []string `protobuf:"bytes,5,rep,name=threads,proto3" json:"threads,omitempty"`
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar  string   `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Peers   []*Peer  `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	Threads []string `protobuf:"bytes,5,rep,name=threads,proto3" json:"threads,omitempty"`
+	Sig     []byte   `protobuf:"bytes,6,opt,name=sig,proto3" json:"sig,omitempty"`
+	// view info
+	Verified             bool     `protobuf:"varint,101,opt,name=verified,proto3" json:"verified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -524,7 +736,7 @@ func (m *Contact) Reset()         { *m = Contact{} }
 func (m *Contact) String() string { return proto.CompactTextString(m) }
 func (*Contact) ProtoMessage()    {}
 func (*Contact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{3}
+	return fileDescriptor_4c16552f9fdb66d8, []int{5}
 }
 
 func (m *Contact) XXX_Unmarshal(b []byte) error {
@@ -580,6 +792,20 @@ func (m *Contact) GetThreads() []string {
 	return nil
 }
 
+func (m *Contact) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+func (m *Contact) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 type ContactList struct {
 	Items                []*Contact `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -591,7 +817,7 @@ func (m *ContactList) Reset()         { *m = ContactList{} }
 func (m *ContactList) String() string { return proto.CompactTextString(m) }
 func (*ContactList) ProtoMessage()    {}
 func (*ContactList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{4}
+	return fileDescriptor_4c16552f9fdb66d8, []int{6}
 }
 
 func (m *ContactList) XXX_Unmarshal(b []byte) error {
@@ -619,6 +845,61 @@ func (m *ContactList) GetItems() []*Contact {
 	return nil
 }
 
+type ContactVerification struct {
+	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Peers                []string             `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ContactVerification) Reset()         { *m = ContactVerification{} }
+func (m *ContactVerification) String() string { return proto.CompactTextString(m) }
+func (*ContactVerification) ProtoMessage()    {}
+func (*ContactVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{7}
+}
+
+func (m *ContactVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactVerification.Unmarshal(m, b)
+}
+func (m *ContactVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactVerification.Marshal(b, m, deterministic)
+}
+func (m *ContactVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactVerification.Merge(m, src)
+}
+func (m *ContactVerification) XXX_Size() int {
+	return xxx_messageInfo_ContactVerification.Size(m)
+}
+func (m *ContactVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactVerification proto.InternalMessageInfo
+
+func (m *ContactVerification) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContactVerification) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *ContactVerification) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 type Thread struct {
 	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Key       string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -636,6 +917,7 @@ type Thread struct {
 	SchemaNode           *Node    `protobuf:"bytes,102,opt,name=schema_node,json=schemaNode,proto3" json:"schema_node,omitempty"`
 	BlockCount           int32    `protobuf:"varint,103,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
 	PeerCount            int32    `protobuf:"varint,104,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	UnreadCount          int32    `protobuf:"varint,105,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -645,7 +927,7 @@ func (m *Thread) Reset()         { *m = Thread{} }
 func (m *Thread) String() string { return proto.CompactTextString(m) }
 func (*Thread) ProtoMessage()    {}
 func (*Thread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5}
+	return fileDescriptor_4c16552f9fdb66d8, []int{8}
 }
 
 func (m *Thread) XXX_Unmarshal(b []byte) error {
@@ -772,6 +1054,13 @@ func (m *Thread) GetPeerCount() int32 {
 	return 0
 }
 
+func (m *Thread) GetUnreadCount() int32 {
+	if m != nil {
+		return m.UnreadCount
+	}
+	return 0
+}
+
 type ThreadList struct {
 	Items                []*Thread `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -783,7 +1072,7 @@ func (m *ThreadList) Reset()         { *m = ThreadList{} }
 func (m *ThreadList) String() string { return proto.CompactTextString(m) }
 func (*ThreadList) ProtoMessage()    {}
 func (*ThreadList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{6}
+	return fileDescriptor_4c16552f9fdb66d8, []int{9}
 }
 
 func (m *ThreadList) XXX_Unmarshal(b []byte) error {
@@ -824,7 +1113,7 @@ func (m *ThreadPeer) Reset()         { *m = ThreadPeer{} }
 func (m *ThreadPeer) String() string { return proto.CompactTextString(m) }
 func (*ThreadPeer) ProtoMessage()    {}
 func (*ThreadPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{7}
+	return fileDescriptor_4c16552f9fdb66d8, []int{10}
 }
 
 func (m *ThreadPeer) XXX_Unmarshal(b []byte) error {
@@ -866,18 +1155,11 @@ func (m *ThreadPeer) GetWelcomed() bool {
 	return false
 }
 
-type Block struct {
-	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Thread   string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	Author   string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Type     Block_BlockType      `protobuf:"varint,4,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
-	Date     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
-	Parents  []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
-	Target   string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
-	Data     string               `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
-	Body     string               `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
-	Status   Block_BlockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
-	Attempts int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+type ThreadRead struct {
+	Thread string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Peer   string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Block  string               `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Date   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	// view info
 	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -885,103 +1167,293 @@ type Block struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8}
+func (m *ThreadRead) Reset()         { *m = ThreadRead{} }
+func (m *ThreadRead) String() string { return proto.CompactTextString(m) }
+func (*ThreadRead) ProtoMessage()    {}
+func (*ThreadRead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{11}
 }
 
-func (m *Block) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block.Unmarshal(m, b)
+func (m *ThreadRead) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRead.Unmarshal(m, b)
 }
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+func (m *ThreadRead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRead.Marshal(b, m, deterministic)
 }
-func (m *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(m, src)
+func (m *ThreadRead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRead.Merge(m, src)
 }
-func (m *Block) XXX_Size() int {
-	return xxx_messageInfo_Block.Size(m)
+func (m *ThreadRead) XXX_Size() int {
+	return xxx_messageInfo_ThreadRead.Size(m)
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+func (m *ThreadRead) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRead.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
-
-func (m *Block) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_ThreadRead proto.InternalMessageInfo
 
-func (m *Block) GetThread() string {
+func (m *ThreadRead) GetThread() string {
 	if m != nil {
 		return m.Thread
 	}
 	return ""
 }
 
-func (m *Block) GetAuthor() string {
+func (m *ThreadRead) GetPeer() string {
 	if m != nil {
-		return m.Author
+		return m.Peer
 	}
 	return ""
 }
 
-func (m *Block) GetType() Block_BlockType {
+func (m *ThreadRead) GetBlock() string {
 	if m != nil {
-		return m.Type
+		return m.Block
 	}
-	return Block_MERGE
+	return ""
 }
 
-func (m *Block) GetDate() *timestamp.Timestamp {
+func (m *ThreadRead) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
 	return nil
 }
 
-func (m *Block) GetParents() []string {
+func (m *ThreadRead) GetUser() *User {
 	if m != nil {
-		return m.Parents
+		return m.User
 	}
 	return nil
 }
 
-func (m *Block) GetTarget() string {
-	if m != nil {
-		return m.Target
-	}
-	return ""
+type ThreadReadList struct {
+	Items                []*ThreadRead `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Block) GetData() string {
-	if m != nil {
-		return m.Data
-	}
-	return ""
+func (m *ThreadReadList) Reset()         { *m = ThreadReadList{} }
+func (m *ThreadReadList) String() string { return proto.CompactTextString(m) }
+func (*ThreadReadList) ProtoMessage()    {}
+func (*ThreadReadList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{12}
 }
 
-func (m *Block) GetBody() string {
-	if m != nil {
-		return m.Body
-	}
-	return ""
+func (m *ThreadReadList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadReadList.Unmarshal(m, b)
+}
+func (m *ThreadReadList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadReadList.Marshal(b, m, deterministic)
+}
+func (m *ThreadReadList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadReadList.Merge(m, src)
+}
+func (m *ThreadReadList) XXX_Size() int {
+	return xxx_messageInfo_ThreadReadList.Size(m)
+}
+func (m *ThreadReadList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadReadList.DiscardUnknown(m)
 }
 
-func (m *Block) GetStatus() Block_BlockStatus {
+var xxx_messageInfo_ThreadReadList proto.InternalMessageInfo
+
+func (m *ThreadReadList) GetItems() []*ThreadRead {
 	if m != nil {
-		return m.Status
+		return m.Items
 	}
-	return Block_READY
+	return nil
 }
 
-func (m *Block) GetAttempts() int32 {
-	if m != nil {
+type ThreadTyping struct {
+	Thread string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Peer   string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Typing bool                 `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`
+	Date   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// view info
+	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadTyping) Reset()         { *m = ThreadTyping{} }
+func (m *ThreadTyping) String() string { return proto.CompactTextString(m) }
+func (*ThreadTyping) ProtoMessage()    {}
+func (*ThreadTyping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{13}
+}
+
+func (m *ThreadTyping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadTyping.Unmarshal(m, b)
+}
+func (m *ThreadTyping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadTyping.Marshal(b, m, deterministic)
+}
+func (m *ThreadTyping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadTyping.Merge(m, src)
+}
+func (m *ThreadTyping) XXX_Size() int {
+	return xxx_messageInfo_ThreadTyping.Size(m)
+}
+func (m *ThreadTyping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadTyping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadTyping proto.InternalMessageInfo
+
+func (m *ThreadTyping) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadTyping) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *ThreadTyping) GetTyping() bool {
+	if m != nil {
+		return m.Typing
+	}
+	return false
+}
+
+func (m *ThreadTyping) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ThreadTyping) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type Block struct {
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Thread   string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Author   string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Type     Block_BlockType      `protobuf:"varint,4,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
+	Date     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Parents  []string             `protobuf:"bytes,6,rep,name=parents,proto3" json:"parents,omitempty"`
+	Target   string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	Data     string               `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	Body     string               `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Status   Block_BlockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
+	Attempts int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// view info
+	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{14}
+}
+
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Block) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *Block) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Block) GetType() Block_BlockType {
+	if m != nil {
+		return m.Type
+	}
+	return Block_MERGE
+}
+
+func (m *Block) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Block) GetParents() []string {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *Block) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Block) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Block) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Block) GetStatus() Block_BlockStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Block_READY
+}
+
+func (m *Block) GetAttempts() int32 {
+	if m != nil {
 		return m.Attempts
 	}
 	return 0
@@ -1005,7 +1477,7 @@ func (m *BlockList) Reset()         { *m = BlockList{} }
 func (m *BlockList) String() string { return proto.CompactTextString(m) }
 func (*BlockList) ProtoMessage()    {}
 func (*BlockList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{9}
+	return fileDescriptor_4c16552f9fdb66d8, []int{15}
 }
 
 func (m *BlockList) XXX_Unmarshal(b []byte) error {
@@ -1038,6 +1510,9 @@ type BlockMessage struct {
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
 	Env                  *Envelope            `protobuf:"bytes,3,opt,name=env,proto3" json:"env,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Attempts             int32                `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Failed               bool                 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1047,7 +1522,7 @@ func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
 func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
 func (*BlockMessage) ProtoMessage()    {}
 func (*BlockMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{10}
+	return fileDescriptor_4c16552f9fdb66d8, []int{16}
 }
 
 func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
@@ -1096,6 +1571,137 @@ func (m *BlockMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *BlockMessage) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *BlockMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BlockMessage) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+type PeerHealth struct {
+	Peer                 string               `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Failures             int32                `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastError            string               `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastAttempt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	NextAttempt          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PeerHealth) Reset()         { *m = PeerHealth{} }
+func (m *PeerHealth) String() string { return proto.CompactTextString(m) }
+func (*PeerHealth) ProtoMessage()    {}
+func (*PeerHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{17}
+}
+
+func (m *PeerHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerHealth.Unmarshal(m, b)
+}
+func (m *PeerHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerHealth.Marshal(b, m, deterministic)
+}
+func (m *PeerHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerHealth.Merge(m, src)
+}
+func (m *PeerHealth) XXX_Size() int {
+	return xxx_messageInfo_PeerHealth.Size(m)
+}
+func (m *PeerHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerHealth proto.InternalMessageInfo
+
+func (m *PeerHealth) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *PeerHealth) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *PeerHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PeerHealth) GetLastAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+func (m *PeerHealth) GetNextAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextAttempt
+	}
+	return nil
+}
+
+type PeerHealthList struct {
+	Items                []*PeerHealth `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PeerHealthList) Reset()         { *m = PeerHealthList{} }
+func (m *PeerHealthList) String() string { return proto.CompactTextString(m) }
+func (*PeerHealthList) ProtoMessage()    {}
+func (*PeerHealthList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{18}
+}
+
+func (m *PeerHealthList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerHealthList.Unmarshal(m, b)
+}
+func (m *PeerHealthList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerHealthList.Marshal(b, m, deterministic)
+}
+func (m *PeerHealthList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerHealthList.Merge(m, src)
+}
+func (m *PeerHealthList) XXX_Size() int {
+	return xxx_messageInfo_PeerHealthList.Size(m)
+}
+func (m *PeerHealthList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerHealthList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerHealthList proto.InternalMessageInfo
+
+func (m *PeerHealthList) GetItems() []*PeerHealth {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type Invite struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Block                []byte               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -1112,7 +1718,7 @@ func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{11}
+	return fileDescriptor_4c16552f9fdb66d8, []int{19}
 }
 
 func (m *Invite) XXX_Unmarshal(b []byte) error {
@@ -1186,7 +1792,7 @@ func (m *InviteList) Reset()         { *m = InviteList{} }
 func (m *InviteList) String() string { return proto.CompactTextString(m) }
 func (*InviteList) ProtoMessage()    {}
 func (*InviteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{12}
+	return fileDescriptor_4c16552f9fdb66d8, []int{20}
 }
 
 func (m *InviteList) XXX_Unmarshal(b []byte) error {
@@ -1236,7 +1842,7 @@ func (m *FileIndex) Reset()         { *m = FileIndex{} }
 func (m *FileIndex) String() string { return proto.CompactTextString(m) }
 func (*FileIndex) ProtoMessage()    {}
 func (*FileIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{13}
+	return fileDescriptor_4c16552f9fdb66d8, []int{21}
 }
 
 func (m *FileIndex) XXX_Unmarshal(b []byte) error {
@@ -1349,6 +1955,7 @@ type Node struct {
 	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
 	Links                map[string]*Link  `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Pipeline             []*MillStep       `protobuf:"bytes,9,rep,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1358,7 +1965,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{14}
+	return fileDescriptor_4c16552f9fdb66d8, []int{22}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -1428,13 +2035,21 @@ func (m *Node) GetLinks() map[string]*Link {
 	return nil
 }
 
-type Link struct {
-	Use                  string            `protobuf:"bytes,1,opt,name=use,proto3" json:"use,omitempty"`
-	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
-	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+func (m *Node) GetPipeline() []*MillStep {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+type Link struct {
+	Use                  string            `protobuf:"bytes,1,opt,name=use,proto3" json:"use,omitempty"`
+	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
 	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
 	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Pipeline             []*MillStep       `protobuf:"bytes,7,rep,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1444,7 +2059,7 @@ func (m *Link) Reset()         { *m = Link{} }
 func (m *Link) String() string { return proto.CompactTextString(m) }
 func (*Link) ProtoMessage()    {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{15}
+	return fileDescriptor_4c16552f9fdb66d8, []int{23}
 }
 
 func (m *Link) XXX_Unmarshal(b []byte) error {
@@ -1507,6 +2122,217 @@ func (m *Link) GetJsonSchema() *_struct.Struct {
 	return nil
 }
 
+func (m *Link) GetPipeline() []*MillStep {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+type MillStep struct {
+	Mill                 string          `protobuf:"bytes,1,opt,name=mill,proto3" json:"mill,omitempty"`
+	Opts                 *_struct.Struct `protobuf:"bytes,2,opt,name=opts,proto3" json:"opts,omitempty"`
+	When                 *MillCondition  `protobuf:"bytes,3,opt,name=when,proto3" json:"when,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MillStep) Reset()         { *m = MillStep{} }
+func (m *MillStep) String() string { return proto.CompactTextString(m) }
+func (*MillStep) ProtoMessage()    {}
+func (*MillStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{24}
+}
+
+func (m *MillStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MillStep.Unmarshal(m, b)
+}
+func (m *MillStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MillStep.Marshal(b, m, deterministic)
+}
+func (m *MillStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MillStep.Merge(m, src)
+}
+func (m *MillStep) XXX_Size() int {
+	return xxx_messageInfo_MillStep.Size(m)
+}
+func (m *MillStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_MillStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MillStep proto.InternalMessageInfo
+
+func (m *MillStep) GetMill() string {
+	if m != nil {
+		return m.Mill
+	}
+	return ""
+}
+
+func (m *MillStep) GetOpts() *_struct.Struct {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *MillStep) GetWhen() *MillCondition {
+	if m != nil {
+		return m.When
+	}
+	return nil
+}
+
+type MillCondition struct {
+	Link                 string           `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Key                  string           `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Op                   MillCondition_Op `protobuf:"varint,3,opt,name=op,proto3,enum=MillCondition_Op" json:"op,omitempty"`
+	Value                *_struct.Value   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MillCondition) Reset()         { *m = MillCondition{} }
+func (m *MillCondition) String() string { return proto.CompactTextString(m) }
+func (*MillCondition) ProtoMessage()    {}
+func (*MillCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{25}
+}
+
+func (m *MillCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MillCondition.Unmarshal(m, b)
+}
+func (m *MillCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MillCondition.Marshal(b, m, deterministic)
+}
+func (m *MillCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MillCondition.Merge(m, src)
+}
+func (m *MillCondition) XXX_Size() int {
+	return xxx_messageInfo_MillCondition.Size(m)
+}
+func (m *MillCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MillCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MillCondition proto.InternalMessageInfo
+
+func (m *MillCondition) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *MillCondition) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MillCondition) GetOp() MillCondition_Op {
+	if m != nil {
+		return m.Op
+	}
+	return MillCondition_EQ
+}
+
+func (m *MillCondition) GetValue() *_struct.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type BlockedAccount struct {
+	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlockedAccount) Reset()         { *m = BlockedAccount{} }
+func (m *BlockedAccount) String() string { return proto.CompactTextString(m) }
+func (*BlockedAccount) ProtoMessage()    {}
+func (*BlockedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{26}
+}
+
+func (m *BlockedAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockedAccount.Unmarshal(m, b)
+}
+func (m *BlockedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockedAccount.Marshal(b, m, deterministic)
+}
+func (m *BlockedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAccount.Merge(m, src)
+}
+func (m *BlockedAccount) XXX_Size() int {
+	return xxx_messageInfo_BlockedAccount.Size(m)
+}
+func (m *BlockedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAccount proto.InternalMessageInfo
+
+func (m *BlockedAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedAccount) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type BlockedAccountList struct {
+	Items                []*BlockedAccount `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BlockedAccountList) Reset()         { *m = BlockedAccountList{} }
+func (m *BlockedAccountList) String() string { return proto.CompactTextString(m) }
+func (*BlockedAccountList) ProtoMessage()    {}
+func (*BlockedAccountList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{27}
+}
+
+func (m *BlockedAccountList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockedAccountList.Unmarshal(m, b)
+}
+func (m *BlockedAccountList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockedAccountList.Marshal(b, m, deterministic)
+}
+func (m *BlockedAccountList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAccountList.Merge(m, src)
+}
+func (m *BlockedAccountList) XXX_Size() int {
+	return xxx_messageInfo_BlockedAccountList.Size(m)
+}
+func (m *BlockedAccountList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAccountList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAccountList proto.InternalMessageInfo
+
+func (m *BlockedAccountList) GetItems() []*BlockedAccount {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type Notification struct {
 	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Date        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -1529,7 +2355,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{16}
+	return fileDescriptor_4c16552f9fdb66d8, []int{28}
 }
 
 func (m *Notification) XXX_Unmarshal(b []byte) error {
@@ -1638,7 +2464,7 @@ func (m *NotificationList) Reset()         { *m = NotificationList{} }
 func (m *NotificationList) String() string { return proto.CompactTextString(m) }
 func (*NotificationList) ProtoMessage()    {}
 func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{17}
+	return fileDescriptor_4c16552f9fdb66d8, []int{29}
 }
 
 func (m *NotificationList) XXX_Unmarshal(b []byte) error {
@@ -1673,6 +2499,7 @@ type Cafe struct {
 	Protocol             string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	Node                 string   `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
 	Url                  string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Neighbors            []string `protobuf:"bytes,7,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1682,7 +2509,7 @@ func (m *Cafe) Reset()         { *m = Cafe{} }
 func (m *Cafe) String() string { return proto.CompactTextString(m) }
 func (*Cafe) ProtoMessage()    {}
 func (*Cafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{18}
+	return fileDescriptor_4c16552f9fdb66d8, []int{30}
 }
 
 func (m *Cafe) XXX_Unmarshal(b []byte) error {
@@ -1745,6 +2572,13 @@ func (m *Cafe) GetUrl() string {
 	return ""
 }
 
+func (m *Cafe) GetNeighbors() []string {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
 type CafeSession struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Access               string               `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
@@ -1763,7 +2597,7 @@ func (m *CafeSession) Reset()         { *m = CafeSession{} }
 func (m *CafeSession) String() string { return proto.CompactTextString(m) }
 func (*CafeSession) ProtoMessage()    {}
 func (*CafeSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{19}
+	return fileDescriptor_4c16552f9fdb66d8, []int{31}
 }
 
 func (m *CafeSession) XXX_Unmarshal(b []byte) error {
@@ -1851,7 +2685,7 @@ func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
 func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
 func (*CafeSessionList) ProtoMessage()    {}
 func (*CafeSessionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{20}
+	return fileDescriptor_4c16552f9fdb66d8, []int{32}
 }
 
 func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
@@ -1893,6 +2727,7 @@ type CafeRequest struct {
 	Attempts             int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	GroupSize            int64                `protobuf:"varint,12,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
 	GroupTransferred     int64                `protobuf:"varint,13,opt,name=group_transferred,json=groupTransferred,proto3" json:"group_transferred,omitempty"`
+	LastError            string               `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1902,7 +2737,7 @@ func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
 func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeRequest) ProtoMessage()    {}
 func (*CafeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21}
+	return fileDescriptor_4c16552f9fdb66d8, []int{33}
 }
 
 func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
@@ -2014,6 +2849,13 @@ func (m *CafeRequest) GetGroupTransferred() int64 {
 	return 0
 }
 
+func (m *CafeRequest) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 type CafeRequestList struct {
 	Items                []*CafeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -2025,7 +2867,7 @@ func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
 func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
 func (*CafeRequestList) ProtoMessage()    {}
 func (*CafeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{22}
+	return fileDescriptor_4c16552f9fdb66d8, []int{34}
 }
 
 func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
@@ -2074,7 +2916,7 @@ func (m *CafeSyncGroupStatus) Reset()         { *m = CafeSyncGroupStatus{} }
 func (m *CafeSyncGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*CafeSyncGroupStatus) ProtoMessage()    {}
 func (*CafeSyncGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{23}
+	return fileDescriptor_4c16552f9fdb66d8, []int{35}
 }
 
 func (m *CafeSyncGroupStatus) XXX_Unmarshal(b []byte) error {
@@ -2186,7 +3028,7 @@ func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
 func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeHTTPRequest) ProtoMessage()    {}
 func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{24}
+	return fileDescriptor_4c16552f9fdb66d8, []int{36}
 }
 
 func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
@@ -2240,6 +3082,8 @@ type CafeMessage struct {
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	Attempts             int32                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Failed               bool                 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2249,7 +3093,7 @@ func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
 func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeMessage) ProtoMessage()    {}
 func (*CafeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{25}
+	return fileDescriptor_4c16552f9fdb66d8, []int{37}
 }
 
 func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
@@ -2298,6 +3142,20 @@ func (m *CafeMessage) GetAttempts() int32 {
 	return 0
 }
 
+func (m *CafeMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *CafeMessage) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
 type CafeClientNonce struct {
 	Value                string               `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -2311,7 +3169,7 @@ func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
 func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeClientNonce) ProtoMessage()    {}
 func (*CafeClientNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{26}
+	return fileDescriptor_4c16552f9fdb66d8, []int{38}
 }
 
 func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
@@ -2359,6 +3217,10 @@ type CafeClient struct {
 	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
 	Seen                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
 	Token                string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Expired              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	Stored               int64                `protobuf:"varint,7,opt,name=stored,proto3" json:"stored,omitempty"`
+	StorageQuota         int64                `protobuf:"varint,8,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
+	ThreadQuota          int32                `protobuf:"varint,9,opt,name=thread_quota,json=threadQuota,proto3" json:"thread_quota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2368,7 +3230,7 @@ func (m *CafeClient) Reset()         { *m = CafeClient{} }
 func (m *CafeClient) String() string { return proto.CompactTextString(m) }
 func (*CafeClient) ProtoMessage()    {}
 func (*CafeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{27}
+	return fileDescriptor_4c16552f9fdb66d8, []int{39}
 }
 
 func (m *CafeClient) XXX_Unmarshal(b []byte) error {
@@ -2424,6 +3286,34 @@ func (m *CafeClient) GetToken() string {
 	return ""
 }
 
+func (m *CafeClient) GetExpired() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expired
+	}
+	return nil
+}
+
+func (m *CafeClient) GetStored() int64 {
+	if m != nil {
+		return m.Stored
+	}
+	return 0
+}
+
+func (m *CafeClient) GetStorageQuota() int64 {
+	if m != nil {
+		return m.StorageQuota
+	}
+	return 0
+}
+
+func (m *CafeClient) GetThreadQuota() int32 {
+	if m != nil {
+		return m.ThreadQuota
+	}
+	return 0
+}
+
 type CafeClientList struct {
 	Items                []*CafeClient `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -2435,7 +3325,7 @@ func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
 func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
 func (*CafeClientList) ProtoMessage()    {}
 func (*CafeClientList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{28}
+	return fileDescriptor_4c16552f9fdb66d8, []int{40}
 }
 
 func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
@@ -2463,10 +3353,78 @@ func (m *CafeClientList) GetItems() []*CafeClient {
 	return nil
 }
 
+type CafeClientInfo struct {
+	Client               *CafeClient          `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Threads              []string             `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+	Messages             []*CafeClientMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	MessageCount         int32                `protobuf:"varint,4,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientInfo) Reset()         { *m = CafeClientInfo{} }
+func (m *CafeClientInfo) String() string { return proto.CompactTextString(m) }
+func (*CafeClientInfo) ProtoMessage()    {}
+func (*CafeClientInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{41}
+}
+
+func (m *CafeClientInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientInfo.Unmarshal(m, b)
+}
+func (m *CafeClientInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientInfo.Marshal(b, m, deterministic)
+}
+func (m *CafeClientInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientInfo.Merge(m, src)
+}
+func (m *CafeClientInfo) XXX_Size() int {
+	return xxx_messageInfo_CafeClientInfo.Size(m)
+}
+func (m *CafeClientInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientInfo proto.InternalMessageInfo
+
+func (m *CafeClientInfo) GetClient() *CafeClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CafeClientInfo) GetThreads() []string {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *CafeClientInfo) GetMessages() []*CafeClientMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CafeClientInfo) GetMessageCount() int32 {
+	if m != nil {
+		return m.MessageCount
+	}
+	return 0
+}
+
 type CafeToken struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Label                string               `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Expiry               *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	MaxClients           int32                `protobuf:"varint,6,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	StorageQuota         int64                `protobuf:"varint,7,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
+	ThreadQuota          int32                `protobuf:"varint,8,opt,name=thread_quota,json=threadQuota,proto3" json:"thread_quota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2476,7 +3434,7 @@ func (m *CafeToken) Reset()         { *m = CafeToken{} }
 func (m *CafeToken) String() string { return proto.CompactTextString(m) }
 func (*CafeToken) ProtoMessage()    {}
 func (*CafeToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{29}
+	return fileDescriptor_4c16552f9fdb66d8, []int{42}
 }
 
 func (m *CafeToken) XXX_Unmarshal(b []byte) error {
@@ -2518,6 +3476,284 @@ func (m *CafeToken) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CafeToken) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *CafeToken) GetExpiry() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *CafeToken) GetMaxClients() int32 {
+	if m != nil {
+		return m.MaxClients
+	}
+	return 0
+}
+
+func (m *CafeToken) GetStorageQuota() int64 {
+	if m != nil {
+		return m.StorageQuota
+	}
+	return 0
+}
+
+func (m *CafeToken) GetThreadQuota() int32 {
+	if m != nil {
+		return m.ThreadQuota
+	}
+	return 0
+}
+
+type CafeTokenList struct {
+	Items                []*CafeToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CafeTokenList) Reset()         { *m = CafeTokenList{} }
+func (m *CafeTokenList) String() string { return proto.CompactTextString(m) }
+func (*CafeTokenList) ProtoMessage()    {}
+func (*CafeTokenList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{43}
+}
+
+func (m *CafeTokenList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeTokenList.Unmarshal(m, b)
+}
+func (m *CafeTokenList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeTokenList.Marshal(b, m, deterministic)
+}
+func (m *CafeTokenList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeTokenList.Merge(m, src)
+}
+func (m *CafeTokenList) XXX_Size() int {
+	return xxx_messageInfo_CafeTokenList.Size(m)
+}
+func (m *CafeTokenList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeTokenList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeTokenList proto.InternalMessageInfo
+
+func (m *CafeTokenList) GetItems() []*CafeToken {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeTokenUsage struct {
+	Token                *CafeToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Clients              int32      `protobuf:"varint,2,opt,name=clients,proto3" json:"clients,omitempty"`
+	Stored               int64      `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	Threads              int32      `protobuf:"varint,4,opt,name=threads,proto3" json:"threads,omitempty"`
+	Messages             int32      `protobuf:"varint,5,opt,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CafeTokenUsage) Reset()         { *m = CafeTokenUsage{} }
+func (m *CafeTokenUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeTokenUsage) ProtoMessage()    {}
+func (*CafeTokenUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{44}
+}
+
+func (m *CafeTokenUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeTokenUsage.Unmarshal(m, b)
+}
+func (m *CafeTokenUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeTokenUsage.Marshal(b, m, deterministic)
+}
+func (m *CafeTokenUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeTokenUsage.Merge(m, src)
+}
+func (m *CafeTokenUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeTokenUsage.Size(m)
+}
+func (m *CafeTokenUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeTokenUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeTokenUsage proto.InternalMessageInfo
+
+func (m *CafeTokenUsage) GetToken() *CafeToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *CafeTokenUsage) GetClients() int32 {
+	if m != nil {
+		return m.Clients
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetStored() int64 {
+	if m != nil {
+		return m.Stored
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetThreads() int32 {
+	if m != nil {
+		return m.Threads
+	}
+	return 0
+}
+
+func (m *CafeTokenUsage) GetMessages() int32 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+type CafeTokenUsageList struct {
+	Items                []*CafeTokenUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CafeTokenUsageList) Reset()         { *m = CafeTokenUsageList{} }
+func (m *CafeTokenUsageList) String() string { return proto.CompactTextString(m) }
+func (*CafeTokenUsageList) ProtoMessage()    {}
+func (*CafeTokenUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{45}
+}
+
+func (m *CafeTokenUsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeTokenUsageList.Unmarshal(m, b)
+}
+func (m *CafeTokenUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeTokenUsageList.Marshal(b, m, deterministic)
+}
+func (m *CafeTokenUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeTokenUsageList.Merge(m, src)
+}
+func (m *CafeTokenUsageList) XXX_Size() int {
+	return xxx_messageInfo_CafeTokenUsageList.Size(m)
+}
+func (m *CafeTokenUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeTokenUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeTokenUsageList proto.InternalMessageInfo
+
+func (m *CafeTokenUsageList) GetItems() []*CafeTokenUsage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeBan struct {
+	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason               string               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeBan) Reset()         { *m = CafeBan{} }
+func (m *CafeBan) String() string { return proto.CompactTextString(m) }
+func (*CafeBan) ProtoMessage()    {}
+func (*CafeBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{46}
+}
+
+func (m *CafeBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeBan.Unmarshal(m, b)
+}
+func (m *CafeBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeBan.Marshal(b, m, deterministic)
+}
+func (m *CafeBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeBan.Merge(m, src)
+}
+func (m *CafeBan) XXX_Size() int {
+	return xxx_messageInfo_CafeBan.Size(m)
+}
+func (m *CafeBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeBan proto.InternalMessageInfo
+
+func (m *CafeBan) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CafeBan) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CafeBan) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type CafeBanList struct {
+	Items                []*CafeBan `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CafeBanList) Reset()         { *m = CafeBanList{} }
+func (m *CafeBanList) String() string { return proto.CompactTextString(m) }
+func (*CafeBanList) ProtoMessage()    {}
+func (*CafeBanList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{47}
+}
+
+func (m *CafeBanList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeBanList.Unmarshal(m, b)
+}
+func (m *CafeBanList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeBanList.Marshal(b, m, deterministic)
+}
+func (m *CafeBanList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeBanList.Merge(m, src)
+}
+func (m *CafeBanList) XXX_Size() int {
+	return xxx_messageInfo_CafeBanList.Size(m)
+}
+func (m *CafeBanList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeBanList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeBanList proto.InternalMessageInfo
+
+func (m *CafeBanList) GetItems() []*CafeBan {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type CafeClientThread struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
@@ -2531,7 +3767,7 @@ func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
 func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
 func (*CafeClientThread) ProtoMessage()    {}
 func (*CafeClientThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{30}
+	return fileDescriptor_4c16552f9fdb66d8, []int{48}
 }
 
 func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
@@ -2587,7 +3823,7 @@ func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
 func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeClientMessage) ProtoMessage()    {}
 func (*CafeClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{31}
+	return fileDescriptor_4c16552f9fdb66d8, []int{49}
 }
 
 func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
@@ -2636,6 +3872,132 @@ func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+type CafeClientObject struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientObject) Reset()         { *m = CafeClientObject{} }
+func (m *CafeClientObject) String() string { return proto.CompactTextString(m) }
+func (*CafeClientObject) ProtoMessage()    {}
+func (*CafeClientObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{50}
+}
+
+func (m *CafeClientObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientObject.Unmarshal(m, b)
+}
+func (m *CafeClientObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientObject.Marshal(b, m, deterministic)
+}
+func (m *CafeClientObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientObject.Merge(m, src)
+}
+func (m *CafeClientObject) XXX_Size() int {
+	return xxx_messageInfo_CafeClientObject.Size(m)
+}
+func (m *CafeClientObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientObject proto.InternalMessageInfo
+
+func (m *CafeClientObject) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeClientObject) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type CafeReplica struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Neighbor             string               `protobuf:"bytes,2,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
+	Body                 []byte               `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeReplica) Reset()         { *m = CafeReplica{} }
+func (m *CafeReplica) String() string { return proto.CompactTextString(m) }
+func (*CafeReplica) ProtoMessage()    {}
+func (*CafeReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{51}
+}
+
+func (m *CafeReplica) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplica.Unmarshal(m, b)
+}
+func (m *CafeReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplica.Marshal(b, m, deterministic)
+}
+func (m *CafeReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplica.Merge(m, src)
+}
+func (m *CafeReplica) XXX_Size() int {
+	return xxx_messageInfo_CafeReplica.Size(m)
+}
+func (m *CafeReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplica proto.InternalMessageInfo
+
+func (m *CafeReplica) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeReplica) GetNeighbor() string {
+	if m != nil {
+		return m.Neighbor
+	}
+	return ""
+}
+
+func (m *CafeReplica) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *CafeReplica) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 // Bots KV Store //
 type BotKV struct {
 	Key                  string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -2651,7 +4013,7 @@ func (m *BotKV) Reset()         { *m = BotKV{} }
 func (m *BotKV) String() string { return proto.CompactTextString(m) }
 func (*BotKV) ProtoMessage()    {}
 func (*BotKV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{32}
+	return fileDescriptor_4c16552f9fdb66d8, []int{52}
 }
 
 func (m *BotKV) XXX_Unmarshal(b []byte) error {
@@ -2706,21 +4068,30 @@ func init() {
 	proto.RegisterEnum("Thread_State", Thread_State_name, Thread_State_value)
 	proto.RegisterEnum("Block_BlockType", Block_BlockType_name, Block_BlockType_value)
 	proto.RegisterEnum("Block_BlockStatus", Block_BlockStatus_name, Block_BlockStatus_value)
+	proto.RegisterEnum("MillCondition_Op", MillCondition_Op_name, MillCondition_Op_value)
 	proto.RegisterEnum("Notification_Type", Notification_Type_name, Notification_Type_value)
 	proto.RegisterEnum("CafeRequest_Type", CafeRequest_Type_name, CafeRequest_Type_value)
 	proto.RegisterEnum("CafeRequest_Status", CafeRequest_Status_name, CafeRequest_Status_value)
 	proto.RegisterEnum("CafeHTTPRequest_Type", CafeHTTPRequest_Type_name, CafeHTTPRequest_Type_value)
 	proto.RegisterType((*Peer)(nil), "Peer")
 	proto.RegisterType((*PeerList)(nil), "PeerList")
+	proto.RegisterType((*Profile)(nil), "Profile")
+	proto.RegisterType((*ProfileThread)(nil), "ProfileThread")
 	proto.RegisterType((*User)(nil), "User")
 	proto.RegisterType((*Contact)(nil), "Contact")
 	proto.RegisterType((*ContactList)(nil), "ContactList")
+	proto.RegisterType((*ContactVerification)(nil), "ContactVerification")
 	proto.RegisterType((*Thread)(nil), "Thread")
 	proto.RegisterType((*ThreadList)(nil), "ThreadList")
 	proto.RegisterType((*ThreadPeer)(nil), "ThreadPeer")
+	proto.RegisterType((*ThreadRead)(nil), "ThreadRead")
+	proto.RegisterType((*ThreadReadList)(nil), "ThreadReadList")
+	proto.RegisterType((*ThreadTyping)(nil), "ThreadTyping")
 	proto.RegisterType((*Block)(nil), "Block")
 	proto.RegisterType((*BlockList)(nil), "BlockList")
 	proto.RegisterType((*BlockMessage)(nil), "BlockMessage")
+	proto.RegisterType((*PeerHealth)(nil), "PeerHealth")
+	proto.RegisterType((*PeerHealthList)(nil), "PeerHealthList")
 	proto.RegisterType((*Invite)(nil), "Invite")
 	proto.RegisterType((*InviteList)(nil), "InviteList")
 	proto.RegisterType((*FileIndex)(nil), "FileIndex")
@@ -2729,6 +4100,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "Node.OptsEntry")
 	proto.RegisterType((*Link)(nil), "Link")
 	proto.RegisterMapType((map[string]string)(nil), "Link.OptsEntry")
+	proto.RegisterType((*MillStep)(nil), "MillStep")
+	proto.RegisterType((*MillCondition)(nil), "MillCondition")
+	proto.RegisterType((*BlockedAccount)(nil), "BlockedAccount")
+	proto.RegisterType((*BlockedAccountList)(nil), "BlockedAccountList")
 	proto.RegisterType((*Notification)(nil), "Notification")
 	proto.RegisterType((*NotificationList)(nil), "NotificationList")
 	proto.RegisterType((*Cafe)(nil), "Cafe")
@@ -2743,163 +4118,227 @@ func init() {
 	proto.RegisterType((*CafeClientNonce)(nil), "CafeClientNonce")
 	proto.RegisterType((*CafeClient)(nil), "CafeClient")
 	proto.RegisterType((*CafeClientList)(nil), "CafeClientList")
+	proto.RegisterType((*CafeClientInfo)(nil), "CafeClientInfo")
 	proto.RegisterType((*CafeToken)(nil), "CafeToken")
+	proto.RegisterType((*CafeTokenList)(nil), "CafeTokenList")
+	proto.RegisterType((*CafeTokenUsage)(nil), "CafeTokenUsage")
+	proto.RegisterType((*CafeTokenUsageList)(nil), "CafeTokenUsageList")
+	proto.RegisterType((*CafeBan)(nil), "CafeBan")
+	proto.RegisterType((*CafeBanList)(nil), "CafeBanList")
 	proto.RegisterType((*CafeClientThread)(nil), "CafeClientThread")
 	proto.RegisterType((*CafeClientMessage)(nil), "CafeClientMessage")
+	proto.RegisterType((*CafeClientObject)(nil), "CafeClientObject")
+	proto.RegisterType((*CafeReplica)(nil), "CafeReplica")
 	proto.RegisterType((*BotKV)(nil), "BotKV")
 }
 
 func init() { proto.RegisterFile("model.proto", fileDescriptor_4c16552f9fdb66d8) }
 
 var fileDescriptor_4c16552f9fdb66d8 = []byte{
-	// 2375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x48, 0x80, 0x7f, 0x1e, 0x29, 0x0b, 0x5e, 0x3b, 0x0e, 0x2c, 0xdb, 0xb1, 0x03, 0x37,
-	0xfe, 0x13, 0xa7, 0x4c, 0x2a, 0xb7, 0xb5, 0x27, 0x97, 0x0e, 0x45, 0xc1, 0x32, 0x1b, 0x8a, 0x54,
-	0x41, 0xca, 0x4d, 0x72, 0xe1, 0x40, 0xc0, 0x4a, 0x44, 0x44, 0x02, 0x0c, 0x00, 0x3a, 0x56, 0x66,
-	0x3a, 0xb9, 0x75, 0x7a, 0xe8, 0x07, 0xe8, 0x4c, 0xfb, 0x15, 0x7a, 0xe9, 0x67, 0xe8, 0xf7, 0xe8,
-	0xb5, 0x3d, 0xf5, 0xd2, 0xe9, 0xa9, 0xd3, 0xe9, 0xbc, 0xb7, 0xbb, 0x24, 0x68, 0xc9, 0x8a, 0x94,
-	0x71, 0x2f, 0xd2, 0xbe, 0x3f, 0xbb, 0xef, 0xed, 0xdb, 0xdf, 0xfb, 0x03, 0x42, 0x6d, 0x12, 0x07,
-	0x7c, 0xdc, 0x98, 0x26, 0x71, 0x16, 0xaf, 0xdf, 0x3e, 0x8c, 0xe3, 0xc3, 0x31, 0xff, 0x98, 0xa8,
-	0xfd, 0xd9, 0xc1, 0xc7, 0x59, 0x38, 0xe1, 0x69, 0xe6, 0x4d, 0xa6, 0x52, 0xe1, 0xe6, 0xeb, 0x0a,
-	0x69, 0x96, 0xcc, 0xfc, 0x4c, 0x4a, 0x57, 0x27, 0x3c, 0x4d, 0xbd, 0x43, 0x2e, 0x48, 0xfb, 0x1f,
-	0x1a, 0xe8, 0xbb, 0x9c, 0x27, 0xec, 0x12, 0x14, 0xc2, 0xc0, 0xd2, 0xee, 0x68, 0x0f, 0xaa, 0x6e,
-	0x21, 0x0c, 0x98, 0x05, 0x65, 0x2f, 0x08, 0x12, 0x9e, 0xa6, 0x56, 0x81, 0x98, 0x8a, 0x64, 0x0c,
-	0xf4, 0xc8, 0x9b, 0x70, 0xab, 0x48, 0x6c, 0x5a, 0xb3, 0x6b, 0x50, 0xf2, 0x5e, 0x7a, 0x99, 0x97,
-	0x58, 0x3a, 0x71, 0x25, 0xc5, 0x6e, 0x43, 0x39, 0x8c, 0xf6, 0xe3, 0x57, 0x3c, 0xb5, 0x8c, 0x3b,
-	0xc5, 0x07, 0xb5, 0x0d, 0xa3, 0xd1, 0xf2, 0x0e, 0xb8, 0xab, 0xb8, 0xec, 0xa7, 0x50, 0xf6, 0x13,
-	0xee, 0x65, 0x3c, 0xb0, 0x4a, 0x77, 0xb4, 0x07, 0xb5, 0x8d, 0xf5, 0x86, 0x70, 0xbf, 0xa1, 0xdc,
-	0x6f, 0x0c, 0xd4, 0xfd, 0x5c, 0xa5, 0x8a, 0xbb, 0x66, 0xd3, 0x80, 0x76, 0x95, 0xbf, 0x7f, 0x97,
-	0x54, 0xb5, 0xef, 0x43, 0x05, 0xaf, 0xda, 0x09, 0xd3, 0x8c, 0xdd, 0x00, 0x23, 0xcc, 0xf8, 0x24,
-	0xb5, 0x34, 0xe9, 0x16, 0x4a, 0x5c, 0xc1, 0xb3, 0x3b, 0xa0, 0xef, 0xa5, 0x3c, 0xc9, 0xc7, 0x40,
-	0x3b, 0x3d, 0x06, 0x85, 0x53, 0x63, 0x50, 0xcc, 0xc7, 0xc0, 0xfe, 0xad, 0x06, 0xe5, 0x56, 0x1c,
-	0x65, 0x9e, 0x9f, 0xbd, 0x9d, 0x13, 0xd1, 0xf9, 0x29, 0xe7, 0x49, 0x6a, 0xe9, 0x4b, 0xce, 0x13,
-	0x0f, 0x4d, 0x64, 0xa3, 0x84, 0x7b, 0x81, 0x08, 0x79, 0xd5, 0x55, 0xa4, 0xfd, 0x63, 0xa8, 0x49,
-	0x3f, 0x28, 0x04, 0xef, 0x2d, 0x87, 0xa0, 0xd2, 0x90, 0x42, 0x15, 0x85, 0xbf, 0xeb, 0x50, 0x1a,
-	0xd0, 0xd6, 0x13, 0xe0, 0x30, 0xa1, 0x78, 0xc4, 0x8f, 0xa5, 0xaf, 0xb8, 0x44, 0x8d, 0xf4, 0x88,
-	0xdc, 0xac, 0xbb, 0x85, 0xf4, 0x68, 0x7e, 0x1d, 0x7d, 0xf9, 0x3a, 0xa9, 0x3f, 0xe2, 0x13, 0xcf,
-	0x32, 0xc4, 0x75, 0x04, 0xc5, 0x6e, 0x42, 0x35, 0x8c, 0xc2, 0x2c, 0xf4, 0xb2, 0x38, 0x21, 0x14,
-	0x54, 0xdd, 0x05, 0x83, 0xdd, 0x01, 0x3d, 0x3b, 0x9e, 0x72, 0x7a, 0xe8, 0x4b, 0x1b, 0xf5, 0x86,
-	0x70, 0xa9, 0x31, 0x38, 0x9e, 0x72, 0x97, 0x24, 0xec, 0x21, 0x94, 0xd3, 0x91, 0x97, 0x84, 0xd1,
-	0xa1, 0x55, 0x21, 0xa5, 0x35, 0xa5, 0xd4, 0x17, 0x6c, 0x57, 0xc9, 0xd1, 0xd4, 0x37, 0xa3, 0x30,
-	0xe3, 0xe3, 0x30, 0xcd, 0xac, 0x2a, 0x85, 0x67, 0xc1, 0x60, 0xf7, 0xc1, 0x48, 0x33, 0x2f, 0xe3,
-	0x16, 0xd0, 0x31, 0xab, 0xf3, 0x63, 0x90, 0xb9, 0x59, 0xb0, 0x34, 0x57, 0xc8, 0xf1, 0x76, 0x23,
-	0xee, 0x05, 0x56, 0x4d, 0xdc, 0x0e, 0xd7, 0xec, 0x3e, 0xd4, 0xf0, 0xff, 0x70, 0x7f, 0x1c, 0xfb,
-	0x47, 0xa9, 0xc5, 0x29, 0xa8, 0xa5, 0xc6, 0x26, 0x92, 0x2e, 0xa0, 0x88, 0x96, 0x29, 0xbb, 0x07,
-	0x35, 0x71, 0xf1, 0x61, 0x14, 0x07, 0xdc, 0x3a, 0x20, 0x00, 0x1b, 0x8d, 0x6e, 0x1c, 0x70, 0x17,
-	0x84, 0x04, 0xd7, 0xec, 0x36, 0xd4, 0xe8, 0xac, 0xa1, 0x1f, 0xcf, 0xa2, 0xcc, 0x3a, 0xbc, 0xa3,
-	0x3d, 0x30, 0x5c, 0x20, 0x56, 0x0b, 0x39, 0xec, 0x16, 0x00, 0x3e, 0xb9, 0x94, 0x8f, 0x48, 0x5e,
-	0x45, 0x0e, 0x89, 0xed, 0xa7, 0xa0, 0x63, 0x90, 0x58, 0x0d, 0xca, 0xbb, 0x6e, 0xfb, 0x45, 0x73,
-	0xe0, 0x98, 0x2b, 0x6c, 0x15, 0xaa, 0xae, 0xd3, 0xdc, 0x1a, 0xf6, 0xba, 0x9d, 0x2f, 0x4c, 0x8d,
-	0x01, 0x94, 0x76, 0xf7, 0x36, 0x3b, 0xed, 0x96, 0x59, 0x60, 0x15, 0xd0, 0x7b, 0xbb, 0x4e, 0xd7,
-	0x2c, 0xda, 0x3f, 0x87, 0xb2, 0x8c, 0x1c, 0xbb, 0x04, 0xd0, 0xed, 0x0d, 0x86, 0xfd, 0xe7, 0x4d,
-	0xd7, 0xd9, 0x32, 0x57, 0xd8, 0x1a, 0xd4, 0xda, 0xdd, 0x17, 0xed, 0x81, 0x93, 0x3b, 0x41, 0x0a,
-	0x0b, 0xf6, 0x13, 0x30, 0x28, 0x54, 0xcc, 0x84, 0x7a, 0xa7, 0xd7, 0xdc, 0x6a, 0x77, 0xb7, 0x87,
-	0x83, 0x66, 0xbb, 0x63, 0xae, 0xa0, 0x1a, 0x72, 0x9c, 0x2d, 0x53, 0xcb, 0x4b, 0x9f, 0x3b, 0x4d,
-	0xdc, 0xf8, 0x08, 0x40, 0x84, 0x9a, 0x80, 0x79, 0x6b, 0x19, 0x98, 0x65, 0xf9, 0x0c, 0x0a, 0x97,
-	0xbb, 0x4a, 0xf9, 0xd4, 0xba, 0x75, 0x0d, 0x4a, 0x02, 0xef, 0x12, 0x9d, 0x92, 0x62, 0xeb, 0x50,
-	0xf9, 0x86, 0x8f, 0xfd, 0x78, 0xc2, 0x03, 0x82, 0x69, 0xc5, 0x9d, 0xd3, 0xf6, 0x9f, 0x74, 0x30,
-	0xe8, 0x71, 0xce, 0x7d, 0x1a, 0x66, 0xe6, 0x2c, 0x1b, 0xc5, 0x8b, 0xcc, 0x24, 0x8a, 0xfd, 0x48,
-	0x82, 0x55, 0x27, 0x00, 0x99, 0xe2, 0xf5, 0xc5, 0xdf, 0x1c, 0x60, 0x1b, 0xa0, 0x63, 0x45, 0xa2,
-	0x34, 0x38, 0xbb, 0x76, 0x91, 0x1e, 0xa6, 0xf4, 0xd4, 0x4b, 0x78, 0x94, 0xa5, 0x56, 0x49, 0xa4,
-	0xb4, 0x24, 0xc9, 0x3f, 0x2f, 0x39, 0xe4, 0x19, 0xa5, 0x07, 0xfa, 0x47, 0x14, 0x02, 0x34, 0xf0,
-	0x32, 0xcf, 0xaa, 0x0a, 0x80, 0xe2, 0x1a, 0x79, 0xfb, 0x71, 0x70, 0x4c, 0x39, 0x52, 0x75, 0x69,
-	0xcd, 0x3e, 0x84, 0x12, 0x22, 0x7a, 0x96, 0x4a, 0xc8, 0xb3, 0xbc, 0xc7, 0x7d, 0x92, 0xb8, 0x52,
-	0x03, 0x23, 0xe8, 0x65, 0x19, 0x9f, 0x4c, 0xb3, 0x94, 0x80, 0x6f, 0xb8, 0x73, 0x9a, 0x5d, 0x07,
-	0x7d, 0x96, 0xf2, 0xc4, 0xe2, 0x12, 0xcc, 0x58, 0x3e, 0x5d, 0x62, 0xd9, 0xbf, 0xd7, 0xa0, 0x3a,
-	0x0f, 0x00, 0x5b, 0x05, 0x63, 0xc7, 0x71, 0xb7, 0x1d, 0x73, 0x65, 0xbd, 0x50, 0x21, 0xf4, 0xb4,
-	0xb7, 0xbb, 0x3d, 0xd7, 0x31, 0x35, 0xc4, 0xdf, 0xb3, 0x4e, 0x73, 0x5b, 0x20, 0xf1, 0x97, 0xbd,
-	0x76, 0xd7, 0x2c, 0xb2, 0x3a, 0x54, 0x9a, 0xdd, 0x6e, 0x6f, 0xaf, 0xdb, 0x72, 0x4c, 0x9d, 0x55,
-	0xc1, 0xe8, 0x38, 0xcd, 0x17, 0x8e, 0x69, 0xa0, 0xca, 0xc0, 0xf9, 0x7c, 0x60, 0x96, 0x90, 0xf9,
-	0xac, 0xdd, 0x71, 0xfa, 0x66, 0x99, 0xad, 0x41, 0xb9, 0xd5, 0xdb, 0xd9, 0x71, 0xba, 0x03, 0xb3,
-	0x42, 0xc7, 0x57, 0x40, 0xef, 0xb4, 0x3f, 0x73, 0xcc, 0x2a, 0x2b, 0x43, 0xb1, 0xb9, 0xb5, 0x65,
-	0x6e, 0xd8, 0x3f, 0x81, 0x5a, 0xee, 0x72, 0xb8, 0x1b, 0xf3, 0xe1, 0x0b, 0x01, 0xd1, 0x5f, 0xed,
-	0x39, 0x7b, 0x04, 0x51, 0xcc, 0x19, 0xa7, 0x8b, 0x10, 0x35, 0x0b, 0xf6, 0x43, 0x79, 0x01, 0x02,
-	0xe7, 0xcd, 0x65, 0x70, 0xaa, 0x04, 0x97, 0xd8, 0xfc, 0x0e, 0xea, 0x44, 0xef, 0x88, 0x26, 0x7b,
-	0x02, 0x4f, 0x0c, 0x74, 0x4c, 0x50, 0x55, 0xe5, 0x71, 0xcd, 0x6e, 0x40, 0x91, 0x47, 0x2f, 0x09,
-	0x48, 0xb5, 0x8d, 0x6a, 0xc3, 0x89, 0x5e, 0xf2, 0x71, 0x3c, 0xe5, 0x2e, 0x72, 0xe7, 0x50, 0xd1,
-	0xcf, 0x07, 0x15, 0xfb, 0xcf, 0x1a, 0x94, 0xda, 0xd1, 0xcb, 0x30, 0x3b, 0x69, 0xfb, 0x2a, 0x18,
-	0x54, 0x3c, 0xc8, 0x78, 0xdd, 0x15, 0xc4, 0xa9, 0xdd, 0x9c, 0xba, 0x36, 0x9e, 0x91, 0x48, 0xbb,
-	0xb2, 0xc3, 0x28, 0xee, 0xdb, 0x03, 0x30, 0x66, 0xbe, 0x70, 0xf7, 0xf4, 0xcc, 0x17, 0x32, 0x15,
-	0xdd, 0xbf, 0x16, 0xa0, 0xfa, 0x2c, 0x1c, 0xf3, 0x76, 0x14, 0xf0, 0x57, 0xe8, 0xf9, 0x24, 0x1c,
-	0x8f, 0xe5, 0x0d, 0x69, 0x8d, 0x18, 0xf5, 0x47, 0xdc, 0x3f, 0x4a, 0x67, 0x13, 0x19, 0xe3, 0x39,
-	0x4d, 0xed, 0x27, 0x9e, 0x25, 0xbe, 0xba, 0xab, 0xa4, 0xf0, 0x9c, 0x18, 0x31, 0x2d, 0x5b, 0x15,
-	0xae, 0xa9, 0xc0, 0x7b, 0xe9, 0x48, 0x36, 0x2a, 0x5a, 0xab, 0xa6, 0x57, 0x5a, 0x34, 0xbd, 0xab,
-	0x60, 0x4c, 0x78, 0x10, 0x7a, 0x32, 0xf9, 0x04, 0x31, 0x8f, 0x68, 0x25, 0x17, 0x51, 0x06, 0x7a,
-	0x1a, 0x7e, 0xcb, 0x29, 0x1f, 0x8b, 0x2e, 0xad, 0xd9, 0x27, 0x60, 0x78, 0x41, 0xc0, 0x03, 0x4a,
-	0xbd, 0xb3, 0xa3, 0x28, 0x14, 0xd9, 0x23, 0xd0, 0x27, 0x3c, 0xf3, 0x28, 0xfb, 0x6a, 0x1b, 0xef,
-	0x9e, 0xd8, 0xd0, 0xa7, 0x41, 0xcf, 0x25, 0x25, 0x9a, 0x03, 0xa8, 0x18, 0xa4, 0x56, 0x5d, 0xce,
-	0x01, 0x82, 0xb4, 0xff, 0x56, 0x00, 0x9d, 0x3a, 0x8c, 0xf2, 0x54, 0xcb, 0x79, 0x6a, 0x42, 0x71,
-	0x1a, 0x46, 0x14, 0xbc, 0x8a, 0x8b, 0x4b, 0xec, 0x99, 0xd3, 0xb1, 0x17, 0x46, 0x19, 0x7f, 0x95,
-	0xc9, 0xd2, 0xb9, 0x60, 0xcc, 0x5f, 0x41, 0xcf, 0xbd, 0xc2, 0x5d, 0x19, 0x51, 0x31, 0xf2, 0xad,
-	0x51, 0x6b, 0x6b, 0xf4, 0xa6, 0x59, 0xea, 0x44, 0x59, 0x72, 0x2c, 0x43, 0xfc, 0x14, 0x6a, 0x5f,
-	0xa5, 0x71, 0x34, 0x94, 0x23, 0x41, 0xe9, 0xec, 0x3b, 0x01, 0xea, 0xf6, 0xc5, 0xbc, 0x70, 0x0f,
-	0x8c, 0x71, 0x18, 0x1d, 0xa5, 0x56, 0x85, 0xce, 0x37, 0xc5, 0xf9, 0x1d, 0x64, 0x09, 0x03, 0x42,
-	0xbc, 0xfe, 0x04, 0xaa, 0x73, 0xa3, 0xea, 0xf5, 0xb4, 0xa5, 0xd7, 0x7b, 0xe9, 0x8d, 0x67, 0x6a,
-	0xe4, 0x12, 0xc4, 0xa7, 0x85, 0xa7, 0xda, 0xfa, 0x2f, 0x00, 0x16, 0xa7, 0x9d, 0xb2, 0xf3, 0x46,
-	0x7e, 0x27, 0x66, 0x07, 0x6a, 0xe7, 0x0e, 0xb0, 0xff, 0xa5, 0x81, 0x8e, 0x3c, 0xdc, 0x3b, 0x4b,
-	0x55, 0x80, 0x71, 0xf9, 0x7f, 0x89, 0x2f, 0x9a, 0x7a, 0x7b, 0xf1, 0xfd, 0xc1, 0x71, 0xb3, 0xff,
-	0x59, 0x84, 0x7a, 0x37, 0xce, 0xc2, 0x83, 0xd0, 0xf7, 0xb2, 0x30, 0x8e, 0x4e, 0x94, 0x20, 0x55,
-	0x37, 0x0a, 0xe7, 0xac, 0x1b, 0x57, 0xc1, 0xf0, 0xfc, 0x6c, 0xde, 0x65, 0x05, 0x81, 0xc8, 0x4e,
-	0x67, 0xfb, 0x5f, 0x71, 0x3f, 0x93, 0x51, 0x51, 0x24, 0x7b, 0x1f, 0xea, 0x72, 0x39, 0x0c, 0x78,
-	0xea, 0xcb, 0xf4, 0xad, 0x49, 0xde, 0x16, 0x4f, 0xfd, 0x45, 0x15, 0x14, 0x79, 0x2c, 0xab, 0xe0,
-	0x9b, 0xfa, 0xe8, 0x3d, 0xd9, 0xcf, 0x2b, 0xb2, 0x3b, 0xe6, 0x6f, 0x97, 0x1f, 0x41, 0x55, 0x6f,
-	0xad, 0xe6, 0x7a, 0x2b, 0x03, 0x9d, 0x26, 0x07, 0xa0, 0x27, 0xa5, 0xf5, 0x59, 0x7d, 0xf2, 0x2f,
-	0x9a, 0x9c, 0xd7, 0xae, 0xc0, 0x9a, 0x1c, 0xb1, 0x5c, 0xa7, 0xe5, 0xb4, 0x5f, 0xd0, 0xdc, 0xf5,
-	0x2e, 0x5c, 0x69, 0xb6, 0x5a, 0xbd, 0xbd, 0xee, 0x60, 0xb8, 0xeb, 0x38, 0xee, 0x10, 0xfb, 0x23,
-	0x75, 0xaa, 0x77, 0xe0, 0xf2, 0x92, 0xa0, 0xe3, 0x3c, 0x1b, 0x98, 0x15, 0x9c, 0xd3, 0xf2, 0x7a,
-	0x05, 0x1c, 0xfc, 0x16, 0xf2, 0x22, 0xbb, 0x0c, 0xab, 0x3b, 0x4e, 0xbf, 0xdf, 0xdc, 0x76, 0x86,
-	0xcd, 0x2d, 0x1c, 0xcb, 0x74, 0xdc, 0x42, 0x8d, 0x54, 0x32, 0x0c, 0xd4, 0x91, 0xed, 0x54, 0xb2,
-	0x4a, 0x38, 0x0e, 0x62, 0x43, 0x95, 0x74, 0xd9, 0x7e, 0x02, 0x66, 0x3e, 0x24, 0x54, 0xc4, 0xef,
-	0x2e, 0x17, 0xf1, 0xd5, 0xa5, 0xa0, 0xa9, 0x52, 0xfe, 0x3b, 0x0d, 0x74, 0xfc, 0x12, 0x9c, 0x77,
-	0x44, 0x2d, 0xd7, 0x11, 0xdf, 0xfc, 0xed, 0x69, 0x42, 0xd1, 0x9b, 0x86, 0x12, 0x0e, 0xb8, 0xc4,
-	0x8a, 0x4f, 0xf0, 0xf1, 0x63, 0x95, 0x23, 0x73, 0x9a, 0xea, 0x1b, 0x8e, 0xd8, 0xb2, 0x8a, 0xe3,
-	0x9a, 0x32, 0x32, 0x19, 0xab, 0x2a, 0x3e, 0x4b, 0xc6, 0xf6, 0xbf, 0x35, 0xa8, 0xa1, 0x2b, 0x7d,
-	0x9e, 0xa6, 0xa7, 0x81, 0x16, 0x67, 0x3d, 0xdf, 0x5f, 0x38, 0x23, 0x29, 0xf6, 0x11, 0x14, 0xf9,
-	0xab, 0xa9, 0xec, 0xdb, 0x67, 0x61, 0x19, 0xd5, 0xf0, 0x4e, 0x09, 0x3f, 0x48, 0x78, 0x3a, 0x52,
-	0xa0, 0x95, 0x24, 0x26, 0x45, 0x82, 0x07, 0x9d, 0xa3, 0x99, 0x26, 0xf2, 0x24, 0x05, 0xff, 0xd2,
-	0x32, 0xfc, 0x59, 0xee, 0x53, 0xa9, 0x2a, 0x91, 0x79, 0x1d, 0x74, 0xdf, 0x3b, 0x10, 0x08, 0x9e,
-	0x7f, 0x7e, 0x13, 0xcb, 0xfe, 0x19, 0xac, 0xe5, 0xee, 0x4d, 0x6f, 0x67, 0x2f, 0xbf, 0x5d, 0xbd,
-	0x91, 0x53, 0x50, 0x4f, 0xf7, 0x07, 0x5d, 0xc4, 0xcb, 0xe5, 0x5f, 0xcf, 0x78, 0x9a, 0x9d, 0x6b,
-	0xc6, 0x59, 0xe4, 0x57, 0x71, 0x29, 0xbf, 0x94, 0x77, 0xfa, 0x09, 0xef, 0x30, 0x51, 0x0f, 0x93,
-	0x78, 0x36, 0x95, 0x7d, 0x54, 0x10, 0xf8, 0xcd, 0x93, 0x1e, 0x47, 0xfe, 0x50, 0x88, 0x40, 0x7c,
-	0x2c, 0x22, 0x67, 0x9b, 0xc4, 0x1f, 0xc8, 0x08, 0x18, 0x94, 0xaf, 0x97, 0x1b, 0x39, 0x3f, 0x1b,
-	0xa7, 0x0c, 0xe0, 0xa5, 0x73, 0xd6, 0x21, 0xd5, 0xbe, 0xcb, 0xb9, 0xf6, 0xfd, 0x68, 0x3e, 0x3a,
-	0x57, 0xc9, 0xd8, 0x95, 0x25, 0x63, 0x17, 0x98, 0x9d, 0x6f, 0x01, 0xd0, 0x6d, 0x86, 0x64, 0xa2,
-	0x4e, 0x26, 0xaa, 0xc4, 0xe9, 0x0b, 0x3b, 0x97, 0x85, 0x38, 0x4b, 0xbc, 0x28, 0x3d, 0xe0, 0x49,
-	0xc2, 0x03, 0x6b, 0x95, 0xb4, 0x4c, 0x12, 0x0c, 0x16, 0x7c, 0xbb, 0x27, 0x6b, 0x48, 0x15, 0x8c,
-	0xfe, 0x00, 0xc7, 0xea, 0x15, 0x1c, 0x65, 0xf7, 0xba, 0x82, 0x28, 0xe2, 0xa7, 0x17, 0x2d, 0x87,
-	0x83, 0xe7, 0x38, 0xf6, 0x9a, 0x1a, 0x63, 0x70, 0x49, 0x8a, 0x15, 0x8f, 0xe6, 0xec, 0x76, 0x77,
-	0xb3, 0xf7, 0xb9, 0x59, 0xb0, 0x3f, 0x82, 0x92, 0x9c, 0x94, 0xcb, 0x50, 0xec, 0x3a, 0xbf, 0x16,
-	0x07, 0xaa, 0xd9, 0x58, 0xc3, 0x01, 0xbd, 0xd5, 0xdb, 0xd9, 0xed, 0x38, 0x03, 0xc7, 0x2c, 0x28,
-	0x44, 0xc9, 0x20, 0xbc, 0x19, 0x51, 0x52, 0x41, 0x21, 0xea, 0x3f, 0x05, 0xb8, 0x42, 0x40, 0x53,
-	0xef, 0x28, 0x4d, 0xbe, 0x8e, 0xac, 0x1b, 0x50, 0x8d, 0x66, 0x93, 0x61, 0x16, 0x67, 0xde, 0x98,
-	0xe0, 0x65, 0xb8, 0x95, 0x68, 0x36, 0x19, 0x20, 0x8d, 0x9f, 0xcb, 0x28, 0x9c, 0xf2, 0x28, 0x08,
-	0xa3, 0x43, 0xc2, 0x99, 0xe1, 0x42, 0x34, 0x9b, 0xec, 0x0a, 0x0e, 0x36, 0x07, 0x54, 0xf0, 0xe3,
-	0xc9, 0x74, 0xcc, 0xe5, 0x48, 0x6d, 0xb8, 0xb8, 0xa9, 0x25, 0x59, 0x84, 0xae, 0xf0, 0x5b, 0x2e,
-	0x2d, 0x18, 0xe2, 0x29, 0x90, 0x23, 0x4c, 0x60, 0x7b, 0x41, 0xb1, 0xb2, 0x51, 0x22, 0x85, 0x1a,
-	0xf2, 0x94, 0x91, 0xbb, 0xb0, 0x4a, 0x2a, 0x73, 0x2b, 0x02, 0x32, 0xb4, 0x6f, 0x6e, 0xe6, 0x43,
-	0xf9, 0xa4, 0xe9, 0x30, 0x67, 0xad, 0x42, 0x8a, 0x6b, 0x42, 0xd0, 0x9f, 0xdb, 0xfc, 0x04, 0xae,
-	0xe6, 0x75, 0xe7, 0xe7, 0x8a, 0x49, 0x92, 0x2d, 0xd4, 0xe7, 0xa7, 0x5f, 0x05, 0x83, 0x27, 0x49,
-	0x9c, 0x58, 0x1b, 0x22, 0x71, 0x88, 0x60, 0xd7, 0xa1, 0x42, 0x8b, 0x61, 0x18, 0x58, 0x8f, 0x45,
-	0xd9, 0x20, 0xba, 0x1d, 0xd8, 0xff, 0xd5, 0xc4, 0xb3, 0x3d, 0x1f, 0x0c, 0x76, 0x55, 0x52, 0x3f,
-	0x94, 0x89, 0xa4, 0x11, 0xb6, 0xdf, 0x69, 0xbc, 0x26, 0xcf, 0x27, 0x93, 0xac, 0xa8, 0x85, 0x79,
-	0x45, 0x65, 0x4f, 0xa0, 0x3c, 0xe2, 0x5e, 0xc0, 0x93, 0xd4, 0x2a, 0xd2, 0xab, 0xdf, 0x3a, 0xb1,
-	0xff, 0xb9, 0x90, 0x8b, 0x81, 0x45, 0x69, 0x53, 0xe9, 0xf0, 0x32, 0x55, 0x21, 0x69, 0xbd, 0xfe,
-	0x29, 0xd4, 0xf3, 0xca, 0x17, 0x1a, 0x48, 0x3e, 0x90, 0xe9, 0x50, 0x86, 0xe2, 0xee, 0xde, 0xc0,
-	0x5c, 0xc1, 0x0f, 0xc2, 0xdd, 0x5e, 0x7f, 0x20, 0x7e, 0xb7, 0xd8, 0x72, 0x24, 0x6c, 0x7f, 0x23,
-	0x0a, 0xda, 0x45, 0x3e, 0xda, 0x54, 0x05, 0x29, 0x9e, 0xb3, 0x82, 0xe4, 0x0b, 0x80, 0xbe, 0x5c,
-	0x00, 0xec, 0xaf, 0x45, 0xf8, 0x5b, 0xe3, 0x90, 0x47, 0x59, 0x37, 0x8e, 0x7c, 0xbe, 0xb8, 0x92,
-	0x96, 0xbb, 0xd2, 0x19, 0x7d, 0xf1, 0x82, 0xee, 0xe0, 0xb0, 0x01, 0x0b, 0x9b, 0x17, 0xf8, 0xf1,
-	0x37, 0xf7, 0x7b, 0x6d, 0xf1, 0xfc, 0xbf, 0xd7, 0x36, 0x40, 0x4f, 0x39, 0x8f, 0xce, 0xf3, 0x15,
-	0x8b, 0x7a, 0x78, 0xfd, 0x2c, 0x3e, 0xe2, 0x91, 0xec, 0xdc, 0x82, 0xb0, 0x1f, 0xc3, 0xa5, 0x85,
-	0xcf, 0x54, 0x5c, 0xde, 0x5f, 0x2e, 0x2e, 0xb5, 0xc6, 0x42, 0xae, 0x6a, 0x8b, 0x07, 0x55, 0x64,
-	0x0e, 0xf0, 0x84, 0xd3, 0x3e, 0x89, 0x17, 0xc8, 0xa9, 0xab, 0x30, 0x5f, 0x34, 0x98, 0x5f, 0x82,
-	0xb9, 0xb0, 0xfb, 0x86, 0x5f, 0x4c, 0xaf, 0x41, 0xc9, 0x27, 0xb9, 0x1a, 0x22, 0x04, 0xc5, 0xde,
-	0x03, 0xf0, 0xc3, 0xe9, 0x88, 0x27, 0xf3, 0xe9, 0xbf, 0xee, 0xe6, 0x38, 0xf6, 0x77, 0x70, 0x79,
-	0x71, 0xf6, 0x45, 0x00, 0xba, 0x30, 0x58, 0x5c, 0x32, 0x78, 0xd1, 0x1f, 0x14, 0xfe, 0xa8, 0x81,
-	0xb1, 0x19, 0x67, 0x9f, 0xbd, 0xf8, 0xbe, 0xc4, 0x9b, 0x87, 0xef, 0x87, 0x41, 0x24, 0xf7, 0x93,
-	0xbe, 0x7e, 0xee, 0x9f, 0xf4, 0x37, 0xaf, 0xc0, 0x6a, 0x18, 0x37, 0x30, 0x52, 0x21, 0x6a, 0xee,
-	0x7f, 0x59, 0x98, 0xee, 0xef, 0x97, 0x68, 0xc7, 0xe3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd6,
-	0x54, 0xdf, 0xcf, 0x37, 0x19, 0x00, 0x00,
+	// 3275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcf, 0x6f, 0xe3, 0xd6,
+	0x99, 0x26, 0x45, 0xea, 0xc7, 0x27, 0xd9, 0xe6, 0x70, 0x26, 0x13, 0xc5, 0x93, 0x49, 0x1c, 0xce,
+	0x4e, 0x32, 0xc9, 0x24, 0x4a, 0xe2, 0xec, 0xee, 0x0c, 0xb2, 0x58, 0x2c, 0x64, 0x99, 0x63, 0x6b,
+	0x23, 0x4b, 0x0e, 0x45, 0xcf, 0x26, 0xb9, 0x08, 0xb4, 0xf4, 0x6c, 0x33, 0x96, 0x48, 0x85, 0xa4,
+	0x26, 0x76, 0x80, 0xdd, 0x3d, 0x2c, 0xb0, 0x97, 0xbd, 0xee, 0x65, 0x37, 0xe8, 0xad, 0xe8, 0xad,
+	0xe8, 0xa5, 0x97, 0x9e, 0xdb, 0x43, 0x51, 0xf4, 0xd4, 0x5b, 0x81, 0xfe, 0x15, 0x3d, 0xf4, 0x54,
+	0x14, 0xc5, 0xf7, 0xbd, 0xf7, 0x28, 0xd2, 0xf6, 0x78, 0xe4, 0x62, 0x72, 0xb1, 0xdf, 0xf7, 0xe3,
+	0xfd, 0xf8, 0x7e, 0xbe, 0xef, 0x7b, 0x14, 0x54, 0x27, 0xe1, 0x88, 0x8d, 0x1b, 0xd3, 0x28, 0x4c,
+	0xc2, 0xb5, 0x37, 0x8f, 0xc2, 0xf0, 0x68, 0xcc, 0x3e, 0x24, 0xe8, 0x60, 0x76, 0xf8, 0x61, 0xe2,
+	0x4f, 0x58, 0x9c, 0x78, 0x93, 0xa9, 0x60, 0x78, 0xfd, 0x3c, 0x43, 0x9c, 0x44, 0xb3, 0x61, 0x22,
+	0xa8, 0xcb, 0x13, 0x16, 0xc7, 0xde, 0x11, 0xe3, 0xa0, 0xf5, 0x5f, 0x2a, 0x68, 0x7b, 0x8c, 0x45,
+	0xe6, 0x0a, 0xa8, 0xfe, 0xa8, 0xae, 0xac, 0x2b, 0x0f, 0x2a, 0x8e, 0xea, 0x8f, 0xcc, 0x3a, 0x94,
+	0xbc, 0xd1, 0x28, 0x62, 0x71, 0x5c, 0x57, 0x09, 0x29, 0x41, 0xd3, 0x04, 0x2d, 0xf0, 0x26, 0xac,
+	0x5e, 0x20, 0x34, 0x8d, 0xcd, 0xdb, 0x50, 0xf4, 0x9e, 0x79, 0x89, 0x17, 0xd5, 0x35, 0xc2, 0x0a,
+	0xc8, 0x7c, 0x13, 0x4a, 0x7e, 0x70, 0x10, 0x9e, 0xb2, 0xb8, 0xae, 0xaf, 0x17, 0x1e, 0x54, 0x37,
+	0xf4, 0x46, 0xcb, 0x3b, 0x64, 0x8e, 0xc4, 0x9a, 0x7f, 0x0f, 0xa5, 0x61, 0xc4, 0xbc, 0x84, 0x8d,
+	0xea, 0xc5, 0x75, 0xe5, 0x41, 0x75, 0x63, 0xad, 0xc1, 0x8f, 0xdf, 0x90, 0xc7, 0x6f, 0xb8, 0x52,
+	0x3e, 0x47, 0xb2, 0xe2, 0xac, 0xd9, 0x74, 0x44, 0xb3, 0x4a, 0x2f, 0x9e, 0x25, 0x58, 0x51, 0xa4,
+	0x69, 0x14, 0x1e, 0xfa, 0x63, 0x56, 0x2f, 0x73, 0x91, 0x04, 0x68, 0xbd, 0x03, 0x65, 0x54, 0x42,
+	0xc7, 0x8f, 0x13, 0xf3, 0x0e, 0xe8, 0x7e, 0xc2, 0x26, 0x71, 0x5d, 0x11, 0x07, 0x46, 0x8a, 0xc3,
+	0x71, 0xd6, 0x2f, 0x14, 0x28, 0xed, 0xf1, 0x49, 0x3f, 0x90, 0xc6, 0x1e, 0x40, 0x29, 0x39, 0x8e,
+	0x98, 0x37, 0x92, 0x1a, 0x5b, 0x69, 0x88, 0x0d, 0x5d, 0x42, 0x3b, 0x92, 0x6c, 0x36, 0x40, 0x43,
+	0xb9, 0x16, 0xd0, 0x1b, 0xf1, 0x59, 0x6d, 0x58, 0xce, 0xad, 0x74, 0x41, 0x00, 0x79, 0x4c, 0x35,
+	0x73, 0xcc, 0x5b, 0xa0, 0x1f, 0xd3, 0x61, 0x0a, 0xeb, 0x85, 0x07, 0x15, 0x87, 0x03, 0x56, 0x07,
+	0xb4, 0xfd, 0x98, 0x45, 0x59, 0x91, 0x95, 0xcb, 0x45, 0x56, 0x2f, 0x15, 0xb9, 0x90, 0x15, 0xd9,
+	0xfa, 0xb9, 0x02, 0xa5, 0x56, 0x18, 0x24, 0xde, 0x30, 0x79, 0x39, 0x2b, 0xa2, 0x0d, 0xa7, 0x8c,
+	0x45, 0x71, 0x5d, 0xcb, 0xd9, 0x90, 0x70, 0xb8, 0x45, 0x56, 0xc3, 0x95, 0xb9, 0x46, 0x0d, 0x28,
+	0xc4, 0xfe, 0x11, 0x29, 0xb4, 0xe6, 0xe0, 0xd0, 0x5c, 0x83, 0xf2, 0x33, 0x16, 0xf9, 0x87, 0x3e,
+	0x1b, 0xd5, 0xd9, 0xba, 0xf2, 0xa0, 0xec, 0xa4, 0xb0, 0xf5, 0x01, 0x54, 0xc5, 0xa9, 0xc9, 0x6f,
+	0xde, 0xc8, 0xfb, 0x4d, 0xb9, 0x21, 0x88, 0xd2, 0x75, 0x66, 0x70, 0x53, 0x60, 0x9e, 0xd2, 0x0a,
+	0x43, 0x2f, 0xf1, 0xc3, 0xe0, 0x0a, 0x81, 0x6f, 0x49, 0x21, 0x54, 0xae, 0x7a, 0x7e, 0x7a, 0x69,
+	0xf5, 0xc2, 0x82, 0x56, 0xff, 0x1f, 0x1d, 0x8a, 0xcf, 0xb1, 0xb7, 0x01, 0x85, 0x13, 0x76, 0x26,
+	0x14, 0x8a, 0x43, 0xe4, 0x88, 0x4f, 0x68, 0xe9, 0x9a, 0xa3, 0xc6, 0x27, 0xa9, 0xce, 0xb5, 0xbc,
+	0xce, 0xe3, 0xe1, 0x31, 0x9b, 0x78, 0x75, 0x9d, 0xeb, 0x9c, 0x43, 0xe6, 0xeb, 0x50, 0xf1, 0x03,
+	0x3f, 0xf1, 0xbd, 0x24, 0x8c, 0x48, 0x85, 0x15, 0x67, 0x8e, 0x30, 0xd7, 0x41, 0x4b, 0xce, 0xa6,
+	0x8c, 0xc2, 0x75, 0x65, 0xa3, 0xd6, 0xe0, 0x47, 0x6a, 0xb8, 0x67, 0x53, 0xe6, 0x10, 0xc5, 0x7c,
+	0x17, 0x4a, 0xf1, 0xb1, 0x17, 0xf9, 0xc1, 0x11, 0x45, 0xe7, 0xca, 0xc6, 0xaa, 0x64, 0xea, 0x73,
+	0xb4, 0x23, 0xe9, 0xb8, 0xd5, 0xb7, 0xc7, 0x7e, 0xc2, 0xc6, 0x7e, 0x9c, 0xd4, 0x2b, 0xa4, 0x9d,
+	0x39, 0xc2, 0x7c, 0x07, 0xf4, 0x38, 0x41, 0x15, 0x01, 0x2d, 0xb3, 0x9c, 0x2e, 0x83, 0xc8, 0x4d,
+	0xb5, 0xae, 0x38, 0x9c, 0x8e, 0xd2, 0xa1, 0x3b, 0xd7, 0xab, 0x5c, 0x3a, 0x1c, 0x9b, 0xef, 0x40,
+	0x15, 0xff, 0x0f, 0x0e, 0xc6, 0xe1, 0xf0, 0x24, 0xae, 0x33, 0xb2, 0x65, 0xb1, 0xb1, 0x89, 0xa0,
+	0x03, 0x48, 0xa2, 0x61, 0x6c, 0xbe, 0x0d, 0x55, 0x2e, 0xf8, 0x20, 0x08, 0x47, 0xac, 0x7e, 0x48,
+	0xe6, 0xd0, 0x1b, 0xdd, 0x70, 0xc4, 0x1c, 0xe0, 0x14, 0x1c, 0x9b, 0x6f, 0x42, 0x95, 0xd6, 0x1a,
+	0x0c, 0xc3, 0x59, 0x90, 0xd4, 0x8f, 0xd6, 0x95, 0x07, 0xba, 0x03, 0x84, 0x6a, 0x21, 0xc6, 0xbc,
+	0x0b, 0x80, 0x96, 0x15, 0xf4, 0x63, 0xa2, 0x57, 0x10, 0xc3, 0xc9, 0x6f, 0x41, 0x6d, 0x16, 0xe0,
+	0xf9, 0x05, 0x83, 0x4f, 0x0c, 0x55, 0x8e, 0x23, 0x16, 0xeb, 0x31, 0x68, 0xa8, 0x47, 0xb3, 0x0a,
+	0xa5, 0x3d, 0xa7, 0xfd, 0xb4, 0xe9, 0xda, 0xc6, 0x92, 0xb9, 0x0c, 0x15, 0xc7, 0x6e, 0x6e, 0x0d,
+	0x7a, 0xdd, 0xce, 0x97, 0x86, 0x62, 0x02, 0x14, 0xf7, 0xf6, 0x37, 0x3b, 0xed, 0x96, 0xa1, 0x9a,
+	0x65, 0xd0, 0x7a, 0x7b, 0x76, 0xd7, 0x28, 0x58, 0xff, 0x08, 0x25, 0xa1, 0x5c, 0x73, 0x05, 0xa0,
+	0xdb, 0x73, 0x07, 0xfd, 0x9d, 0xa6, 0x63, 0x6f, 0x19, 0x4b, 0xe6, 0x2a, 0x54, 0xdb, 0xdd, 0xa7,
+	0x6d, 0xd7, 0xce, 0xac, 0x20, 0x88, 0xaa, 0xf5, 0x08, 0x74, 0xd2, 0xa6, 0x69, 0x40, 0xad, 0xd3,
+	0x6b, 0x6e, 0xb5, 0xbb, 0xdb, 0x03, 0xb7, 0xd9, 0xee, 0x18, 0x4b, 0xc8, 0x86, 0x18, 0x7b, 0xcb,
+	0x50, 0xb2, 0xd4, 0x1d, 0xbb, 0x89, 0x13, 0x1f, 0x02, 0x70, 0x6b, 0x50, 0xc8, 0xdc, 0xcd, 0x87,
+	0x4c, 0x49, 0x58, 0x4a, 0x46, 0xcc, 0x9e, 0x64, 0xbe, 0xf4, 0x82, 0xba, 0x0d, 0x45, 0x1e, 0xb7,
+	0xc2, 0x81, 0x05, 0x84, 0x21, 0xfb, 0x2d, 0x1b, 0x0f, 0xc3, 0x09, 0x1b, 0x91, 0x27, 0x97, 0x9d,
+	0x14, 0xb6, 0xfe, 0x4f, 0x91, 0x4b, 0x3a, 0xcc, 0xcb, 0x2e, 0xa1, 0xe4, 0x96, 0x30, 0x41, 0x43,
+	0x03, 0xc8, 0x54, 0x83, 0x63, 0x8c, 0x46, 0x32, 0x9a, 0xc8, 0x34, 0x1c, 0x48, 0xa3, 0x51, 0x5b,
+	0x2c, 0x1a, 0xcd, 0xd7, 0x40, 0x9b, 0xc5, 0x2c, 0xaa, 0x33, 0xe1, 0x2e, 0x98, 0x45, 0x1d, 0x42,
+	0x59, 0x9f, 0xc0, 0xca, 0xfc, 0x68, 0xa4, 0x9e, 0xb7, 0xf2, 0xea, 0xa9, 0x36, 0xe6, 0x74, 0xa9,
+	0xa2, 0x1f, 0x29, 0x50, 0xe3, 0x58, 0xf7, 0x6c, 0x8a, 0x66, 0xbc, 0x8e, 0x48, 0xc8, 0x4b, 0xb3,
+	0x84, 0x9e, 0x04, 0xf4, 0x32, 0x85, 0xfa, 0x83, 0x06, 0x3a, 0x05, 0xcc, 0xc2, 0xe6, 0xc3, 0x94,
+	0x3e, 0x4b, 0x8e, 0xc3, 0x79, 0x4a, 0x27, 0xc8, 0xfc, 0x3b, 0x91, 0x40, 0x34, 0x0a, 0x6a, 0x83,
+	0x47, 0x24, 0xff, 0x9b, 0x49, 0x22, 0xf2, 0xe8, 0xfa, 0x82, 0x47, 0xc7, 0x92, 0xc0, 0x8b, 0x58,
+	0x90, 0xc4, 0xf5, 0x22, 0xbf, 0x0b, 0x04, 0x48, 0xe7, 0xf3, 0xa2, 0x23, 0x96, 0xd4, 0x4b, 0xe2,
+	0x7c, 0x04, 0xa1, 0x22, 0x47, 0x5e, 0xe2, 0xd5, 0x2b, 0x5c, 0x91, 0x38, 0x46, 0xdc, 0x41, 0x38,
+	0x3a, 0x13, 0x55, 0x05, 0x8d, 0xcd, 0xf7, 0xa0, 0x88, 0x59, 0x66, 0x16, 0x8b, 0x34, 0x64, 0x66,
+	0x4f, 0xdc, 0x27, 0x8a, 0x23, 0x38, 0xd0, 0x65, 0xbd, 0x24, 0x61, 0x93, 0x69, 0x12, 0x53, 0x32,
+	0xd2, 0x9d, 0x14, 0xbe, 0x4a, 0xb9, 0xbf, 0x55, 0xa0, 0x92, 0x2a, 0xc0, 0x5c, 0x06, 0x7d, 0xd7,
+	0x76, 0xb6, 0x6d, 0x63, 0x69, 0x4d, 0x2d, 0x53, 0xb8, 0xb6, 0xb7, 0xbb, 0x3d, 0xc7, 0x36, 0x14,
+	0x0c, 0xf8, 0x27, 0x9d, 0xe6, 0x36, 0x0f, 0xfd, 0x7f, 0xed, 0xb5, 0xbb, 0x46, 0xc1, 0xac, 0x41,
+	0xb9, 0xd9, 0xed, 0xf6, 0xf6, 0xbb, 0x2d, 0xdb, 0xd0, 0xcc, 0x0a, 0xe8, 0x1d, 0xbb, 0xf9, 0xd4,
+	0x36, 0x74, 0x64, 0x71, 0xed, 0x2f, 0x5c, 0xa3, 0x88, 0xc8, 0x27, 0xed, 0x8e, 0xdd, 0x37, 0x4a,
+	0xe6, 0x2a, 0x94, 0x5a, 0xbd, 0xdd, 0x5d, 0xbb, 0xeb, 0x1a, 0x65, 0x5a, 0xbe, 0x0c, 0x5a, 0xa7,
+	0xfd, 0x99, 0x6d, 0x54, 0x30, 0xd1, 0x6c, 0x76, 0x7a, 0xad, 0xcf, 0x3a, 0xed, 0xbe, 0x6b, 0x00,
+	0x12, 0x30, 0xef, 0x18, 0x55, 0xdc, 0xc1, 0xb1, 0x9b, 0x2d, 0xb7, 0xdd, 0xeb, 0x1a, 0x35, 0x4c,
+	0x4e, 0xfb, 0x5d, 0x82, 0x8d, 0x65, 0xca, 0x25, 0xad, 0x1d, 0x7b, 0xb7, 0x69, 0xac, 0x98, 0x25,
+	0x28, 0x34, 0xb7, 0xb6, 0x8c, 0x0d, 0xeb, 0x63, 0xa8, 0x66, 0x94, 0x83, 0xbb, 0xe3, 0x42, 0x5f,
+	0xf2, 0x9c, 0xf2, 0xf9, 0xbe, 0xbd, 0x4f, 0x39, 0x05, 0x93, 0x9c, 0xdd, 0xc5, 0x9c, 0x62, 0xa8,
+	0xd6, 0xbb, 0x42, 0x01, 0x14, 0x2e, 0xaf, 0xe7, 0xc3, 0x45, 0x26, 0x6d, 0x11, 0x29, 0xbf, 0x53,
+	0xa0, 0x46, 0x88, 0x5d, 0x5e, 0xff, 0x5e, 0x56, 0xfd, 0x5c, 0x88, 0x90, 0x3b, 0x50, 0x60, 0xc1,
+	0x33, 0x71, 0xd7, 0x56, 0x1a, 0x76, 0xf0, 0x8c, 0x8d, 0xc3, 0x29, 0x73, 0x10, 0x7b, 0xed, 0x30,
+	0xc9, 0x5a, 0x59, 0x3f, 0x67, 0xe5, 0xbb, 0x00, 0x63, 0x2f, 0x4e, 0x06, 0x2c, 0x8a, 0xe6, 0xb7,
+	0x27, 0x62, 0x6c, 0x44, 0xa0, 0x33, 0x1e, 0x7a, 0xfe, 0x58, 0x94, 0xbb, 0x65, 0x47, 0x40, 0xd6,
+	0xef, 0x15, 0x00, 0x4c, 0x8e, 0x3b, 0xcc, 0x1b, 0x27, 0xc7, 0xa9, 0x08, 0x4a, 0x46, 0x84, 0x35,
+	0x28, 0x23, 0xf3, 0x2c, 0x62, 0xbc, 0x2c, 0xd5, 0x9d, 0x14, 0x3e, 0xb7, 0x6b, 0xe1, 0xfc, 0xae,
+	0xff, 0x0c, 0x35, 0x22, 0x8b, 0x53, 0x2e, 0x20, 0x68, 0x15, 0xf9, 0x9b, 0x9c, 0x1d, 0xa7, 0x07,
+	0xec, 0x74, 0x3e, 0xfd, 0xc5, 0x31, 0x59, 0x45, 0x7e, 0x31, 0x1d, 0xf3, 0xe1, 0x5c, 0xb4, 0xcb,
+	0xf3, 0xe1, 0x9c, 0x2e, 0xad, 0xfc, 0x53, 0x05, 0x8a, 0xed, 0xe0, 0x99, 0x9f, 0x5c, 0xb4, 0x6f,
+	0x9a, 0xc0, 0x55, 0x2a, 0x6f, 0x38, 0x70, 0x69, 0x69, 0x4e, 0x4d, 0x0b, 0xae, 0x11, 0x09, 0x91,
+	0x45, 0xfd, 0x28, 0xb1, 0x2f, 0x2f, 0xcb, 0xe0, 0x7d, 0xc8, 0x8f, 0x7b, 0xf9, 0x7d, 0xc8, 0x69,
+	0x52, 0xb8, 0x5f, 0xa9, 0x50, 0x79, 0xe2, 0x8f, 0x59, 0x3b, 0x18, 0xb1, 0x53, 0x3c, 0xf9, 0xc4,
+	0x1f, 0x8f, 0xa5, 0xb1, 0x71, 0x8c, 0xc6, 0x1e, 0x1e, 0xb3, 0xe1, 0x49, 0x3c, 0x9b, 0x08, 0x3f,
+	0x4e, 0x61, 0xaa, 0xdb, 0xc2, 0x59, 0x34, 0x94, 0xb2, 0x0a, 0x08, 0xd7, 0x09, 0xd1, 0x25, 0x45,
+	0x8d, 0x87, 0x63, 0xc4, 0x1d, 0x7b, 0xf1, 0xb1, 0xa8, 0xf0, 0x68, 0x2c, 0xab, 0xc5, 0xe2, 0xbc,
+	0x5a, 0xbc, 0x05, 0xfa, 0x84, 0x8d, 0x7c, 0x4f, 0x64, 0x48, 0x0e, 0xa4, 0x1a, 0x2d, 0x67, 0x34,
+	0x6a, 0x82, 0x16, 0xfb, 0xdf, 0x31, 0x4a, 0x9a, 0x05, 0x87, 0xc6, 0xe6, 0x47, 0xa0, 0x7b, 0xa3,
+	0x11, 0x1b, 0xd5, 0xe1, 0x85, 0x5a, 0xe4, 0x8c, 0xe6, 0x43, 0xd0, 0x26, 0x2c, 0xf1, 0x28, 0x45,
+	0x56, 0x37, 0x5e, 0xbd, 0x30, 0xa1, 0x4f, 0x7d, 0xae, 0x43, 0x4c, 0x54, 0xe5, 0x53, 0xc6, 0x8e,
+	0xeb, 0x35, 0x51, 0xe5, 0x73, 0xd0, 0xfa, 0xef, 0x02, 0x68, 0x54, 0x9a, 0xc9, 0x93, 0x2a, 0x99,
+	0x93, 0x1a, 0x50, 0x98, 0xfa, 0x01, 0x29, 0xaf, 0xec, 0xe0, 0x10, 0x8b, 0xcd, 0xe9, 0xd8, 0xf3,
+	0x83, 0x84, 0x9d, 0x26, 0xe2, 0xa2, 0x9c, 0x23, 0x52, 0x2b, 0x68, 0x19, 0x2b, 0xdc, 0x13, 0x1a,
+	0xe5, 0xfd, 0xdb, 0x2a, 0xd5, 0x84, 0x8d, 0xde, 0x34, 0x89, 0xed, 0x20, 0x89, 0xce, 0x84, 0x8a,
+	0x1f, 0x43, 0xf5, 0xeb, 0x38, 0x0c, 0x06, 0xa2, 0x96, 0x2e, 0x5e, 0x2d, 0x13, 0x20, 0x6f, 0x9f,
+	0x58, 0xcd, 0xb7, 0x41, 0x1f, 0xfb, 0xc1, 0x49, 0x5c, 0x2f, 0xd3, 0xfa, 0x06, 0x5f, 0xbf, 0x83,
+	0x28, 0xbe, 0x01, 0x27, 0x9b, 0xf7, 0xa1, 0x3c, 0xf5, 0xa7, 0x6c, 0xec, 0x07, 0x8c, 0x8a, 0x64,
+	0xcc, 0x60, 0xbb, 0xfe, 0x78, 0xdc, 0x4f, 0xd8, 0xd4, 0x49, 0x49, 0x6b, 0x8f, 0xa0, 0x92, 0x9e,
+	0x4d, 0x1a, 0x59, 0xc9, 0x19, 0xf9, 0x99, 0x37, 0x9e, 0xc9, 0xbe, 0x8b, 0x03, 0x9f, 0xaa, 0x8f,
+	0x95, 0xb5, 0x7f, 0x01, 0x98, 0x6f, 0x7a, 0xc9, 0xcc, 0x3b, 0xd9, 0x99, 0x18, 0x44, 0xc8, 0x9d,
+	0x59, 0xc0, 0xfa, 0x5e, 0x05, 0x0d, 0x71, 0x38, 0x77, 0x16, 0x4b, 0x3b, 0xe0, 0xf0, 0x07, 0x31,
+	0x03, 0x6e, 0xf5, 0x12, 0xcd, 0x90, 0x55, 0x6f, 0xe9, 0xe5, 0xab, 0xd7, 0x0a, 0xa1, 0x2c, 0x97,
+	0xbb, 0x34, 0xd6, 0x1f, 0x0a, 0xf1, 0xd4, 0x17, 0x44, 0x03, 0x89, 0x69, 0x81, 0xf6, 0xed, 0x31,
+	0x0b, 0xc4, 0x4d, 0xb6, 0x42, 0x07, 0x6d, 0x85, 0xc1, 0xc8, 0xc7, 0x1e, 0xd4, 0x21, 0x9a, 0xf5,
+	0x1b, 0x05, 0x96, 0x73, 0x78, 0xdc, 0x16, 0x5d, 0x49, 0x6e, 0x3b, 0x16, 0xb6, 0x3a, 0xd7, 0x34,
+	0xbe, 0x05, 0x6a, 0x38, 0xa5, 0x95, 0x57, 0x36, 0x6e, 0xe4, 0x57, 0x6e, 0xf4, 0xa6, 0x8e, 0x1a,
+	0x4e, 0xcd, 0xf7, 0xa5, 0x94, 0x3c, 0x9f, 0xde, 0xbe, 0x70, 0xd8, 0xa7, 0x48, 0x15, 0xd2, 0x5b,
+	0x9b, 0xa0, 0xf6, 0xa6, 0x66, 0x11, 0x54, 0xfb, 0x73, 0x63, 0x09, 0xff, 0x77, 0xb1, 0x88, 0x29,
+	0x82, 0xba, 0xed, 0x1a, 0x2a, 0xd6, 0x0b, 0xdb, 0xae, 0x6d, 0x14, 0x10, 0xd1, 0x71, 0x0d, 0x0d,
+	0x11, 0x1d, 0x17, 0x2b, 0x17, 0x80, 0xa2, 0xfd, 0x45, 0xbb, 0xef, 0xf6, 0x8d, 0xa2, 0xf5, 0x15,
+	0xac, 0xd0, 0x6d, 0xcf, 0x46, 0xcd, 0x21, 0x35, 0x4e, 0x57, 0x34, 0xda, 0x32, 0x9d, 0xab, 0x0b,
+	0xb6, 0xd4, 0xff, 0x04, 0x66, 0x7e, 0x6d, 0x4a, 0xde, 0xf7, 0xf3, 0xc9, 0x7b, 0xb5, 0x91, 0xe7,
+	0x91, 0x49, 0xfc, 0x67, 0x1a, 0xd4, 0xba, 0x61, 0x32, 0x7f, 0x00, 0x38, 0x7f, 0x4f, 0x5d, 0xf3,
+	0x34, 0xe8, 0x41, 0xde, 0x30, 0x49, 0xef, 0x6f, 0x0e, 0xa0, 0xb4, 0xf1, 0xec, 0xe0, 0x6b, 0x36,
+	0x4c, 0x44, 0x4c, 0x48, 0x10, 0x1b, 0x4a, 0x31, 0x1c, 0x8c, 0x58, 0x3c, 0x14, 0x39, 0xbe, 0x2a,
+	0x70, 0x5b, 0x2c, 0x1e, 0xce, 0xaf, 0xca, 0x62, 0xb6, 0xd7, 0x79, 0x5e, 0x45, 0xfc, 0xb6, 0xa8,
+	0xcc, 0xcb, 0xa2, 0xce, 0xcd, 0x4a, 0x97, 0x6d, 0xf0, 0x65, 0x95, 0x5c, 0xc9, 0x54, 0xc9, 0x26,
+	0x68, 0xd4, 0x03, 0x00, 0x05, 0x34, 0x8d, 0xaf, 0xaa, 0x78, 0xff, 0xa8, 0x88, 0x56, 0xf7, 0x26,
+	0xac, 0x8a, 0xee, 0xd4, 0xb1, 0x5b, 0x76, 0xfb, 0x29, 0xb5, 0xac, 0xaf, 0xc2, 0xcd, 0x66, 0xab,
+	0xd5, 0xdb, 0xef, 0xba, 0x83, 0x3d, 0xdb, 0x76, 0x06, 0x58, 0xe9, 0x52, 0xcd, 0xf8, 0x0a, 0xdc,
+	0xc8, 0x11, 0x3a, 0xf6, 0x13, 0xd7, 0x28, 0x63, 0x8b, 0x9b, 0xe5, 0x53, 0xb1, 0x94, 0x9d, 0xd3,
+	0x0b, 0xe6, 0x0d, 0x58, 0xde, 0xb5, 0xfb, 0xfd, 0xe6, 0xb6, 0x3d, 0x68, 0x6e, 0x61, 0x47, 0xab,
+	0xe1, 0x14, 0x2a, 0x89, 0x05, 0x42, 0x47, 0x1e, 0x51, 0x18, 0x0b, 0x54, 0x11, 0x3b, 0x69, 0x2c,
+	0x8d, 0x05, 0x5c, 0xc2, 0xb3, 0xb6, 0x7a, 0x5d, 0xb7, 0xd9, 0x72, 0x07, 0xad, 0x9d, 0x66, 0x77,
+	0xdb, 0xde, 0x32, 0x2a, 0xa6, 0x09, 0x2b, 0xb2, 0x38, 0x16, 0x8c, 0x80, 0xc7, 0xa4, 0x32, 0x77,
+	0xd0, 0x76, 0xed, 0xdd, 0xc1, 0x93, 0x66, 0xbb, 0x63, 0x6f, 0x19, 0x55, 0xeb, 0x11, 0x18, 0x59,
+	0x95, 0x92, 0xb3, 0xdd, 0xcb, 0x3b, 0xdb, 0x72, 0x4e, 0xe9, 0xd2, 0xd5, 0x7e, 0xa2, 0x80, 0x86,
+	0xaf, 0xad, 0x97, 0xd6, 0x85, 0xcf, 0x7f, 0xad, 0x34, 0xa0, 0xe0, 0x4d, 0x7d, 0xe1, 0x4e, 0x38,
+	0xc4, 0xb2, 0x82, 0xdc, 0x6f, 0x18, 0xca, 0x0c, 0x9b, 0xc2, 0x74, 0x89, 0xe2, 0x03, 0x88, 0x28,
+	0x15, 0x70, 0x4c, 0xf9, 0x3c, 0x1a, 0xcb, 0x52, 0x61, 0x16, 0x8d, 0x31, 0x7b, 0x07, 0xcc, 0x3f,
+	0x3a, 0x3e, 0x08, 0xa3, 0x98, 0xb2, 0x65, 0xc5, 0x99, 0x23, 0xac, 0x3f, 0x29, 0x50, 0xc5, 0x83,
+	0xf6, 0x59, 0x1c, 0x5f, 0x16, 0x12, 0xd8, 0x13, 0x0e, 0x87, 0xf3, 0xa3, 0x0a, 0xc8, 0x7c, 0x1f,
+	0x0a, 0xec, 0x74, 0xba, 0xc0, 0x53, 0x18, 0xb2, 0xa1, 0xc4, 0x11, 0x3b, 0x8c, 0x58, 0x7c, 0x2c,
+	0x43, 0x42, 0x80, 0x18, 0x72, 0x11, 0x2e, 0xb4, 0x40, 0x3d, 0x17, 0x89, 0x95, 0x64, 0x70, 0x15,
+	0xf3, 0xc1, 0x65, 0x66, 0x9e, 0xb9, 0x2a, 0xc2, 0xef, 0x5f, 0x03, 0x6d, 0xe8, 0x1d, 0xf2, 0xf8,
+	0x48, 0x1f, 0xc0, 0x09, 0x65, 0xfd, 0x03, 0xac, 0x66, 0xe4, 0x26, 0xcb, 0x5a, 0x79, 0xcb, 0xd6,
+	0x1a, 0x19, 0x06, 0x69, 0xd8, 0x5f, 0x6a, 0x5c, 0x5f, 0x0e, 0xfb, 0x66, 0xc6, 0xe2, 0x64, 0xa1,
+	0x56, 0x66, 0x1e, 0xbd, 0x85, 0x5c, 0xf4, 0xca, 0xd3, 0x69, 0x17, 0x4e, 0x87, 0x69, 0xe0, 0x28,
+	0x0a, 0x67, 0x53, 0x51, 0xca, 0x71, 0x00, 0x9b, 0x86, 0xf8, 0x2c, 0x18, 0x0e, 0x38, 0x09, 0x88,
+	0x54, 0x41, 0xcc, 0x36, 0x91, 0xef, 0x0b, 0x0d, 0xe8, 0xe2, 0x3e, 0xc8, 0x9c, 0xb3, 0x71, 0x49,
+	0xa3, 0xbe, 0xe0, 0xe3, 0x75, 0x5a, 0x41, 0x96, 0x32, 0x15, 0xe4, 0xc3, 0xb4, 0xc5, 0xae, 0xd0,
+	0x66, 0x37, 0x73, 0x9b, 0x5d, 0xa3, 0xc7, 0xbe, 0x0b, 0x40, 0xd2, 0x0c, 0x68, 0x8b, 0x1a, 0x6d,
+	0x51, 0x21, 0x4c, 0x9f, 0xef, 0x73, 0x83, 0x93, 0x93, 0xc8, 0x0b, 0xe2, 0x43, 0x16, 0x45, 0x6c,
+	0x54, 0x5f, 0x26, 0x2e, 0x83, 0x08, 0xee, 0x1c, 0x7f, 0xae, 0xa7, 0x5a, 0x39, 0xd7, 0x53, 0x59,
+	0x3d, 0x91, 0xc0, 0x2a, 0xa0, 0xf7, 0x5d, 0xec, 0xce, 0x97, 0x78, 0x67, 0xcc, 0x81, 0x02, 0x3e,
+	0x99, 0xd1, 0x70, 0xe0, 0xee, 0x50, 0x1b, 0xad, 0x60, 0xa6, 0xd8, 0xef, 0xe6, 0x70, 0xd4, 0xae,
+	0xb7, 0xbb, 0x9b, 0xbd, 0x2f, 0x0c, 0xd5, 0x7a, 0x0c, 0x45, 0xd1, 0x30, 0x97, 0xa0, 0xd0, 0xb5,
+	0xff, 0xcd, 0x58, 0xca, 0xb6, 0xc8, 0x0a, 0x76, 0xe1, 0xad, 0xde, 0xee, 0x5e, 0xc7, 0x76, 0x6d,
+	0x43, 0xc5, 0x2b, 0x52, 0xe4, 0x95, 0x82, 0x74, 0x3e, 0xa1, 0xaf, 0xe7, 0x3b, 0x9f, 0x60, 0x90,
+	0xce, 0xf7, 0x67, 0x15, 0x6e, 0x92, 0x4f, 0x4a, 0x93, 0x8b, 0xed, 0xcf, 0x3b, 0xe1, 0x1d, 0xa8,
+	0x04, 0xb3, 0xc9, 0x20, 0x09, 0x13, 0x6f, 0x2c, 0x3b, 0xcf, 0x60, 0x36, 0x71, 0x11, 0xc6, 0x57,
+	0x51, 0x24, 0x4e, 0x59, 0x30, 0x92, 0xef, 0x4f, 0xba, 0x03, 0xc1, 0x6c, 0xb2, 0xc7, 0x31, 0x78,
+	0x4b, 0x21, 0xc3, 0x30, 0x9c, 0x4c, 0xc7, 0x4c, 0x34, 0xd9, 0xba, 0x83, 0x93, 0x5a, 0x02, 0x45,
+	0x8e, 0xe8, 0x7f, 0xc7, 0xc4, 0x0e, 0x3a, 0xb7, 0x1a, 0x62, 0xf8, 0x16, 0x78, 0xcf, 0x21, 0x59,
+	0xee, 0x51, 0x24, 0x86, 0x2a, 0xe2, 0xe4, 0x26, 0xf7, 0x60, 0x99, 0x58, 0xd2, 0x5d, 0xb8, 0x77,
+	0xd1, 0xbc, 0x74, 0x9b, 0xf7, 0x84, 0xf5, 0xe3, 0x41, 0x66, 0xb7, 0x32, 0x31, 0xae, 0x72, 0x42,
+	0x3f, 0xdd, 0xf3, 0x23, 0xb8, 0x95, 0xe5, 0x4d, 0xd7, 0xe5, 0x7d, 0x8f, 0x39, 0x67, 0x4f, 0x57,
+	0xbf, 0x05, 0x3a, 0xf7, 0x94, 0x0d, 0x1e, 0x63, 0x04, 0x98, 0xaf, 0x41, 0x99, 0x06, 0x03, 0x7f,
+	0x54, 0xff, 0x84, 0x67, 0x18, 0x82, 0xdb, 0x23, 0xeb, 0x2f, 0x0a, 0x37, 0xdb, 0x8e, 0xeb, 0xee,
+	0xc9, 0xf8, 0x7f, 0x57, 0xc4, 0x9c, 0x42, 0x61, 0xf0, 0x4a, 0xe3, 0x1c, 0x3d, 0x1b, 0x77, 0x22,
+	0x35, 0xab, 0xf3, 0xd4, 0xfc, 0x08, 0x4a, 0xf8, 0xac, 0xcd, 0x22, 0xfe, 0x8d, 0xa7, 0xba, 0x71,
+	0xf7, 0xc2, 0xfc, 0x1d, 0x4e, 0xe7, 0x75, 0xb3, 0xe4, 0xa6, 0x2c, 0xe3, 0x25, 0x32, 0x99, 0xd2,
+	0x78, 0xed, 0x53, 0xa8, 0x65, 0x99, 0xaf, 0x55, 0xf0, 0xde, 0x17, 0xa1, 0x51, 0x82, 0xc2, 0xde,
+	0xbe, 0x6b, 0x2c, 0xe1, 0x53, 0xd2, 0x5e, 0xaf, 0xef, 0xf2, 0xb7, 0xe7, 0x2d, 0x9b, 0xbb, 0x30,
+	0x7e, 0x2d, 0xa2, 0xe4, 0x77, 0x9d, 0x77, 0x9c, 0x6b, 0x7e, 0x34, 0xc9, 0x25, 0x0b, 0xed, 0xca,
+	0xa7, 0x1a, 0xfd, 0xf9, 0x4f, 0x35, 0xc5, 0xdc, 0x53, 0xcd, 0x37, 0xdc, 0x6c, 0xad, 0xb1, 0xcf,
+	0x82, 0xa4, 0x1b, 0x06, 0x43, 0x36, 0x57, 0x85, 0x92, 0x51, 0xc5, 0x15, 0x17, 0xf3, 0x75, 0x3f,
+	0xfd, 0xfc, 0x5a, 0x05, 0x98, 0xef, 0x79, 0x8d, 0xef, 0x95, 0x99, 0x8f, 0xb2, 0x85, 0xc5, 0x3f,
+	0xca, 0x36, 0x40, 0x8b, 0x19, 0x0b, 0x16, 0x79, 0x0f, 0x43, 0x3e, 0x14, 0x3f, 0x09, 0x4f, 0x58,
+	0x20, 0x74, 0xc8, 0x01, 0xdc, 0x9b, 0x9d, 0x4e, 0xfd, 0x68, 0xb1, 0x0f, 0xc2, 0x82, 0x95, 0x1e,
+	0x37, 0x92, 0x30, 0x12, 0x0f, 0x64, 0x05, 0x47, 0x40, 0x14, 0xe1, 0x49, 0x18, 0x79, 0x47, 0x6c,
+	0xf0, 0xcd, 0x2c, 0x4c, 0x3c, 0x11, 0xb8, 0x35, 0x81, 0xfc, 0x1c, 0x71, 0x98, 0x29, 0xf8, 0xe3,
+	0xb3, 0xe0, 0xa9, 0xf0, 0x5c, 0xc3, 0x71, 0xc4, 0x82, 0x8f, 0x51, 0x73, 0x4d, 0x5e, 0xfe, 0x18,
+	0x35, 0xa7, 0xcb, 0x4c, 0xf9, 0x63, 0x25, 0x3b, 0xab, 0x1d, 0x1c, 0x86, 0xe6, 0x3d, 0x28, 0x0e,
+	0x09, 0x22, 0x3b, 0x9c, 0x9b, 0x26, 0x48, 0xd9, 0x0f, 0x94, 0x6a, 0xfe, 0x03, 0x65, 0x03, 0xca,
+	0xe2, 0xf3, 0xbd, 0x0c, 0x56, 0x33, 0xb3, 0x80, 0x88, 0x08, 0x27, 0xe5, 0x41, 0xf1, 0xc5, 0x58,
+	0x7c, 0x3d, 0xe2, 0xce, 0x5c, 0x13, 0x48, 0xfe, 0xf9, 0xe8, 0x7f, 0x55, 0xa8, 0xe0, 0x22, 0x2e,
+	0xe9, 0xff, 0x92, 0x67, 0xb3, 0x79, 0xbc, 0xd6, 0xa4, 0x93, 0x5e, 0x37, 0xa0, 0x6e, 0x81, 0x3e,
+	0xf6, 0x0e, 0x98, 0x2c, 0x1f, 0x39, 0x60, 0x6e, 0x40, 0x91, 0x0c, 0x78, 0xb6, 0x40, 0xe5, 0x25,
+	0x38, 0xf1, 0xe6, 0x98, 0x78, 0xa7, 0x03, 0xae, 0xaa, 0x98, 0x7c, 0x44, 0x77, 0x60, 0xe2, 0x9d,
+	0x72, 0x1d, 0xc4, 0x17, 0x4d, 0x5e, 0x5a, 0xc0, 0xe4, 0xe5, 0x8b, 0x26, 0xff, 0x18, 0x96, 0x53,
+	0xad, 0x90, 0xc5, 0xd7, 0xf3, 0x16, 0x87, 0x46, 0x4a, 0x96, 0x06, 0xff, 0x7f, 0x61, 0x70, 0x42,
+	0xee, 0x53, 0x76, 0x5a, 0x97, 0x4e, 0xce, 0xed, 0x9d, 0x9b, 0x44, 0x04, 0xb4, 0xb6, 0x14, 0x86,
+	0xdf, 0x92, 0x12, 0xcc, 0x38, 0x75, 0x21, 0xe7, 0xd4, 0x19, 0xff, 0xe0, 0xf6, 0x94, 0x20, 0xe6,
+	0xad, 0xd4, 0x3f, 0xc4, 0x13, 0xb3, 0x84, 0xb1, 0x6b, 0xcd, 0x9f, 0xed, 0xf2, 0xae, 0x35, 0xcf,
+	0x23, 0x25, 0x3b, 0x81, 0x12, 0x12, 0x36, 0xbd, 0xab, 0x3e, 0x58, 0xdf, 0x86, 0x62, 0xc4, 0xbc,
+	0x38, 0x0c, 0x64, 0x99, 0xce, 0xa1, 0x6b, 0xe7, 0xad, 0x0f, 0x78, 0x82, 0xdf, 0xf4, 0x82, 0xe7,
+	0x7c, 0x58, 0xe7, 0x44, 0x79, 0xb6, 0xaf, 0xc0, 0x98, 0xc7, 0xc0, 0x73, 0x3e, 0x75, 0xdf, 0x4e,
+	0xe3, 0x4e, 0x1c, 0x8d, 0x43, 0xe6, 0x1b, 0x00, 0x43, 0x7f, 0x7a, 0xcc, 0xa2, 0xf4, 0x59, 0xa9,
+	0xe6, 0x64, 0x30, 0xd6, 0x7f, 0xc2, 0x8d, 0x0b, 0xf1, 0xb5, 0x68, 0xb9, 0x2d, 0x36, 0x2c, 0xe4,
+	0x36, 0xbc, 0xe6, 0x47, 0x03, 0xeb, 0x3f, 0xb2, 0xc2, 0xf5, 0x78, 0x93, 0xb1, 0xa8, 0x70, 0xb2,
+	0x66, 0x2e, 0x64, 0x6a, 0xe6, 0xeb, 0xee, 0xff, 0xef, 0xb2, 0xd3, 0x98, 0x8e, 0xfd, 0xa1, 0x77,
+	0x61, 0xeb, 0x35, 0x28, 0xcb, 0x36, 0x4e, 0x3e, 0x38, 0x4b, 0x38, 0xed, 0xf7, 0xb9, 0x56, 0x69,
+	0x7c, 0xed, 0xed, 0xbf, 0x57, 0x40, 0xdf, 0x0c, 0x93, 0xcf, 0x9e, 0xbe, 0xa8, 0x92, 0x48, 0x33,
+	0xd3, 0xdf, 0x76, 0x77, 0x65, 0x7e, 0x50, 0xa4, 0x2d, 0xfc, 0x83, 0xa2, 0xcd, 0x9b, 0xb0, 0xec,
+	0x87, 0x0d, 0x74, 0x14, 0x1f, 0x39, 0x0f, 0xbe, 0x52, 0xa7, 0x07, 0x07, 0x45, 0x9a, 0xf1, 0xc9,
+	0x5f, 0x07, 0x00, 0x70, 0x6b, 0x30, 0xb7, 0xb5, 0x25, 0x00, 0x00,
 }
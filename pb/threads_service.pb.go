@@ -22,6 +22,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ThreadPresence_Type int32
+
+const (
+	ThreadPresence_READ   ThreadPresence_Type = 0
+	ThreadPresence_TYPING ThreadPresence_Type = 1
+)
+
+var ThreadPresence_Type_name = map[int32]string{
+	0: "READ",
+	1: "TYPING",
+}
+
+var ThreadPresence_Type_value = map[string]int32{
+	"READ":   0,
+	"TYPING": 1,
+}
+
+func (x ThreadPresence_Type) String() string {
+	return proto.EnumName(ThreadPresence_Type_name, int32(x))
+}
+
+func (ThreadPresence_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{3, 0}
+}
+
 // for wire transport
 type ThreadEnvelope struct {
 	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
@@ -143,6 +168,117 @@ func (m *ThreadEnvelopeAck) GetId() string {
 	return ""
 }
 
+// for ephemeral pubsub transport on a thread's presence topic
+type ThreadPresenceEnvelope struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Ciphertext           []byte   `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadPresenceEnvelope) Reset()         { *m = ThreadPresenceEnvelope{} }
+func (m *ThreadPresenceEnvelope) String() string { return proto.CompactTextString(m) }
+func (*ThreadPresenceEnvelope) ProtoMessage()    {}
+func (*ThreadPresenceEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{2}
+}
+
+func (m *ThreadPresenceEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadPresenceEnvelope.Unmarshal(m, b)
+}
+func (m *ThreadPresenceEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadPresenceEnvelope.Marshal(b, m, deterministic)
+}
+func (m *ThreadPresenceEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadPresenceEnvelope.Merge(m, src)
+}
+func (m *ThreadPresenceEnvelope) XXX_Size() int {
+	return xxx_messageInfo_ThreadPresenceEnvelope.Size(m)
+}
+func (m *ThreadPresenceEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadPresenceEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadPresenceEnvelope proto.InternalMessageInfo
+
+func (m *ThreadPresenceEnvelope) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadPresenceEnvelope) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+type ThreadPresence struct {
+	Type                 ThreadPresence_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=ThreadPresence_Type" json:"type,omitempty"`
+	Block                string               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Typing               bool                 `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ThreadPresence) Reset()         { *m = ThreadPresence{} }
+func (m *ThreadPresence) String() string { return proto.CompactTextString(m) }
+func (*ThreadPresence) ProtoMessage()    {}
+func (*ThreadPresence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{3}
+}
+
+func (m *ThreadPresence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadPresence.Unmarshal(m, b)
+}
+func (m *ThreadPresence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadPresence.Marshal(b, m, deterministic)
+}
+func (m *ThreadPresence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadPresence.Merge(m, src)
+}
+func (m *ThreadPresence) XXX_Size() int {
+	return xxx_messageInfo_ThreadPresence.Size(m)
+}
+func (m *ThreadPresence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadPresence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadPresence proto.InternalMessageInfo
+
+func (m *ThreadPresence) GetType() ThreadPresence_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ThreadPresence_READ
+}
+
+func (m *ThreadPresence) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ThreadPresence) GetTyping() bool {
+	if m != nil {
+		return m.Typing
+	}
+	return false
+}
+
+func (m *ThreadPresence) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 type ThreadBlock struct {
 	Header               *ThreadBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Type                 Block_BlockType    `protobuf:"varint,2,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
@@ -156,7 +292,7 @@ func (m *ThreadBlock) Reset()         { *m = ThreadBlock{} }
 func (m *ThreadBlock) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlock) ProtoMessage()    {}
 func (*ThreadBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{2}
+	return fileDescriptor_402f4f9ff5658127, []int{4}
 }
 
 func (m *ThreadBlock) XXX_Unmarshal(b []byte) error {
@@ -212,7 +348,7 @@ func (m *ThreadBlockHeader) Reset()         { *m = ThreadBlockHeader{} }
 func (m *ThreadBlockHeader) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlockHeader) ProtoMessage()    {}
 func (*ThreadBlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{3}
+	return fileDescriptor_402f4f9ff5658127, []int{5}
 }
 
 func (m *ThreadBlockHeader) XXX_Unmarshal(b []byte) error {
@@ -275,7 +411,7 @@ func (m *ThreadAdd) Reset()         { *m = ThreadAdd{} }
 func (m *ThreadAdd) String() string { return proto.CompactTextString(m) }
 func (*ThreadAdd) ProtoMessage()    {}
 func (*ThreadAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{4}
+	return fileDescriptor_402f4f9ff5658127, []int{6}
 }
 
 func (m *ThreadAdd) XXX_Unmarshal(b []byte) error {
@@ -329,7 +465,7 @@ func (m *ThreadIgnore) Reset()         { *m = ThreadIgnore{} }
 func (m *ThreadIgnore) String() string { return proto.CompactTextString(m) }
 func (*ThreadIgnore) ProtoMessage()    {}
 func (*ThreadIgnore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{5}
+	return fileDescriptor_402f4f9ff5658127, []int{7}
 }
 
 func (m *ThreadIgnore) XXX_Unmarshal(b []byte) error {
@@ -369,7 +505,7 @@ func (m *ThreadFlag) Reset()         { *m = ThreadFlag{} }
 func (m *ThreadFlag) String() string { return proto.CompactTextString(m) }
 func (*ThreadFlag) ProtoMessage()    {}
 func (*ThreadFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{6}
+	return fileDescriptor_402f4f9ff5658127, []int{8}
 }
 
 func (m *ThreadFlag) XXX_Unmarshal(b []byte) error {
@@ -409,7 +545,7 @@ func (m *ThreadJoin) Reset()         { *m = ThreadJoin{} }
 func (m *ThreadJoin) String() string { return proto.CompactTextString(m) }
 func (*ThreadJoin) ProtoMessage()    {}
 func (*ThreadJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{7}
+	return fileDescriptor_402f4f9ff5658127, []int{9}
 }
 
 func (m *ThreadJoin) XXX_Unmarshal(b []byte) error {
@@ -456,7 +592,7 @@ func (m *ThreadAnnounce) Reset()         { *m = ThreadAnnounce{} }
 func (m *ThreadAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ThreadAnnounce) ProtoMessage()    {}
 func (*ThreadAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{8}
+	return fileDescriptor_402f4f9ff5658127, []int{10}
 }
 
 func (m *ThreadAnnounce) XXX_Unmarshal(b []byte) error {
@@ -502,7 +638,7 @@ func (m *ThreadMessage) Reset()         { *m = ThreadMessage{} }
 func (m *ThreadMessage) String() string { return proto.CompactTextString(m) }
 func (*ThreadMessage) ProtoMessage()    {}
 func (*ThreadMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{9}
+	return fileDescriptor_402f4f9ff5658127, []int{11}
 }
 
 func (m *ThreadMessage) XXX_Unmarshal(b []byte) error {
@@ -543,7 +679,7 @@ func (m *ThreadFiles) Reset()         { *m = ThreadFiles{} }
 func (m *ThreadFiles) String() string { return proto.CompactTextString(m) }
 func (*ThreadFiles) ProtoMessage()    {}
 func (*ThreadFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{10}
+	return fileDescriptor_402f4f9ff5658127, []int{12}
 }
 
 func (m *ThreadFiles) XXX_Unmarshal(b []byte) error {
@@ -599,7 +735,7 @@ func (m *ThreadComment) Reset()         { *m = ThreadComment{} }
 func (m *ThreadComment) String() string { return proto.CompactTextString(m) }
 func (*ThreadComment) ProtoMessage()    {}
 func (*ThreadComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{11}
+	return fileDescriptor_402f4f9ff5658127, []int{13}
 }
 
 func (m *ThreadComment) XXX_Unmarshal(b []byte) error {
@@ -646,7 +782,7 @@ func (m *ThreadLike) Reset()         { *m = ThreadLike{} }
 func (m *ThreadLike) String() string { return proto.CompactTextString(m) }
 func (*ThreadLike) ProtoMessage()    {}
 func (*ThreadLike) Descriptor() ([]byte, []int) {
-	return fileDescriptor_402f4f9ff5658127, []int{12}
+	return fileDescriptor_402f4f9ff5658127, []int{14}
 }
 
 func (m *ThreadLike) XXX_Unmarshal(b []byte) error {
@@ -674,9 +810,184 @@ func (m *ThreadLike) GetTarget() string {
 	return ""
 }
 
+type ThreadReaction struct {
+	Emoji                string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadReaction) Reset()         { *m = ThreadReaction{} }
+func (m *ThreadReaction) String() string { return proto.CompactTextString(m) }
+func (*ThreadReaction) ProtoMessage()    {}
+func (*ThreadReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{15}
+}
+
+func (m *ThreadReaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadReaction.Unmarshal(m, b)
+}
+func (m *ThreadReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadReaction.Marshal(b, m, deterministic)
+}
+func (m *ThreadReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadReaction.Merge(m, src)
+}
+func (m *ThreadReaction) XXX_Size() int {
+	return xxx_messageInfo_ThreadReaction.Size(m)
+}
+func (m *ThreadReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadReaction proto.InternalMessageInfo
+
+func (m *ThreadReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+type ThreadSchema struct {
+	Schema               string   `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Previous             string   `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadSchema) Reset()         { *m = ThreadSchema{} }
+func (m *ThreadSchema) String() string { return proto.CompactTextString(m) }
+func (*ThreadSchema) ProtoMessage()    {}
+func (*ThreadSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{16}
+}
+
+func (m *ThreadSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadSchema.Unmarshal(m, b)
+}
+func (m *ThreadSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadSchema.Marshal(b, m, deterministic)
+}
+func (m *ThreadSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadSchema.Merge(m, src)
+}
+func (m *ThreadSchema) XXX_Size() int {
+	return xxx_messageInfo_ThreadSchema.Size(m)
+}
+func (m *ThreadSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadSchema proto.InternalMessageInfo
+
+func (m *ThreadSchema) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *ThreadSchema) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+type ThreadBlocklist struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadBlocklist) Reset()         { *m = ThreadBlocklist{} }
+func (m *ThreadBlocklist) String() string { return proto.CompactTextString(m) }
+func (*ThreadBlocklist) ProtoMessage()    {}
+func (*ThreadBlocklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{17}
+}
+
+func (m *ThreadBlocklist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadBlocklist.Unmarshal(m, b)
+}
+func (m *ThreadBlocklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadBlocklist.Marshal(b, m, deterministic)
+}
+func (m *ThreadBlocklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadBlocklist.Merge(m, src)
+}
+func (m *ThreadBlocklist) XXX_Size() int {
+	return xxx_messageInfo_ThreadBlocklist.Size(m)
+}
+func (m *ThreadBlocklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadBlocklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadBlocklist proto.InternalMessageInfo
+
+func (m *ThreadBlocklist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type ThreadReadMarker struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Block                string   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadReadMarker) Reset()         { *m = ThreadReadMarker{} }
+func (m *ThreadReadMarker) String() string { return proto.CompactTextString(m) }
+func (*ThreadReadMarker) ProtoMessage()    {}
+func (*ThreadReadMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_402f4f9ff5658127, []int{18}
+}
+
+func (m *ThreadReadMarker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadReadMarker.Unmarshal(m, b)
+}
+func (m *ThreadReadMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadReadMarker.Marshal(b, m, deterministic)
+}
+func (m *ThreadReadMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadReadMarker.Merge(m, src)
+}
+func (m *ThreadReadMarker) XXX_Size() int {
+	return xxx_messageInfo_ThreadReadMarker.Size(m)
+}
+func (m *ThreadReadMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadReadMarker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadReadMarker proto.InternalMessageInfo
+
+func (m *ThreadReadMarker) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadReadMarker) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("ThreadPresence_Type", ThreadPresence_Type_name, ThreadPresence_Type_value)
 	proto.RegisterType((*ThreadEnvelope)(nil), "ThreadEnvelope")
 	proto.RegisterType((*ThreadEnvelopeAck)(nil), "ThreadEnvelopeAck")
+	proto.RegisterType((*ThreadPresenceEnvelope)(nil), "ThreadPresenceEnvelope")
+	proto.RegisterType((*ThreadPresence)(nil), "ThreadPresence")
 	proto.RegisterType((*ThreadBlock)(nil), "ThreadBlock")
 	proto.RegisterType((*ThreadBlockHeader)(nil), "ThreadBlockHeader")
 	proto.RegisterType((*ThreadAdd)(nil), "ThreadAdd")
@@ -689,49 +1000,64 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "ThreadFiles.KeysEntry")
 	proto.RegisterType((*ThreadComment)(nil), "ThreadComment")
 	proto.RegisterType((*ThreadLike)(nil), "ThreadLike")
+	proto.RegisterType((*ThreadReaction)(nil), "ThreadReaction")
+	proto.RegisterType((*ThreadSchema)(nil), "ThreadSchema")
+	proto.RegisterType((*ThreadBlocklist)(nil), "ThreadBlocklist")
+	proto.RegisterType((*ThreadReadMarker)(nil), "ThreadReadMarker")
 }
 
 func init() { proto.RegisterFile("threads_service.proto", fileDescriptor_402f4f9ff5658127) }
 
 var fileDescriptor_402f4f9ff5658127 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x45, 0xb2, 0x62, 0xe3, 0x71, 0x12, 0xd2, 0x6d, 0x1a, 0x14, 0x53, 0x88, 0x51, 0x7a, 0x30,
-	0x39, 0x28, 0xe0, 0x1e, 0x5a, 0x72, 0x09, 0x4e, 0x49, 0xe8, 0x27, 0x14, 0x91, 0x53, 0x2f, 0x65,
-	0x6d, 0x4d, 0xe5, 0xc5, 0xf2, 0xae, 0xd8, 0x5d, 0x9b, 0xea, 0x57, 0xf4, 0xd0, 0x3f, 0x50, 0xfa,
-	0x4b, 0x8b, 0xf6, 0x43, 0x71, 0x9a, 0x36, 0x17, 0xb3, 0x6f, 0xe6, 0xed, 0xce, 0x9b, 0x99, 0x27,
-	0xc3, 0x33, 0xbd, 0x90, 0x48, 0x73, 0xf5, 0x55, 0xa1, 0xdc, 0xb0, 0x39, 0xa6, 0x95, 0x14, 0x5a,
-	0x0c, 0x8f, 0x0b, 0x21, 0x8a, 0x12, 0xcf, 0x0d, 0x9a, 0xad, 0xbf, 0x9d, 0x53, 0x5e, 0xbb, 0xd4,
-	0xc9, 0xdf, 0x29, 0xcd, 0x56, 0xa8, 0x34, 0x5d, 0x55, 0x8e, 0x30, 0x58, 0x89, 0x1c, 0x4b, 0x0b,
-	0x92, 0x5f, 0x01, 0xec, 0xdf, 0x9a, 0x12, 0xd7, 0x7c, 0x83, 0xa5, 0xa8, 0x90, 0x1c, 0x41, 0xd7,
-	0x16, 0x8d, 0x83, 0x51, 0x30, 0xee, 0x67, 0x0e, 0x91, 0x23, 0x88, 0x16, 0x54, 0x2d, 0xe2, 0xb0,
-	0x89, 0x5e, 0x85, 0x71, 0x90, 0x19, 0x4c, 0x12, 0x80, 0x39, 0xab, 0x16, 0x28, 0x35, 0x7e, 0xd7,
-	0x71, 0x67, 0x14, 0x8c, 0x77, 0x4d, 0x76, 0x2b, 0x4a, 0x0e, 0xa0, 0xa3, 0x58, 0x11, 0x47, 0x4d,
-	0x32, 0x6b, 0x8e, 0x84, 0x40, 0xc4, 0x45, 0x8e, 0xf1, 0x8e, 0x09, 0x99, 0x33, 0x39, 0x84, 0x9d,
-	0x59, 0x29, 0xe6, 0xcb, 0xb8, 0x6b, 0x82, 0x16, 0x24, 0xa7, 0xf0, 0xe4, 0xbe, 0xc2, 0xe9, 0x7c,
-	0x49, 0xf6, 0x21, 0x64, 0x5e, 0x60, 0xc8, 0xf2, 0xe4, 0x47, 0x00, 0x03, 0xcb, 0xba, 0x6a, 0x2e,
-	0x91, 0x33, 0xe8, 0x2e, 0x90, 0xe6, 0x28, 0x0d, 0x67, 0x30, 0x21, 0xe9, 0x56, 0xf6, 0xad, 0xc9,
-	0x64, 0x8e, 0x41, 0x5e, 0x40, 0xa4, 0xeb, 0x0a, 0x4d, 0x63, 0xfb, 0x93, 0x83, 0xd4, 0x70, 0xec,
-	0xef, 0x6d, 0x5d, 0x61, 0x66, 0xb2, 0x24, 0x85, 0x5e, 0x45, 0xeb, 0x52, 0xd0, 0xdc, 0xf4, 0x38,
-	0x98, 0x1c, 0xa6, 0x76, 0xd2, 0xa9, 0x9f, 0x74, 0x3a, 0xe5, 0x75, 0xe6, 0x49, 0xc9, 0xcf, 0xc0,
-	0xeb, 0xde, 0xaa, 0x49, 0x52, 0x88, 0x72, 0xaa, 0xd1, 0xa9, 0x1a, 0x3e, 0x78, 0xe2, 0xd6, 0x2f,
-	0x2b, 0x33, 0x3c, 0xf2, 0xbc, 0xa9, 0x2a, 0x91, 0x6b, 0x15, 0x87, 0xa3, 0x8e, 0x9b, 0xbb, 0x0f,
-	0x35, 0xab, 0xa2, 0x6b, 0xbd, 0x10, 0xd2, 0x48, 0xea, 0x67, 0x0e, 0x91, 0x18, 0x7a, 0x34, 0xcf,
-	0x25, 0x2a, 0x65, 0x46, 0xde, 0xcf, 0x3c, 0x4c, 0x0a, 0xe8, 0x5b, 0x51, 0xd3, 0x3c, 0x27, 0x27,
-	0xd0, 0x63, 0x7c, 0xc3, 0x74, 0x3b, 0xa5, 0x9d, 0xf4, 0x33, 0xa2, 0xcc, 0x7c, 0x94, 0x9c, 0xb4,
-	0x56, 0x08, 0x4d, 0xbe, 0xe7, 0xa6, 0xd8, 0x7a, 0x22, 0xf6, 0x2f, 0xa0, 0x53, 0xe0, 0x61, 0x72,
-	0x06, 0xbb, 0x96, 0xfb, 0xae, 0xe0, 0x42, 0x5a, 0x57, 0x51, 0x59, 0xa0, 0x6e, 0x5d, 0x65, 0xd0,
-	0x45, 0x18, 0x07, 0xc9, 0x18, 0xc0, 0x72, 0x6f, 0x4a, 0x5a, 0x3c, 0xca, 0x9c, 0x7a, 0xe6, 0x7b,
-	0xc1, 0xf8, 0x5d, 0x75, 0xe9, 0xa8, 0xad, 0xf0, 0x63, 0x88, 0x2a, 0x44, 0xe9, 0x64, 0xbb, 0xb6,
-	0x4c, 0x28, 0xb9, 0xf4, 0x86, 0x9f, 0x72, 0x2e, 0xd6, 0x7c, 0x8e, 0x2d, 0x39, 0x78, 0x40, 0x36,
-	0x2e, 0xa5, 0x2b, 0x6b, 0x8d, 0x7e, 0x66, 0xce, 0xc9, 0x29, 0xec, 0xd9, 0x07, 0x3e, 0xa1, 0x52,
-	0xb4, 0xc0, 0x86, 0x34, 0x13, 0x79, 0xed, 0x34, 0x98, 0x73, 0xf2, 0xbb, 0xf5, 0xe3, 0x0d, 0x2b,
-	0x51, 0x91, 0xe1, 0xfd, 0xa6, 0xcc, 0x1a, 0x5d, 0xa4, 0xbd, 0x1f, 0xde, 0xdd, 0x27, 0x67, 0x10,
-	0x2d, 0xb1, 0x56, 0x71, 0x67, 0xd4, 0x19, 0x0f, 0x26, 0x47, 0xe9, 0xd6, 0x5b, 0xe9, 0x07, 0xac,
-	0xd5, 0x35, 0xd7, 0xb2, 0xce, 0x0c, 0x67, 0xf8, 0x0a, 0xfa, 0x6d, 0xa8, 0xf9, 0xd2, 0x96, 0xe8,
-	0xb5, 0x34, 0xc7, 0xe6, 0xab, 0xda, 0xd0, 0x72, 0xed, 0x9b, 0xb0, 0xe0, 0x22, 0x7c, 0x1d, 0x24,
-	0x97, 0xbe, 0x93, 0x37, 0x62, 0xb5, 0x42, 0xae, 0xff, 0x37, 0xfa, 0x7f, 0x29, 0xbc, 0xbf, 0xb8,
-	0x8f, 0x6c, 0xf9, 0xe8, 0x8a, 0xaf, 0x9e, 0xc2, 0x1e, 0x13, 0x69, 0xf3, 0x5f, 0xc0, 0x1a, 0xbb,
-	0xcf, 0xbe, 0x84, 0xd5, 0x6c, 0xd6, 0x35, 0xb6, 0x7f, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x00,
-	0x27, 0xfa, 0x31, 0xe5, 0x04, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xc7, 0x8e, 0x9b, 0x36, 0x93, 0xbb, 0x12, 0x96, 0x52, 0xf9, 0xa2, 0x8a, 0x56, 0x7b, 0x08,
+	0x45, 0x7d, 0x70, 0xa5, 0xf0, 0x00, 0xba, 0x97, 0x23, 0x85, 0x1e, 0x1c, 0x70, 0xa8, 0x32, 0x7d,
+	0x81, 0x17, 0xb4, 0x89, 0x07, 0x67, 0x89, 0xe3, 0xb5, 0x76, 0x9d, 0x08, 0x7f, 0x0a, 0x1e, 0xf8,
+	0x02, 0x88, 0x0f, 0xc1, 0xe7, 0x43, 0x3b, 0xde, 0x75, 0x13, 0xee, 0xae, 0xf0, 0x12, 0xed, 0x6f,
+	0xe6, 0x97, 0x99, 0xdf, 0xfc, 0x33, 0x7c, 0x50, 0x2f, 0x35, 0x8a, 0xcc, 0xfc, 0x6c, 0x50, 0x6f,
+	0xe5, 0x02, 0x93, 0x4a, 0xab, 0x5a, 0x8d, 0x9f, 0xe4, 0x4a, 0xe5, 0x05, 0x5e, 0x11, 0x9a, 0x6f,
+	0x7e, 0xb9, 0x12, 0x65, 0xe3, 0x5c, 0xe7, 0xff, 0x76, 0xd5, 0x72, 0x8d, 0xa6, 0x16, 0xeb, 0xca,
+	0x11, 0x86, 0x6b, 0x95, 0x61, 0xd1, 0x02, 0xfe, 0x67, 0x00, 0xc7, 0x77, 0x94, 0xe2, 0xa6, 0xdc,
+	0x62, 0xa1, 0x2a, 0x64, 0xa7, 0xd0, 0x6f, 0x93, 0xc6, 0xc1, 0x45, 0x30, 0x19, 0xa4, 0x0e, 0xb1,
+	0x53, 0x88, 0x96, 0xc2, 0x2c, 0xe3, 0xd0, 0x5a, 0xaf, 0xc3, 0x38, 0x48, 0x09, 0x33, 0x0e, 0xb0,
+	0x90, 0xd5, 0x12, 0x75, 0x8d, 0xbf, 0xd5, 0x71, 0xef, 0x22, 0x98, 0x3c, 0x22, 0xef, 0x8e, 0x95,
+	0x8d, 0xa0, 0x67, 0x64, 0x1e, 0x47, 0xd6, 0x99, 0xda, 0x27, 0x63, 0x10, 0x95, 0x2a, 0xc3, 0xf8,
+	0x80, 0x4c, 0xf4, 0x66, 0x27, 0x70, 0x30, 0x2f, 0xd4, 0x62, 0x15, 0xf7, 0xc9, 0xd8, 0x02, 0xfe,
+	0x14, 0xde, 0xdb, 0x57, 0x38, 0x5b, 0xac, 0xd8, 0x31, 0x84, 0xd2, 0x0b, 0x0c, 0x65, 0xc6, 0x6f,
+	0xe1, 0xb4, 0x25, 0xdd, 0x6a, 0x34, 0x58, 0x2e, 0xf0, 0x3f, 0xcb, 0xf9, 0x70, 0x4f, 0x76, 0x48,
+	0x19, 0x77, 0x2c, 0xfc, 0xef, 0xae, 0x33, 0x3e, 0x24, 0x9b, 0x40, 0x54, 0x37, 0x15, 0x52, 0xa0,
+	0xe3, 0xe9, 0x49, 0xb2, 0xef, 0x4e, 0xee, 0x9a, 0x0a, 0x53, 0x62, 0xdc, 0x57, 0x42, 0xcd, 0x72,
+	0x95, 0x90, 0x94, 0xa6, 0x92, 0x65, 0x4e, 0x5d, 0x3a, 0x4a, 0x1d, 0x62, 0x09, 0x44, 0x99, 0xa8,
+	0x91, 0xda, 0x33, 0x9c, 0x8e, 0x93, 0x76, 0x82, 0x89, 0x9f, 0x60, 0x72, 0xe7, 0x27, 0x98, 0x12,
+	0x8f, 0x9f, 0x41, 0x64, 0x73, 0xb1, 0x23, 0x88, 0xd2, 0x9b, 0xd9, 0x97, 0xa3, 0x77, 0x18, 0x40,
+	0xff, 0xee, 0xc7, 0xdb, 0x97, 0xdf, 0x7f, 0x35, 0x0a, 0xf8, 0xef, 0x01, 0x0c, 0x5b, 0x65, 0xd7,
+	0x94, 0xf5, 0x12, 0xfa, 0x4b, 0x14, 0x19, 0x6a, 0xd2, 0x3d, 0x9c, 0xb2, 0x64, 0xc7, 0xfb, 0x35,
+	0x79, 0x52, 0xc7, 0x60, 0x1f, 0xb9, 0x0a, 0x43, 0xaa, 0x70, 0x94, 0x10, 0xa7, 0xfd, 0xdd, 0xa9,
+	0x2e, 0x81, 0xc3, 0x4a, 0x34, 0x85, 0x12, 0x19, 0x15, 0x32, 0x9c, 0x9e, 0xbc, 0x26, 0x79, 0x56,
+	0x36, 0xa9, 0x27, 0xf1, 0x3f, 0x02, 0x3f, 0xc2, 0x9d, 0x9c, 0x5d, 0xd5, 0xc1, 0xff, 0xab, 0x9a,
+	0x9d, 0xd9, 0xac, 0x1a, 0xcb, 0xda, 0xc4, 0xe1, 0x45, 0xcf, 0xad, 0xa0, 0x37, 0xd9, 0xde, 0x8a,
+	0x4d, 0xbd, 0x54, 0x9a, 0x24, 0x0d, 0x52, 0x87, 0x58, 0x0c, 0x87, 0x22, 0xcb, 0x34, 0x1a, 0x43,
+	0xed, 0x1d, 0xa4, 0x1e, 0xf2, 0x1c, 0x06, 0xad, 0xa8, 0x59, 0x96, 0xb1, 0x73, 0x38, 0x94, 0xe5,
+	0x56, 0xd6, 0x5d, 0x97, 0x0e, 0x92, 0x5b, 0x44, 0x9d, 0x7a, 0x2b, 0x3b, 0xef, 0xd6, 0x28, 0x24,
+	0xff, 0xa1, 0xeb, 0x62, 0xb7, 0x4f, 0xb1, 0x8f, 0x80, 0x4e, 0x81, 0x87, 0xfc, 0x12, 0x1e, 0xb5,
+	0xdc, 0x97, 0x79, 0xa9, 0x74, 0xbb, 0x91, 0x42, 0xe7, 0x58, 0x77, 0x1b, 0x49, 0xe8, 0x59, 0x18,
+	0x07, 0x7c, 0x02, 0xd0, 0x72, 0x5f, 0x14, 0x22, 0x7f, 0x90, 0x39, 0xf3, 0xcc, 0x6f, 0x94, 0x2c,
+	0x59, 0xbc, 0xaf, 0x7f, 0x70, 0x2f, 0xfc, 0x09, 0x44, 0x15, 0xa2, 0x8e, 0xc3, 0xdd, 0xb2, 0xc8,
+	0xc4, 0x9f, 0xfb, 0x0d, 0x9f, 0x95, 0xa5, 0xda, 0xd8, 0x0d, 0xf7, 0xe4, 0xe0, 0x35, 0x32, 0x1d,
+	0xac, 0x58, 0xa3, 0xdb, 0x68, 0x7a, 0xf3, 0xa7, 0xf0, 0xb8, 0x0d, 0xf0, 0x0a, 0x8d, 0x11, 0x39,
+	0x5a, 0xd2, 0x5c, 0x65, 0x8d, 0xd3, 0x40, 0x6f, 0xfe, 0x57, 0xb7, 0x8f, 0x2f, 0x64, 0x81, 0x86,
+	0x8d, 0xf7, 0x8b, 0xa2, 0x31, 0x3a, 0x4b, 0xf7, 0xff, 0xf0, 0xfe, 0xff, 0xec, 0x12, 0xa2, 0x15,
+	0x36, 0x26, 0xee, 0x5d, 0xf4, 0x26, 0xc3, 0xe9, 0x69, 0xb2, 0x13, 0x2b, 0xf9, 0x16, 0x1b, 0x73,
+	0x53, 0xd6, 0xba, 0x49, 0x89, 0x33, 0xfe, 0x14, 0x06, 0x9d, 0xc9, 0x7e, 0x74, 0x56, 0xe8, 0xb5,
+	0xd8, 0xa7, 0x3d, 0xcb, 0xad, 0x28, 0x36, 0xbe, 0x88, 0x16, 0x3c, 0x0b, 0x3f, 0x0b, 0xf8, 0x73,
+	0x5f, 0xc9, 0x17, 0x6a, 0xbd, 0xc6, 0xb2, 0x7e, 0x5b, 0xeb, 0xdf, 0xa4, 0x70, 0x7f, 0x70, 0xdf,
+	0xc9, 0xd5, 0xc3, 0x23, 0xfe, 0xd8, 0x77, 0x3d, 0x45, 0xb1, 0xa8, 0xa5, 0x2a, 0xad, 0x2c, 0x5c,
+	0xab, 0x5f, 0xa5, 0x23, 0xb7, 0x80, 0x5f, 0xfb, 0xb5, 0xf9, 0x61, 0xb1, 0xc4, 0xb5, 0xb0, 0x31,
+	0x0d, 0xbd, 0x7c, 0xcc, 0x16, 0xb1, 0x31, 0x1c, 0x55, 0x1a, 0xb7, 0x52, 0x6d, 0x8c, 0x53, 0xd5,
+	0x61, 0x7e, 0x05, 0xef, 0xee, 0x1c, 0x5e, 0x21, 0x4d, 0xcd, 0xce, 0x60, 0xe0, 0x2e, 0x00, 0x4d,
+	0x1c, 0xd8, 0x43, 0x4a, 0xef, 0x0d, 0xfc, 0x73, 0x18, 0x75, 0xe2, 0xb2, 0x57, 0x42, 0xaf, 0x50,
+	0xbf, 0xf5, 0x0b, 0xfa, 0xc6, 0x8f, 0xdc, 0xf5, 0xfb, 0xf0, 0x58, 0xaa, 0xc4, 0x7e, 0x42, 0xa5,
+	0xbd, 0xe6, 0xf9, 0x4f, 0x61, 0x35, 0x9f, 0xf7, 0xe9, 0xaa, 0x3f, 0xf9, 0x67, 0x00, 0x76, 0xa0,
+	0xdd, 0x5c, 0xcf, 0x06, 0x00, 0x00,
 }
@@ -109,7 +109,60 @@ func (x AccountUpdate_Type) String() string {
 }
 
 func (AccountUpdate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{27, 0}
+	return fileDescriptor_10c1b2aca93c333f, []int{30, 0}
+}
+
+type QueueItem_Queue int32
+
+const (
+	QueueItem_BLOCK_OUTBOX QueueItem_Queue = 0
+	QueueItem_CAFE_OUTBOX  QueueItem_Queue = 1
+	QueueItem_CAFE_INBOX   QueueItem_Queue = 2
+)
+
+var QueueItem_Queue_name = map[int32]string{
+	0: "BLOCK_OUTBOX",
+	1: "CAFE_OUTBOX",
+	2: "CAFE_INBOX",
+}
+
+var QueueItem_Queue_value = map[string]int32{
+	"BLOCK_OUTBOX": 0,
+	"CAFE_OUTBOX":  1,
+	"CAFE_INBOX":   2,
+}
+
+func (x QueueItem_Queue) String() string {
+	return proto.EnumName(QueueItem_Queue_name, int32(x))
+}
+
+func (QueueItem_Queue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{32, 0}
+}
+
+type AuditEntry_Result int32
+
+const (
+	AuditEntry_OK    AuditEntry_Result = 0
+	AuditEntry_ERROR AuditEntry_Result = 1
+)
+
+var AuditEntry_Result_name = map[int32]string{
+	0: "OK",
+	1: "ERROR",
+}
+
+var AuditEntry_Result_value = map[string]int32{
+	"OK":    0,
+	"ERROR": 1,
+}
+
+func (x AuditEntry_Result) String() string {
+	return proto.EnumName(AuditEntry_Result_name, int32(x))
+}
+
+func (AuditEntry_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{34, 0}
 }
 
 type LogLevel_Level int32
@@ -146,7 +199,7 @@ func (x LogLevel_Level) String() string {
 }
 
 func (LogLevel_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{29, 0}
+	return fileDescriptor_10c1b2aca93c333f, []int{36, 0}
 }
 
 type AddThreadConfig struct {
@@ -1035,6 +1088,7 @@ type Join struct {
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,4,rep,name=likes,proto3" json:"likes,omitempty"`
+	Reactions            []*ReactionSummary   `protobuf:"bytes,5,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1093,6 +1147,13 @@ func (m *Join) GetLikes() []*Like {
 	return nil
 }
 
+func (m *Join) GetReactions() []*ReactionSummary {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type Announce struct {
 	Block                string               `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -1153,6 +1214,7 @@ type Leave struct {
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,4,rep,name=likes,proto3" json:"likes,omitempty"`
+	Reactions            []*ReactionSummary   `protobuf:"bytes,5,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1211,6 +1273,13 @@ func (m *Leave) GetLikes() []*Like {
 	return nil
 }
 
+func (m *Leave) GetReactions() []*ReactionSummary {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type Text struct {
 	Block                string               `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
@@ -1218,6 +1287,7 @@ type Text struct {
 	Body                 string               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	Comments             []*Comment           `protobuf:"bytes,5,rep,name=comments,proto3" json:"comments,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,6,rep,name=likes,proto3" json:"likes,omitempty"`
+	Reactions            []*ReactionSummary   `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1290,6 +1360,13 @@ func (m *Text) GetLikes() []*Like {
 	return nil
 }
 
+func (m *Text) GetReactions() []*ReactionSummary {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type TextList struct {
 	Items                []*Text  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1395,6 +1472,7 @@ type Files struct {
 	Comments             []*Comment           `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,8,rep,name=likes,proto3" json:"likes,omitempty"`
 	Threads              []string             `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	Reactions            []*ReactionSummary   `protobuf:"bytes,11,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1495,6 +1573,13 @@ func (m *Files) GetThreads() []string {
 	return nil
 }
 
+func (m *Files) GetReactions() []*ReactionSummary {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type FilesList struct {
 	Items                []*Files `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1748,6 +1833,171 @@ func (m *LikeList) GetItems() []*Like {
 	return nil
 }
 
+type Reaction struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Emoji                string               `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{27}
+}
+
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reaction.Unmarshal(m, b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+}
+func (m *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(m, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return xxx_messageInfo_Reaction.Size(m)
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Reaction) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Reaction) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *Reaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *Reaction) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type ReactionList struct {
+	Items                []*Reaction `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReactionList) Reset()         { *m = ReactionList{} }
+func (m *ReactionList) String() string { return proto.CompactTextString(m) }
+func (*ReactionList) ProtoMessage()    {}
+func (*ReactionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{28}
+}
+
+func (m *ReactionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionList.Unmarshal(m, b)
+}
+func (m *ReactionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionList.Marshal(b, m, deterministic)
+}
+func (m *ReactionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionList.Merge(m, src)
+}
+func (m *ReactionList) XXX_Size() int {
+	return xxx_messageInfo_ReactionList.Size(m)
+}
+func (m *ReactionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionList proto.InternalMessageInfo
+
+func (m *ReactionList) GetItems() []*Reaction {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ReactionSummary struct {
+	Emoji                string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Users                []*User  `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReactionSummary) Reset()         { *m = ReactionSummary{} }
+func (m *ReactionSummary) String() string { return proto.CompactTextString(m) }
+func (*ReactionSummary) ProtoMessage()    {}
+func (*ReactionSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{29}
+}
+
+func (m *ReactionSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionSummary.Unmarshal(m, b)
+}
+func (m *ReactionSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionSummary.Marshal(b, m, deterministic)
+}
+func (m *ReactionSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionSummary.Merge(m, src)
+}
+func (m *ReactionSummary) XXX_Size() int {
+	return xxx_messageInfo_ReactionSummary.Size(m)
+}
+func (m *ReactionSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionSummary proto.InternalMessageInfo
+
+func (m *ReactionSummary) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ReactionSummary) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReactionSummary) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 type AccountUpdate struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Key                  string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // Deprecated: Do not use.
@@ -1761,7 +2011,7 @@ func (m *AccountUpdate) Reset()         { *m = AccountUpdate{} }
 func (m *AccountUpdate) String() string { return proto.CompactTextString(m) }
 func (*AccountUpdate) ProtoMessage()    {}
 func (*AccountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{27}
+	return fileDescriptor_10c1b2aca93c333f, []int{30}
 }
 
 func (m *AccountUpdate) XXX_Unmarshal(b []byte) error {
@@ -1820,7 +2070,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{28}
+	return fileDescriptor_10c1b2aca93c333f, []int{31}
 }
 
 func (m *Summary) XXX_Unmarshal(b []byte) error {
@@ -1883,6 +2133,282 @@ func (m *Summary) GetContactCount() int32 {
 	return 0
 }
 
+type QueueItem struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue                QueueItem_Queue      `protobuf:"varint,2,opt,name=queue,proto3,enum=QueueItem_Queue" json:"queue,omitempty"`
+	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Type                 string               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Attempts             int32                `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Failed               bool                 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QueueItem) Reset()         { *m = QueueItem{} }
+func (m *QueueItem) String() string { return proto.CompactTextString(m) }
+func (*QueueItem) ProtoMessage()    {}
+func (*QueueItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{32}
+}
+
+func (m *QueueItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueItem.Unmarshal(m, b)
+}
+func (m *QueueItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueItem.Marshal(b, m, deterministic)
+}
+func (m *QueueItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueItem.Merge(m, src)
+}
+func (m *QueueItem) XXX_Size() int {
+	return xxx_messageInfo_QueueItem.Size(m)
+}
+func (m *QueueItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueItem proto.InternalMessageInfo
+
+func (m *QueueItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueueItem) GetQueue() QueueItem_Queue {
+	if m != nil {
+		return m.Queue
+	}
+	return QueueItem_BLOCK_OUTBOX
+}
+
+func (m *QueueItem) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *QueueItem) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueueItem) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *QueueItem) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *QueueItem) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *QueueItem) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type QueueItemList struct {
+	Items                []*QueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *QueueItemList) Reset()         { *m = QueueItemList{} }
+func (m *QueueItemList) String() string { return proto.CompactTextString(m) }
+func (*QueueItemList) ProtoMessage()    {}
+func (*QueueItemList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{33}
+}
+
+func (m *QueueItemList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueItemList.Unmarshal(m, b)
+}
+func (m *QueueItemList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueItemList.Marshal(b, m, deterministic)
+}
+func (m *QueueItemList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueItemList.Merge(m, src)
+}
+func (m *QueueItemList) XXX_Size() int {
+	return xxx_messageInfo_QueueItemList.Size(m)
+}
+func (m *QueueItemList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueItemList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueItemList proto.InternalMessageInfo
+
+func (m *QueueItemList) GetItems() []*QueueItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	Seq                  int64                `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Actor                string               `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action               string               `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Target               string               `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Result               AuditEntry_Result    `protobuf:"varint,6,opt,name=result,proto3,enum=AuditEntry_Result" json:"result,omitempty"`
+	Error                string               `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Prev                 string               `protobuf:"bytes,8,opt,name=prev,proto3" json:"prev,omitempty"`
+	Hash                 string               `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{34}
+}
+
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetResult() AuditEntry_Result {
+	if m != nil {
+		return m.Result
+	}
+	return AuditEntry_OK
+}
+
+func (m *AuditEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetPrev() string {
+	if m != nil {
+		return m.Prev
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type AuditEntryList struct {
+	Items                []*AuditEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditEntryList) Reset()         { *m = AuditEntryList{} }
+func (m *AuditEntryList) String() string { return proto.CompactTextString(m) }
+func (*AuditEntryList) ProtoMessage()    {}
+func (*AuditEntryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{35}
+}
+
+func (m *AuditEntryList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntryList.Unmarshal(m, b)
+}
+func (m *AuditEntryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntryList.Marshal(b, m, deterministic)
+}
+func (m *AuditEntryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntryList.Merge(m, src)
+}
+func (m *AuditEntryList) XXX_Size() int {
+	return xxx_messageInfo_AuditEntryList.Size(m)
+}
+func (m *AuditEntryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntryList proto.InternalMessageInfo
+
+func (m *AuditEntryList) GetItems() []*AuditEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type LogLevel struct {
 	Systems              map[string]LogLevel_Level `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=LogLevel_Level"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -1894,7 +2420,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{29}
+	return fileDescriptor_10c1b2aca93c333f, []int{36}
 }
 
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
@@ -1933,7 +2459,7 @@ func (m *Strings) Reset()         { *m = Strings{} }
 func (m *Strings) String() string { return proto.CompactTextString(m) }
 func (*Strings) ProtoMessage()    {}
 func (*Strings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{30}
+	return fileDescriptor_10c1b2aca93c333f, []int{37}
 }
 
 func (m *Strings) XXX_Unmarshal(b []byte) error {
@@ -1965,6 +2491,8 @@ func init() {
 	proto.RegisterEnum("AddThreadConfig_Schema_Preset", AddThreadConfig_Schema_Preset_name, AddThreadConfig_Schema_Preset_value)
 	proto.RegisterEnum("FeedRequest_Mode", FeedRequest_Mode_name, FeedRequest_Mode_value)
 	proto.RegisterEnum("AccountUpdate_Type", AccountUpdate_Type_name, AccountUpdate_Type_value)
+	proto.RegisterEnum("QueueItem_Queue", QueueItem_Queue_name, QueueItem_Queue_value)
+	proto.RegisterEnum("AuditEntry_Result", AuditEntry_Result_name, AuditEntry_Result_value)
 	proto.RegisterEnum("LogLevel_Level", LogLevel_Level_name, LogLevel_Level_value)
 	proto.RegisterType((*AddThreadConfig)(nil), "AddThreadConfig")
 	proto.RegisterType((*AddThreadConfig_Schema)(nil), "AddThreadConfig.Schema")
@@ -1997,8 +2525,15 @@ func init() {
 	proto.RegisterType((*CommentList)(nil), "CommentList")
 	proto.RegisterType((*Like)(nil), "Like")
 	proto.RegisterType((*LikeList)(nil), "LikeList")
+	proto.RegisterType((*Reaction)(nil), "Reaction")
+	proto.RegisterType((*ReactionList)(nil), "ReactionList")
+	proto.RegisterType((*ReactionSummary)(nil), "ReactionSummary")
 	proto.RegisterType((*AccountUpdate)(nil), "AccountUpdate")
 	proto.RegisterType((*Summary)(nil), "Summary")
+	proto.RegisterType((*QueueItem)(nil), "QueueItem")
+	proto.RegisterType((*QueueItemList)(nil), "QueueItemList")
+	proto.RegisterType((*AuditEntry)(nil), "AuditEntry")
+	proto.RegisterType((*AuditEntryList)(nil), "AuditEntryList")
 	proto.RegisterType((*LogLevel)(nil), "LogLevel")
 	proto.RegisterMapType((map[string]LogLevel_Level)(nil), "LogLevel.SystemsEntry")
 	proto.RegisterType((*Strings)(nil), "Strings")
@@ -2007,99 +2542,120 @@ func init() {
 func init() { proto.RegisterFile("view.proto", fileDescriptor_10c1b2aca93c333f) }
 
 var fileDescriptor_10c1b2aca93c333f = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x72, 0xdb, 0x44,
-	0x1b, 0x8e, 0x64, 0xc9, 0xb6, 0x5e, 0x27, 0xa9, 0xbe, 0x6d, 0xbe, 0x7e, 0x6a, 0xda, 0x69, 0x1c,
-	0xf5, 0x2b, 0x4d, 0x07, 0x50, 0x69, 0x3a, 0x30, 0x9d, 0x9e, 0x29, 0xb6, 0xd2, 0x9a, 0x3a, 0x76,
-	0x67, 0xed, 0x94, 0x81, 0x03, 0x32, 0x8a, 0xb5, 0x71, 0x44, 0x6c, 0xc9, 0x48, 0xeb, 0x34, 0xe6,
-	0x80, 0x19, 0x66, 0xe0, 0xa4, 0xc3, 0x09, 0x37, 0x00, 0xa7, 0xc0, 0x45, 0xf4, 0x02, 0xb8, 0x03,
-	0xee, 0x86, 0xd9, 0x1f, 0xf9, 0x27, 0x76, 0x69, 0xcb, 0x4c, 0x80, 0x13, 0x8f, 0xde, 0x1f, 0xef,
-	0x3e, 0xef, 0xff, 0xbb, 0x00, 0xa7, 0x21, 0x79, 0xee, 0x0c, 0x92, 0x98, 0xc6, 0xeb, 0x57, 0xbb,
-	0x71, 0xdc, 0xed, 0x91, 0xbb, 0x9c, 0x3a, 0x1c, 0x1e, 0xdd, 0xf5, 0xa3, 0x91, 0x14, 0x6d, 0x9c,
-	0x17, 0xd1, 0xb0, 0x4f, 0x52, 0xea, 0xf7, 0x07, 0x52, 0xa1, 0xd4, 0x8f, 0x03, 0xd2, 0x13, 0x84,
-	0xfd, 0x22, 0x07, 0x97, 0xdc, 0x20, 0x68, 0x1f, 0x27, 0xc4, 0x0f, 0x2a, 0x71, 0x74, 0x14, 0x76,
-	0x91, 0x09, 0xb9, 0x13, 0x32, 0xb2, 0x94, 0xb2, 0xb2, 0x65, 0x60, 0xf6, 0x89, 0x10, 0x68, 0x91,
-	0xdf, 0x27, 0x96, 0xca, 0x59, 0xfc, 0x1b, 0xdd, 0x85, 0x7c, 0xda, 0x39, 0x26, 0x7d, 0xdf, 0xca,
-	0x95, 0x95, 0xad, 0xd2, 0xf6, 0xff, 0x9c, 0x73, 0xe7, 0x38, 0x2d, 0x2e, 0xc6, 0x52, 0x0d, 0x95,
-	0x41, 0xa3, 0xa3, 0x01, 0xb1, 0xb4, 0xb2, 0xb2, 0xb5, 0xba, 0xbd, 0xec, 0x08, 0x5d, 0xa7, 0x3d,
-	0x1a, 0x10, 0xcc, 0x25, 0xe8, 0x0e, 0x14, 0xd2, 0x63, 0x3f, 0x09, 0xa3, 0xae, 0xa5, 0x73, 0xa5,
-	0x4b, 0x99, 0x52, 0x4b, 0xb0, 0x71, 0x26, 0x47, 0xd7, 0xc1, 0x78, 0x7e, 0x1c, 0x52, 0xd2, 0x0b,
-	0x53, 0x6a, 0xe5, 0xcb, 0xb9, 0x2d, 0x03, 0x4f, 0x18, 0x68, 0x0d, 0xf4, 0xa3, 0x38, 0xe9, 0x10,
-	0xab, 0x50, 0x56, 0xb6, 0x8a, 0x58, 0x10, 0xeb, 0x3f, 0x2a, 0x90, 0x17, 0x98, 0xd0, 0x2a, 0xa8,
-	0x61, 0x20, 0x2d, 0x54, 0xc3, 0x80, 0x19, 0xf8, 0x45, 0x1a, 0x47, 0x99, 0x81, 0xec, 0x1b, 0x7d,
-	0x04, 0xf9, 0x41, 0x42, 0x52, 0x42, 0xb9, 0x81, 0xab, 0xdb, 0x37, 0x5e, 0x61, 0xa0, 0xf3, 0x94,
-	0x6b, 0x61, 0xa9, 0x6d, 0x3f, 0x80, 0xbc, 0xe0, 0xa0, 0x22, 0x68, 0x8d, 0x66, 0xc3, 0x33, 0x97,
-	0xd8, 0xd7, 0x4e, 0xbd, 0xb9, 0x63, 0x2a, 0xe8, 0x12, 0x94, 0x2a, 0xee, 0x9e, 0x87, 0xdd, 0x03,
-	0xdc, 0xac, 0xd7, 0x4d, 0x15, 0x19, 0xa0, 0xef, 0x79, 0xd5, 0x9a, 0x6b, 0xe6, 0xec, 0xc7, 0x50,
-	0xdc, 0xe9, 0xc5, 0x9d, 0x93, 0x67, 0xe1, 0x57, 0x0c, 0x51, 0x10, 0xd3, 0x54, 0x62, 0xe4, 0xdf,
-	0xcc, 0xac, 0x4e, 0x3c, 0x8c, 0x28, 0x87, 0xa9, 0x63, 0x41, 0xf0, 0xe0, 0x90, 0x33, 0x81, 0x92,
-	0x05, 0x87, 0x9c, 0x51, 0xfb, 0x43, 0xd0, 0x5a, 0x94, 0x0c, 0xc6, 0x81, 0x53, 0xa6, 0x02, 0x77,
-	0x15, 0xb4, 0x5e, 0x18, 0x9d, 0xf0, 0x43, 0x4a, 0xdb, 0xba, 0x53, 0x0f, 0xa3, 0x13, 0xcc, 0x59,
-	0xf6, 0xd7, 0x60, 0x54, 0xc3, 0x84, 0x74, 0x68, 0x9c, 0x8c, 0xd0, 0xbb, 0xa0, 0x1f, 0x85, 0x3d,
-	0xc2, 0x20, 0xe4, 0xb6, 0x4a, 0xdb, 0xff, 0x75, 0xc6, 0x22, 0x67, 0x97, 0xf1, 0xbd, 0x88, 0x26,
-	0x23, 0x2c, 0x74, 0xd6, 0xab, 0x00, 0x13, 0xe6, 0x82, 0x0c, 0x2a, 0x83, 0x7e, 0xea, 0xf7, 0x86,
-	0x44, 0xde, 0x0a, 0xfc, 0x88, 0x5a, 0x14, 0x90, 0x33, 0x2c, 0x04, 0x0f, 0xd5, 0x07, 0x8a, 0x7d,
-	0x0f, 0x56, 0xc6, 0x97, 0xd4, 0x59, 0x20, 0xcb, 0xa0, 0x87, 0x94, 0xf4, 0x33, 0x0c, 0x30, 0xc1,
-	0x80, 0x85, 0xc0, 0x3e, 0x06, 0xed, 0x09, 0x19, 0xa5, 0xe8, 0x9d, 0x59, 0xb4, 0xa6, 0xc3, 0xb8,
-	0x0b, 0x80, 0x3e, 0x78, 0x0d, 0xd0, 0xb5, 0x69, 0xa0, 0xc6, 0x34, 0xb8, 0x6f, 0x14, 0x80, 0x5a,
-	0x74, 0x1a, 0x52, 0xf2, 0x2c, 0x24, 0xcf, 0x17, 0xa5, 0xd0, 0x5c, 0x8d, 0x6c, 0x40, 0x21, 0xe4,
-	0xff, 0x48, 0x64, 0x91, 0xe8, 0xce, 0x7e, 0x4a, 0x12, 0x9c, 0x71, 0x91, 0x03, 0x5a, 0xe0, 0x53,
-	0x51, 0x13, 0xa5, 0xed, 0x75, 0x47, 0xd4, 0xae, 0x93, 0xd5, 0xae, 0xd3, 0xce, 0x6a, 0x17, 0x73,
-	0x3d, 0xfb, 0x3e, 0xac, 0x4e, 0x20, 0x70, 0x0f, 0x6d, 0xce, 0x7a, 0xa8, 0xe4, 0x4c, 0xe4, 0x99,
-	0x8b, 0xea, 0xb0, 0xea, 0x9d, 0x51, 0x92, 0x44, 0x7e, 0x4f, 0x08, 0xe7, 0xb0, 0x4b, 0x37, 0xa8,
-	0x13, 0x37, 0x58, 0xb3, 0xc8, 0x8d, 0x31, 0x64, 0xfb, 0x17, 0x05, 0x4a, 0xbb, 0x84, 0x04, 0x98,
-	0x7c, 0x39, 0x24, 0x29, 0x45, 0x57, 0x20, 0x4f, 0x79, 0x51, 0xc8, 0xf3, 0x24, 0xc5, 0xf8, 0xf1,
-	0xd1, 0x11, 0x2b, 0x1f, 0x71, 0xac, 0xa4, 0x98, 0x83, 0x7b, 0x61, 0x3f, 0x14, 0xf9, 0xaa, 0x63,
-	0x41, 0xa0, 0x5b, 0xa0, 0xb1, 0xb6, 0x24, 0x9b, 0xc3, 0x7f, 0x9c, 0xa9, 0x1b, 0x9c, 0xbd, 0x38,
-	0x20, 0x98, 0x8b, 0xed, 0xf7, 0x41, 0x63, 0x14, 0x02, 0xc8, 0x57, 0x1e, 0xe3, 0x66, 0xa3, 0x69,
-	0x2e, 0xa1, 0x15, 0x30, 0xdc, 0x46, 0xa3, 0xd9, 0x76, 0xdb, 0x5e, 0xd5, 0x54, 0x98, 0xa8, 0xd5,
-	0x76, 0x2b, 0x4f, 0x5a, 0xa6, 0x6a, 0x1f, 0x43, 0x91, 0x1d, 0x54, 0xa3, 0xa4, 0xcf, 0xee, 0x3d,
-	0x64, 0xc5, 0x25, 0x61, 0x0a, 0x62, 0x0a, 0xbd, 0x3a, 0x83, 0xde, 0x81, 0xc2, 0xc0, 0x1f, 0xf5,
-	0x62, 0x3f, 0x90, 0x91, 0x5b, 0x9b, 0x8b, 0x8d, 0x1b, 0x8d, 0x70, 0xa6, 0x64, 0x7f, 0x0a, 0xcb,
-	0xd9, 0x4d, 0x3c, 0x2c, 0x1b, 0xb3, 0x61, 0x31, 0x9c, 0x4c, 0x2a, 0x83, 0xf2, 0x16, 0xb5, 0xfc,
-	0x83, 0x02, 0xfa, 0x1e, 0x49, 0xba, 0xe4, 0x15, 0x26, 0x64, 0x39, 0xa4, 0xbe, 0x59, 0x0e, 0xb1,
-	0xfa, 0x1f, 0xa6, 0xe7, 0x33, 0x92, 0xb3, 0xd0, 0x4d, 0x28, 0x50, 0x3f, 0xe9, 0x12, 0x9a, 0x5a,
-	0xda, 0x79, 0xdc, 0x99, 0xe4, 0xa1, 0x6a, 0x29, 0xf6, 0xf7, 0x0a, 0xe4, 0x6b, 0xdd, 0x28, 0x4e,
-	0xfe, 0x06, 0x50, 0x9b, 0x90, 0x17, 0x57, 0xcb, 0x2a, 0x99, 0xc2, 0x24, 0x05, 0xf6, 0x0b, 0x05,
-	0xb4, 0xdd, 0x9e, 0xdf, 0xfd, 0x57, 0x80, 0xf9, 0x56, 0x01, 0xed, 0xe3, 0x38, 0x8c, 0x2e, 0x1e,
-	0xcc, 0x35, 0x56, 0x4a, 0x27, 0x24, 0x0b, 0x16, 0x6b, 0xe5, 0x27, 0x04, 0x0b, 0x9e, 0x7d, 0x02,
-	0x45, 0x37, 0x8a, 0xe2, 0x61, 0xd4, 0xb9, 0xf8, 0x18, 0xd9, 0xdf, 0x29, 0xa0, 0xd7, 0x89, 0x7f,
-	0x4a, 0xfe, 0x61, 0xa3, 0x5f, 0x2a, 0xa0, 0xb5, 0xc9, 0x19, 0xbd, 0x78, 0x18, 0x08, 0xb4, 0xc3,
-	0x38, 0x18, 0xf1, 0x34, 0x30, 0x30, 0xff, 0x46, 0xff, 0x87, 0x62, 0x27, 0xee, 0xf7, 0x49, 0x44,
-	0x53, 0x4b, 0xe7, 0xe8, 0x8a, 0x4e, 0x45, 0x30, 0xf0, 0x58, 0x32, 0x31, 0x20, 0xbf, 0xc0, 0x80,
-	0xdb, 0x50, 0x64, 0xf8, 0x79, 0x0f, 0xb9, 0x36, 0xdb, 0x43, 0x74, 0x87, 0x49, 0xb2, 0xa6, 0xfe,
-	0x2b, 0x4b, 0xf9, 0xb0, 0xc7, 0x1d, 0x1e, 0xb2, 0x39, 0xca, 0x2d, 0xd5, 0xb1, 0x20, 0xd0, 0x0d,
-	0xd0, 0xd8, 0xbc, 0x5b, 0x30, 0x6e, 0x39, 0x9f, 0x8d, 0x4b, 0x36, 0xf1, 0x53, 0x2b, 0x27, 0xc7,
-	0x25, 0x53, 0xe0, 0xab, 0x40, 0x36, 0x2e, 0xb9, 0x98, 0xcd, 0xf5, 0x09, 0xf3, 0x2f, 0xcf, 0xf5,
-	0x9f, 0x55, 0xd0, 0xf9, 0xd4, 0xfd, 0x93, 0x2e, 0x2c, 0xaa, 0x2a, 0xeb, 0xc2, 0x9c, 0xe2, 0x4b,
-	0x90, 0x4f, 0x7d, 0x0b, 0xe4, 0x12, 0xe4, 0x53, 0x7f, 0x1c, 0xc3, 0xdc, 0x5b, 0xc6, 0x50, 0x9b,
-	0x8f, 0xa1, 0x05, 0x85, 0x8e, 0x3f, 0xa0, 0x61, 0x1c, 0xf1, 0x7d, 0xd3, 0xc0, 0x19, 0xc9, 0x5c,
-	0x2f, 0xb6, 0x89, 0x2c, 0x46, 0x0c, 0xbd, 0x5c, 0x21, 0x66, 0xc2, 0x5c, 0x78, 0x7d, 0x98, 0x8b,
-	0xf3, 0x61, 0x66, 0x37, 0x8b, 0x41, 0x93, 0x5a, 0x06, 0x5f, 0x5e, 0x33, 0xd2, 0xbe, 0x03, 0x06,
-	0xf7, 0x14, 0xcf, 0x80, 0xeb, 0xb3, 0x19, 0x90, 0x17, 0xfb, 0x4c, 0x96, 0x02, 0x3f, 0x29, 0x50,
-	0x90, 0xf7, 0xce, 0x4d, 0xf4, 0x0b, 0xce, 0xf4, 0x49, 0x1b, 0xd4, 0x5f, 0xd1, 0x06, 0xf9, 0x98,
-	0xb8, 0x07, 0x25, 0x09, 0x90, 0x9b, 0x73, 0x63, 0xd6, 0x9c, 0x89, 0xd7, 0x04, 0x9b, 0xff, 0x85,
-	0x75, 0x4f, 0xe6, 0xa9, 0x8b, 0xb4, 0xe8, 0x0d, 0x9a, 0xf8, 0x6d, 0x28, 0x32, 0x14, 0x8b, 0xeb,
-	0x50, 0x44, 0x52, 0x04, 0xe1, 0xa5, 0x02, 0x2b, 0x6e, 0x87, 0x4f, 0xef, 0xfd, 0x01, 0xbf, 0xf8,
-	0x3c, 0xf0, 0xb5, 0xa9, 0xe5, 0x6a, 0x47, 0xb5, 0x14, 0x51, 0x38, 0xb7, 0xe5, 0x6b, 0x48, 0xbc,
-	0x2d, 0x2e, 0x3b, 0x33, 0x67, 0x4c, 0x3d, 0x8a, 0xec, 0xcf, 0x41, 0x63, 0x14, 0x32, 0x61, 0xb9,
-	0xfd, 0x18, 0x7b, 0x6e, 0xf5, 0xc0, 0xad, 0x56, 0xbd, 0xaa, 0xb9, 0x84, 0x10, 0xac, 0x4a, 0x0e,
-	0xf6, 0xf6, 0x9a, 0xcf, 0xf8, 0xf6, 0x73, 0x05, 0x90, 0x5b, 0xa9, 0x34, 0xf7, 0x1b, 0xed, 0x83,
-	0xa7, 0x9e, 0x87, 0xa5, 0xae, 0x8a, 0x2c, 0x58, 0x9b, 0xe1, 0x67, 0xff, 0xc8, 0xd9, 0xbf, 0x29,
-	0x50, 0x68, 0x0d, 0xfb, 0x7d, 0x3f, 0x19, 0xcd, 0x41, 0xb7, 0xa0, 0xe0, 0x07, 0x41, 0x42, 0xd2,
-	0x54, 0x16, 0x66, 0x46, 0xa2, 0xf7, 0x00, 0xf9, 0x02, 0xf1, 0xc1, 0x80, 0x90, 0xe4, 0x40, 0xec,
-	0x32, 0x62, 0xa5, 0x33, 0xa5, 0xe4, 0x29, 0x21, 0x49, 0x85, 0xaf, 0x35, 0x9b, 0xb0, 0x2c, 0xf2,
-	0x5b, 0xea, 0x69, 0x5c, 0xaf, 0x44, 0xe5, 0x63, 0x8a, 0xa9, 0x6c, 0x40, 0x89, 0x57, 0x97, 0xd4,
-	0xd0, 0xb9, 0x06, 0x70, 0x96, 0x50, 0xb8, 0x09, 0x2b, 0x9d, 0x38, 0xa2, 0x7e, 0x87, 0x4a, 0x95,
-	0x3c, 0x57, 0x59, 0x96, 0x4c, 0xae, 0x64, 0xff, 0xae, 0x40, 0xb1, 0x1e, 0x77, 0xeb, 0xe4, 0x94,
-	0xf4, 0xd0, 0x07, 0x50, 0x48, 0x47, 0xe9, 0x54, 0xe4, 0xae, 0x38, 0x99, 0xcc, 0x69, 0x09, 0x81,
-	0xe8, 0x75, 0x99, 0xda, 0xfa, 0x13, 0x58, 0x9e, 0x16, 0x2c, 0xe8, 0x77, 0xb7, 0xa6, 0xfb, 0x1d,
-	0x7b, 0xa0, 0x8e, 0x4f, 0xe4, 0xbf, 0xd3, 0x4d, 0xaf, 0xc1, 0x46, 0x22, 0xc3, 0xb1, 0x0c, 0xc5,
-	0x0a, 0xae, 0xb5, 0x6b, 0x15, 0xb7, 0x6e, 0x2e, 0xb1, 0xf7, 0x9e, 0x87, 0x71, 0x13, 0x9b, 0x0a,
-	0x2a, 0x41, 0xe1, 0x13, 0x17, 0x37, 0x6a, 0x8d, 0x47, 0xa6, 0xca, 0xf6, 0xd6, 0x46, 0xb3, 0x5d,
-	0xab, 0x78, 0x66, 0x8e, 0x3d, 0x17, 0x6b, 0x8d, 0xdd, 0xa6, 0xa9, 0x31, 0xed, 0xaa, 0xb7, 0xb3,
-	0xff, 0xc8, 0xd4, 0xed, 0x4d, 0x28, 0xb4, 0x28, 0x7b, 0xfc, 0xa6, 0xac, 0x5f, 0xf2, 0x7b, 0x84,
-	0x61, 0x06, 0x96, 0xd4, 0xce, 0x65, 0x58, 0x09, 0x63, 0x87, 0x92, 0x33, 0xca, 0xba, 0xf9, 0xe0,
-	0xf0, 0x33, 0x75, 0x70, 0x78, 0x98, 0xe7, 0x25, 0x72, 0xff, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xae, 0xbe, 0xa8, 0x37, 0x40, 0x10, 0x00, 0x00,
+	// 1833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x73, 0x1b, 0x49,
+	0x19, 0xf7, 0x8c, 0x66, 0x24, 0xcd, 0x27, 0x5b, 0x19, 0x7a, 0x4d, 0x98, 0x75, 0x96, 0xc4, 0x99,
+	0x65, 0x37, 0x5e, 0x1e, 0x13, 0xd6, 0x5b, 0x50, 0xa9, 0xdc, 0x64, 0x49, 0xde, 0x08, 0xcb, 0x52,
+	0x68, 0xcb, 0x01, 0xf6, 0x80, 0x6b, 0x2c, 0xb5, 0xe5, 0x59, 0x4b, 0x33, 0xca, 0x4c, 0xcb, 0xb1,
+	0x38, 0x50, 0x45, 0x15, 0xa7, 0x2d, 0x2e, 0x9c, 0xa9, 0x0a, 0x67, 0x38, 0x70, 0x82, 0x2b, 0x7f,
+	0x00, 0x7f, 0x01, 0xfc, 0x13, 0xfc, 0x0d, 0xd4, 0xd7, 0x0f, 0xbd, 0xac, 0xe0, 0x84, 0x2a, 0x53,
+	0x70, 0x51, 0xf5, 0xf7, 0x50, 0xf7, 0xef, 0x7b, 0xf6, 0xd7, 0x03, 0x70, 0x19, 0xb1, 0x57, 0xc1,
+	0x28, 0x4d, 0x78, 0xb2, 0xf5, 0x7e, 0x3f, 0x49, 0xfa, 0x03, 0xf6, 0x58, 0x50, 0xa7, 0xe3, 0xb3,
+	0xc7, 0x61, 0x3c, 0x51, 0xa2, 0x07, 0xcb, 0x22, 0x1e, 0x0d, 0x59, 0xc6, 0xc3, 0xe1, 0x48, 0x29,
+	0x94, 0x86, 0x49, 0x8f, 0x0d, 0x24, 0xe1, 0x7f, 0x95, 0x83, 0x3b, 0x95, 0x5e, 0xaf, 0x73, 0x9e,
+	0xb2, 0xb0, 0x57, 0x4d, 0xe2, 0xb3, 0xa8, 0x4f, 0x5c, 0xc8, 0x5d, 0xb0, 0x89, 0x67, 0x6c, 0x1b,
+	0x3b, 0x0e, 0xc5, 0x25, 0x21, 0x60, 0xc5, 0xe1, 0x90, 0x79, 0xa6, 0x60, 0x89, 0x35, 0x79, 0x0c,
+	0xf9, 0xac, 0x7b, 0xce, 0x86, 0xa1, 0x97, 0xdb, 0x36, 0x76, 0x4a, 0xbb, 0xdf, 0x08, 0x96, 0xf6,
+	0x09, 0x8e, 0x84, 0x98, 0x2a, 0x35, 0xb2, 0x0d, 0x16, 0x9f, 0x8c, 0x98, 0x67, 0x6d, 0x1b, 0x3b,
+	0xe5, 0xdd, 0xf5, 0x40, 0xea, 0x06, 0x9d, 0xc9, 0x88, 0x51, 0x21, 0x21, 0x9f, 0x40, 0x21, 0x3b,
+	0x0f, 0xd3, 0x28, 0xee, 0x7b, 0xb6, 0x50, 0xba, 0xa3, 0x95, 0x8e, 0x24, 0x9b, 0x6a, 0x39, 0xf9,
+	0x00, 0x9c, 0x57, 0xe7, 0x11, 0x67, 0x83, 0x28, 0xe3, 0x5e, 0x7e, 0x3b, 0xb7, 0xe3, 0xd0, 0x19,
+	0x83, 0x6c, 0x82, 0x7d, 0x96, 0xa4, 0x5d, 0xe6, 0x15, 0xb6, 0x8d, 0x9d, 0x22, 0x95, 0xc4, 0xd6,
+	0x6b, 0x03, 0xf2, 0x12, 0x13, 0x29, 0x83, 0x19, 0xf5, 0x94, 0x85, 0x66, 0xd4, 0x43, 0x03, 0xbf,
+	0xcc, 0x92, 0x58, 0x1b, 0x88, 0x6b, 0xf2, 0x43, 0xc8, 0x8f, 0x52, 0x96, 0x31, 0x2e, 0x0c, 0x2c,
+	0xef, 0xde, 0x7f, 0x83, 0x81, 0xc1, 0x73, 0xa1, 0x45, 0x95, 0xb6, 0xff, 0x04, 0xf2, 0x92, 0x43,
+	0x8a, 0x60, 0xb5, 0xda, 0xad, 0xba, 0xbb, 0x86, 0xab, 0xbd, 0x66, 0x7b, 0xcf, 0x35, 0xc8, 0x1d,
+	0x28, 0x55, 0x2b, 0x87, 0x75, 0x5a, 0x39, 0xa1, 0xed, 0x66, 0xd3, 0x35, 0x89, 0x03, 0xf6, 0x61,
+	0xbd, 0xd6, 0xa8, 0xb8, 0x39, 0xff, 0x19, 0x14, 0xf7, 0x06, 0x49, 0xf7, 0xe2, 0x45, 0xf4, 0x0b,
+	0x44, 0xd4, 0x4b, 0x78, 0xa6, 0x30, 0x8a, 0x35, 0x9a, 0xd5, 0x4d, 0xc6, 0x31, 0x17, 0x30, 0x6d,
+	0x2a, 0x09, 0x11, 0x1c, 0x76, 0x25, 0x51, 0x62, 0x70, 0xd8, 0x15, 0xf7, 0x7f, 0x00, 0xd6, 0x11,
+	0x67, 0xa3, 0x69, 0xe0, 0x8c, 0xb9, 0xc0, 0xbd, 0x0f, 0xd6, 0x20, 0x8a, 0x2f, 0xc4, 0x26, 0xa5,
+	0x5d, 0x3b, 0x68, 0x46, 0xf1, 0x05, 0x15, 0x2c, 0xff, 0x97, 0xe0, 0xd4, 0xa2, 0x94, 0x75, 0x79,
+	0x92, 0x4e, 0xc8, 0x77, 0xc0, 0x3e, 0x8b, 0x06, 0x0c, 0x21, 0xe4, 0x76, 0x4a, 0xbb, 0x5f, 0x0f,
+	0xa6, 0xa2, 0x60, 0x1f, 0xf9, 0xf5, 0x98, 0xa7, 0x13, 0x2a, 0x75, 0xb6, 0x6a, 0x00, 0x33, 0xe6,
+	0x8a, 0x0c, 0xda, 0x06, 0xfb, 0x32, 0x1c, 0x8c, 0x99, 0x3a, 0x15, 0xc4, 0x16, 0x8d, 0xb8, 0xc7,
+	0xae, 0xa8, 0x14, 0x3c, 0x35, 0x9f, 0x18, 0xfe, 0xa7, 0xb0, 0x31, 0x3d, 0xa4, 0x89, 0x81, 0xdc,
+	0x06, 0x3b, 0xe2, 0x6c, 0xa8, 0x31, 0xc0, 0x0c, 0x03, 0x95, 0x02, 0xff, 0x1c, 0xac, 0x03, 0x36,
+	0xc9, 0xc8, 0xc7, 0x8b, 0x68, 0xdd, 0x00, 0xb9, 0x2b, 0x80, 0x3e, 0xb9, 0x01, 0xe8, 0xe6, 0x3c,
+	0x50, 0x67, 0x1e, 0xdc, 0xaf, 0x0c, 0x80, 0x46, 0x7c, 0x19, 0x71, 0xf6, 0x22, 0x62, 0xaf, 0x56,
+	0xa5, 0xd0, 0xb5, 0x1a, 0x79, 0x00, 0x85, 0x48, 0xfc, 0x23, 0x55, 0x45, 0x62, 0x07, 0xc7, 0x19,
+	0x4b, 0xa9, 0xe6, 0x92, 0x00, 0xac, 0x5e, 0xc8, 0x65, 0x4d, 0x94, 0x76, 0xb7, 0x02, 0x59, 0xbb,
+	0x81, 0xae, 0xdd, 0xa0, 0xa3, 0x6b, 0x97, 0x0a, 0x3d, 0xff, 0x33, 0x28, 0xcf, 0x20, 0x08, 0x0f,
+	0x3d, 0x5c, 0xf4, 0x50, 0x29, 0x98, 0xc9, 0xb5, 0x8b, 0x9a, 0x50, 0xae, 0x5f, 0x71, 0x96, 0xc6,
+	0xe1, 0x40, 0x0a, 0xaf, 0x61, 0x57, 0x6e, 0x30, 0x67, 0x6e, 0xf0, 0x16, 0x91, 0x3b, 0x53, 0xc8,
+	0xfe, 0x1f, 0x0c, 0x28, 0xed, 0x33, 0xd6, 0xa3, 0xec, 0xe5, 0x98, 0x65, 0x9c, 0xdc, 0x85, 0x3c,
+	0x17, 0x45, 0xa1, 0xf6, 0x53, 0x14, 0xf2, 0x93, 0xb3, 0x33, 0x2c, 0x1f, 0xb9, 0xad, 0xa2, 0xd0,
+	0xc1, 0x83, 0x68, 0x18, 0xc9, 0x7c, 0xb5, 0xa9, 0x24, 0xc8, 0x47, 0x60, 0x61, 0x5b, 0x52, 0xcd,
+	0xe1, 0x6b, 0xc1, 0xdc, 0x09, 0xc1, 0x61, 0xd2, 0x63, 0x54, 0x88, 0xfd, 0xef, 0x81, 0x85, 0x14,
+	0x01, 0xc8, 0x57, 0x9f, 0xd1, 0x76, 0xab, 0xed, 0xae, 0x91, 0x0d, 0x70, 0x2a, 0xad, 0x56, 0xbb,
+	0x53, 0xe9, 0xd4, 0x6b, 0xae, 0x81, 0xa2, 0xa3, 0x4e, 0xa5, 0x7a, 0x70, 0xe4, 0x9a, 0xfe, 0x39,
+	0x14, 0x71, 0xa3, 0x06, 0x67, 0x43, 0x3c, 0xf7, 0x14, 0x8b, 0x4b, 0xc1, 0x94, 0xc4, 0x1c, 0x7a,
+	0x73, 0x01, 0x7d, 0x00, 0x85, 0x51, 0x38, 0x19, 0x24, 0x61, 0x4f, 0x45, 0x6e, 0xf3, 0x5a, 0x6c,
+	0x2a, 0xf1, 0x84, 0x6a, 0x25, 0xff, 0x67, 0xb0, 0xae, 0x4f, 0x12, 0x61, 0x79, 0xb0, 0x18, 0x16,
+	0x27, 0xd0, 0x52, 0x15, 0x94, 0x77, 0xa8, 0xe5, 0xdf, 0x1a, 0x60, 0x1f, 0xb2, 0xb4, 0xcf, 0xde,
+	0x60, 0x82, 0xce, 0x21, 0xf3, 0xed, 0x72, 0x08, 0xeb, 0x7f, 0x9c, 0x2d, 0x67, 0xa4, 0x60, 0x91,
+	0x0f, 0xa1, 0xc0, 0xc3, 0xb4, 0xcf, 0x78, 0xe6, 0x59, 0xcb, 0xb8, 0xb5, 0xe4, 0xa9, 0xe9, 0x19,
+	0xfe, 0x6f, 0x0c, 0xc8, 0x37, 0xfa, 0x71, 0x92, 0xfe, 0x17, 0x40, 0x3d, 0x84, 0xbc, 0x3c, 0x5a,
+	0x55, 0xc9, 0x1c, 0x26, 0x25, 0xf0, 0xbf, 0x32, 0xc0, 0xda, 0x1f, 0x84, 0xfd, 0xff, 0x09, 0x30,
+	0x7f, 0x36, 0xc0, 0xfa, 0x51, 0x12, 0xc5, 0xb7, 0x0f, 0xe6, 0x1e, 0x96, 0xd2, 0x05, 0xd3, 0xc1,
+	0xc2, 0x56, 0x7e, 0xc1, 0xa8, 0xe4, 0x91, 0x00, 0x9c, 0x94, 0x85, 0x5d, 0x1e, 0x25, 0x71, 0xe6,
+	0xd9, 0xaa, 0x29, 0x52, 0xc5, 0x39, 0x1a, 0x0f, 0x87, 0x61, 0x3a, 0xa1, 0x33, 0x15, 0xff, 0x02,
+	0x8a, 0x95, 0x38, 0x4e, 0xc6, 0x71, 0xf7, 0xf6, 0x63, 0xea, 0xff, 0xc5, 0x00, 0xbb, 0xc9, 0xc2,
+	0x4b, 0xf6, 0x7f, 0xe6, 0xa4, 0x7f, 0x1a, 0x60, 0x75, 0xd8, 0x15, 0xbf, 0x7d, 0xd8, 0x04, 0xac,
+	0xd3, 0xa4, 0x37, 0x11, 0x69, 0xe6, 0x50, 0xb1, 0x26, 0xdf, 0x82, 0x62, 0x37, 0x19, 0x0e, 0x59,
+	0xcc, 0x35, 0xd8, 0x62, 0x50, 0x95, 0x0c, 0x3a, 0x95, 0xcc, 0x0c, 0xce, 0xdf, 0x64, 0x70, 0xe1,
+	0x66, 0x83, 0x1f, 0x41, 0x11, 0xed, 0x15, 0x3d, 0xed, 0xde, 0x62, 0x4f, 0xb3, 0x03, 0x94, 0xe8,
+	0x4b, 0xe6, 0x8f, 0x58, 0x82, 0xd1, 0x40, 0x04, 0x34, 0xc2, 0x7b, 0x5d, 0x78, 0xc6, 0xa6, 0x92,
+	0x20, 0xf7, 0xc1, 0xc2, 0xfb, 0x77, 0xc5, 0xf5, 0x2f, 0xf8, 0x78, 0x7d, 0xe3, 0x04, 0x92, 0x79,
+	0x39, 0x85, 0x09, 0x15, 0xc4, 0x68, 0xa2, 0xaf, 0x6f, 0x21, 0xc6, 0x39, 0x63, 0xc6, 0xfc, 0x8f,
+	0xe7, 0x8c, 0xbf, 0x9b, 0x60, 0xa3, 0x20, 0xfb, 0x37, 0xb7, 0x82, 0xac, 0x72, 0x7d, 0x2b, 0x08,
+	0x4a, 0x0c, 0x65, 0x21, 0x0f, 0x3d, 0x50, 0x43, 0x59, 0xc8, 0xc3, 0x69, 0xcc, 0x73, 0xef, 0x18,
+	0x73, 0xeb, 0x7a, 0xcc, 0x3d, 0x28, 0x74, 0xc3, 0x11, 0x3a, 0x5e, 0xcc, 0xbf, 0x0e, 0xd5, 0x24,
+	0xba, 0x5e, 0x4e, 0x37, 0x3a, 0xa6, 0x88, 0x5e, 0x8d, 0x34, 0x0b, 0x69, 0x51, 0xb8, 0x39, 0x2d,
+	0x8a, 0x2b, 0xd2, 0xc2, 0x83, 0x82, 0xbc, 0xf8, 0x32, 0xcf, 0x11, 0xc3, 0xb4, 0x26, 0x17, 0x13,
+	0xa6, 0x74, 0x73, 0xc2, 0x7c, 0x02, 0x8e, 0xf0, 0xac, 0xc8, 0x98, 0x0f, 0x16, 0x33, 0x26, 0x2f,
+	0xe7, 0x31, 0x9d, 0x32, 0xbf, 0x37, 0xa0, 0xa0, 0x70, 0x5e, 0x9b, 0x48, 0x6e, 0xb9, 0x92, 0x66,
+	0x6d, 0xdc, 0x7e, 0x43, 0x1b, 0x17, 0xd7, 0xdc, 0xa7, 0x50, 0x52, 0x00, 0x85, 0x39, 0xf7, 0x17,
+	0xcd, 0x99, 0x79, 0x59, 0xb2, 0xc5, 0x5f, 0x7e, 0x6d, 0x80, 0x85, 0x9e, 0xbd, 0x4d, 0x8b, 0xde,
+	0xe2, 0x12, 0x7a, 0x04, 0x45, 0x44, 0xb1, 0xba, 0x6e, 0x65, 0xe4, 0x65, 0x10, 0x5e, 0x1b, 0x50,
+	0xd4, 0xe1, 0xbc, 0x4d, 0xcc, 0x9b, 0x60, 0xb3, 0x61, 0xf2, 0x65, 0xa4, 0xc2, 0x20, 0x89, 0xb7,
+	0x88, 0x83, 0xff, 0x18, 0xd6, 0x35, 0xbe, 0xd5, 0x93, 0x95, 0x96, 0x6a, 0x8b, 0xbe, 0x80, 0x3b,
+	0x4b, 0xf9, 0x39, 0x3b, 0xdc, 0x98, 0x3f, 0x7c, 0xf5, 0x08, 0x76, 0x0f, 0x6c, 0x04, 0xac, 0x3b,
+	0x91, 0x32, 0x42, 0xf2, 0xfc, 0xbf, 0x1a, 0xb0, 0x51, 0xe9, 0x0a, 0xc5, 0xe3, 0x91, 0x30, 0x79,
+	0xd9, 0x65, 0x9b, 0x73, 0xa3, 0xf4, 0x9e, 0xe9, 0x19, 0xb2, 0x2d, 0x3d, 0x52, 0x6f, 0x5f, 0xf9,
+	0x92, 0x7c, 0x2f, 0x58, 0xd8, 0x63, 0xee, 0x09, 0xec, 0xff, 0x1c, 0x2c, 0xa4, 0x88, 0x0b, 0xeb,
+	0x9d, 0x67, 0xb4, 0x5e, 0xa9, 0x9d, 0x54, 0x6a, 0xb5, 0x7a, 0xcd, 0x5d, 0x23, 0x04, 0xca, 0x8a,
+	0x43, 0xeb, 0x87, 0xed, 0x17, 0x62, 0xd6, 0xbd, 0x0b, 0xa4, 0x52, 0xad, 0xb6, 0x8f, 0x5b, 0x9d,
+	0x93, 0xe7, 0xf5, 0x3a, 0x55, 0xba, 0x26, 0xf1, 0x60, 0x73, 0x81, 0xaf, 0xff, 0x91, 0xf3, 0xff,
+	0x66, 0x40, 0x41, 0x7b, 0x65, 0x19, 0xba, 0x07, 0x85, 0xb0, 0xd7, 0x4b, 0x59, 0x96, 0xa9, 0xb6,
+	0xa7, 0x49, 0xf2, 0x5d, 0x20, 0xa1, 0x44, 0x7c, 0x32, 0x62, 0x2c, 0x3d, 0x11, 0x4b, 0x35, 0xc0,
+	0xbb, 0x4a, 0xf2, 0x9c, 0xb1, 0xb4, 0x8a, 0x0b, 0xf2, 0x10, 0xd6, 0x65, 0xf7, 0x50, 0x7a, 0x96,
+	0xd0, 0x2b, 0x71, 0xf5, 0x74, 0x46, 0x95, 0x07, 0x50, 0x12, 0xbd, 0x4b, 0x69, 0xd8, 0x42, 0x03,
+	0x04, 0x4b, 0x2a, 0x7c, 0x08, 0x1b, 0xdd, 0x24, 0xe6, 0x61, 0x97, 0x2b, 0x95, 0xbc, 0x50, 0x59,
+	0x57, 0x4c, 0xa1, 0xe4, 0xff, 0xc9, 0x04, 0xe7, 0xc7, 0x63, 0x36, 0x66, 0x62, 0xc0, 0x5f, 0x36,
+	0xe7, 0x63, 0xb0, 0x5f, 0xa2, 0x50, 0x18, 0x53, 0xde, 0x75, 0x83, 0xa9, 0xaa, 0x5c, 0x51, 0x29,
+	0x9e, 0x6b, 0xf6, 0xb9, 0xe5, 0x66, 0x3f, 0xfd, 0x5e, 0xe1, 0xa8, 0x2f, 0x14, 0x5b, 0x50, 0x0c,
+	0x39, 0x67, 0xc3, 0x91, 0xb8, 0x81, 0x11, 0xd1, 0x94, 0x26, 0xdf, 0x04, 0x18, 0x84, 0x19, 0x3f,
+	0x61, 0x69, 0x9a, 0xa4, 0x02, 0xaf, 0x43, 0x1d, 0xe4, 0xd4, 0x91, 0x81, 0xc7, 0x9c, 0x85, 0xd1,
+	0x80, 0xf5, 0xd4, 0x47, 0x09, 0x45, 0x4d, 0x6b, 0xac, 0xf8, 0x96, 0x4f, 0xc0, 0xa7, 0x60, 0x0b,
+	0xf8, 0x98, 0x22, 0x7b, 0xcd, 0x76, 0xf5, 0xe0, 0xa4, 0x7d, 0xdc, 0xd9, 0x6b, 0xff, 0xd4, 0x5d,
+	0x93, 0xdf, 0x16, 0xf6, 0xeb, 0x9a, 0x61, 0x90, 0x32, 0x80, 0x60, 0x34, 0x5a, 0x48, 0x9b, 0xf8,
+	0xbe, 0x9e, 0x3a, 0x61, 0xf5, 0xfb, 0x7a, 0x2a, 0xd6, 0xd5, 0xf4, 0x3b, 0x13, 0xa0, 0x32, 0xee,
+	0x45, 0x7c, 0x7a, 0xe3, 0x66, 0xec, 0xa5, 0xf0, 0x72, 0x8e, 0xe2, 0xf2, 0x9d, 0x7b, 0xc4, 0x26,
+	0xd8, 0x21, 0xbe, 0xe0, 0x95, 0xb7, 0x25, 0x81, 0xde, 0x91, 0x25, 0xab, 0xdc, 0xad, 0xa8, 0xb9,
+	0xe0, 0xd8, 0x0b, 0xc1, 0xf9, 0x36, 0xe4, 0x53, 0x96, 0x8d, 0x07, 0x32, 0x31, 0xca, 0xbb, 0x24,
+	0x98, 0x81, 0x0c, 0xa8, 0x90, 0x50, 0xa5, 0x21, 0xaa, 0x5f, 0xc4, 0xa4, 0xa0, 0xaa, 0x1f, 0x09,
+	0x0c, 0xef, 0x28, 0x65, 0x97, 0xc2, 0xef, 0x0e, 0x15, 0x6b, 0xe4, 0x9d, 0x87, 0xd9, 0xb9, 0xe7,
+	0x48, 0x1e, 0xae, 0xfd, 0x7b, 0x90, 0x97, 0xfb, 0x91, 0x3c, 0x98, 0xed, 0x03, 0x77, 0x0d, 0xbf,
+	0xd8, 0xd4, 0x29, 0x6d, 0x53, 0xd7, 0xc0, 0xf7, 0xf8, 0xec, 0xdc, 0xd5, 0xef, 0xf1, 0x99, 0x5c,
+	0xbb, 0xf4, 0x1f, 0x06, 0x14, 0x9b, 0x49, 0xbf, 0xc9, 0x2e, 0xd9, 0x80, 0x7c, 0x1f, 0x0a, 0xd9,
+	0x24, 0x9b, 0xfb, 0xc7, 0xdd, 0x40, 0xcb, 0x82, 0x23, 0x29, 0x90, 0x7f, 0xd6, 0x6a, 0x5b, 0x07,
+	0xb0, 0x3e, 0x2f, 0x58, 0x31, 0x04, 0x7d, 0x34, 0x3f, 0x04, 0xe1, 0x57, 0xb4, 0xe9, 0x8e, 0xe2,
+	0x77, 0x7e, 0x12, 0x6a, 0x81, 0x2d, 0x71, 0xac, 0x43, 0xb1, 0x4a, 0x1b, 0x9d, 0x46, 0xb5, 0xd2,
+	0x5c, 0x30, 0x91, 0x94, 0xa0, 0xf0, 0x93, 0x0a, 0x6d, 0x35, 0x5a, 0x9f, 0xbb, 0x26, 0x3e, 0xae,
+	0x5b, 0xed, 0x4e, 0xa3, 0x5a, 0x77, 0x73, 0xf8, 0x4d, 0xab, 0xd1, 0xda, 0x6f, 0xbb, 0x16, 0x6a,
+	0xd7, 0xea, 0x7b, 0xc7, 0x9f, 0xbb, 0xb6, 0xff, 0x10, 0x0a, 0x47, 0x1c, 0xbf, 0xd0, 0x65, 0x18,
+	0x3a, 0x71, 0x8e, 0x34, 0xcc, 0xa1, 0x8a, 0xda, 0x7b, 0x0f, 0x36, 0xa2, 0x24, 0xe0, 0xec, 0x8a,
+	0xe3, 0x88, 0x37, 0x3a, 0xfd, 0xc2, 0x1c, 0x9d, 0x9e, 0xe6, 0x45, 0xbe, 0x7c, 0xf6, 0xaf, 0x01,
+	0x00, 0x35, 0xa1, 0x10, 0xe2, 0xe5, 0x14, 0x00, 0x00,
 }
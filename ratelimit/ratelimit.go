@@ -0,0 +1,89 @@
+// Package ratelimit provides token bucket rate limits keyed by peer, ip or client
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often buckets which have refilled are dropped
+const sweepInterval = time.Minute
+
+// Limiter is a set of token buckets, one per key.
+// A nil Limiter allows everything.
+type Limiter struct {
+	rate  float64 // tokens per second
+	burst float64
+
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+	lock      sync.Mutex
+}
+
+// bucket holds the tokens left for a key at the time of its last use
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New returns a limiter allowing perMinute requests per key on average and bursts
+// of up to burst requests. It returns nil, i.e., no limit, when perMinute is not positive.
+func New(perMinute float64, burst int) *Limiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:    perMinute / 60,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the key's bucket, returning false if it is empty
+func (l *Limiter) Allow(key string) bool {
+	if l == nil {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * l.rate
+		if b.tokens > l.burst {
+			b.tokens = l.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep drops buckets which would be full by now, they are recreated full on demand
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := New(60, 3)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if !l.Allow("a") {
+			t.Fatalf("request %d within burst was limited", i)
+		}
+	}
+	if l.Allow("a") {
+		t.Fatal("request over burst was allowed")
+	}
+	if !l.Allow("b") {
+		t.Fatal("keys should not share a bucket")
+	}
+
+	// one token per second refills
+	now = now.Add(time.Second)
+	if !l.Allow("a") {
+		t.Fatal("refilled token was not allowed")
+	}
+	if l.Allow("a") {
+		t.Fatal("request over refill was allowed")
+	}
+
+	// buckets never hold more than burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		l.Allow("a")
+	}
+	if l.Allow("a") {
+		t.Fatal("bucket refilled over burst")
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	now := time.Now()
+	l := New(60, 3)
+	l.now = func() time.Time { return now }
+
+	l.Allow("a")
+	l.Allow("b")
+	now = now.Add(sweepInterval)
+	l.Allow("b")
+	if len(l.buckets) != 1 {
+		t.Fatalf("expected refilled bucket to be dropped, got %d buckets", len(l.buckets))
+	}
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	var l *Limiter
+	if l = New(0, 10); l != nil {
+		t.Fatal("expected zero rate to disable the limit")
+	}
+	for i := 0; i < 100; i++ {
+		if !l.Allow("a") {
+			t.Fatal("disabled limiter limited a request")
+		}
+	}
+}
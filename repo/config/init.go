@@ -12,15 +12,19 @@ import (
 
 // Config is used to load textile config files.
 type Config struct {
-	Account   Account      // local node's account (public info only)
-	Addresses Addresses    // local node's addresses
-	API       API          // local node's API settings
-	Gateway   Gateway      // local node's Gateway settings
-	Logs      Logs         // local node's log settings
-	IsMobile  bool         // local node is setup for mobile
-	IsServer  bool         // local node is setup for a server w/ a public IP
-	Cafe      Cafe         // local node cafe settings
-	Bots      []EnabledBot // local node enabled bots
+	Account    Account      // local node's account (public info only)
+	Addresses  Addresses    // local node's addresses
+	API        API          // local node's API settings
+	Gateway    Gateway      // local node's Gateway settings
+	Logs       Logs         // local node's log settings
+	IsMobile   bool         // local node is setup for mobile
+	IsServer   bool         // local node is setup for a server w/ a public IP
+	IsLAN      bool         // local node is setup for direct messaging on a local network w/o internet
+	Cafe       Cafe         // local node cafe settings
+	TLS        TLS          // local node https settings for the cafe api and gateway
+	Profile    Profile      // local node public profile settings
+	Blockstore Blockstore   // local node ipfs blockstore settings
+	Bots       []EnabledBot // local node enabled bots
 }
 
 // EnabledBot store settings for an enabled bot
@@ -41,6 +45,7 @@ type Addresses struct {
 	CafeAPI   string // bind address of the cafe REST API
 	Gateway   string // bind address of the IPFS object gateway
 	Profiling string // bind address of the profiling API
+	Metrics   string // bind address of the prometheus metrics endpoint, disabled if empty
 }
 
 type SwarmPorts struct {
@@ -59,7 +64,12 @@ type API struct {
 
 // Gateway settings
 type Gateway struct {
-	HTTPHeaders HTTPHeaders
+	HTTPHeaders      HTTPHeaders
+	PublishedThreads []string          // read only and public threads rendered as web pages
+	Hosts            map[string]string // hostname to served path, i.e., /ipfs/<cid>, /ipns/<key or domain> or /threads/<id>
+	DNSLink          bool              // serve other hostnames which have a _dnslink TXT record
+	DNSLinkDomains   []string          // domains, and their subdomains, which DNSLink may serve
+	SubdomainHosts   []string          // hostnames serving <cid>.ipfs.<host> and <key>.ipns.<host>
 }
 
 // Logs settings
@@ -74,10 +84,62 @@ type Cafe struct {
 
 // CafeHost settings
 type CafeHost struct {
-	Open        bool   // When true, other peers can register with this node for cafe services.
-	URL         string // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
-	NeighborURL string // Specifies the URL of a secondary cafe. Must return cafe info.
-	SizeLimit   int64  // Maximum file size limit to accept for POST requests in bytes.
+	Open        bool     // When true, other peers can register with this node for cafe services.
+	URL         string   // Override the resolved URL of this cafe, useful for load balancers or a TLS host name
+	NeighborURL string   // Specifies the URL of a secondary cafe. Must return cafe info.
+	Neighbors   []string // Peer ids or multiaddrs of cafes which mirror client data with this cafe. Each must list this cafe too.
+	SizeLimit   int64    // Maximum file size limit to accept for POST requests in bytes.
+	AdminToken  string   // Bcrypt hash of the token required by the cafe admin API, which is disabled when empty.
+	Limits      CafeLimits
+}
+
+// CafeLimits settings, rates are token buckets refilled per minute and zero disables a limit.
+// Settings missing from a config, e.g., one written by an older version, use the defaults.
+type CafeLimits struct {
+	RegistrationRate  float64 // registrations per peer ID and registration challenges per IP
+	RegistrationBurst int
+	MessageRate       float64 // inbox messages delivered to each client
+	MessageBurst      int
+	MaxInboxMessages  int     // messages waiting in each client's inbox
+	QueryRate         float64 // search queries from each client or pubsub peer
+	QueryBurst        int
+	NonceTTL          string   // how long a registration challenge is valid, e.g., 5m
+	TrustedProxies    []string // IPs or CIDRs of reverse proxies whose X-Forwarded-For is trusted
+}
+
+// TLS settings, shared by the cafe api and gateway
+type TLS struct {
+	CafeAPI      bool     // serve the cafe api over https
+	Gateway      bool     // serve the gateway over https
+	Hosts        []string // hostnames to get ACME certificates for
+	Email        string   // ACME account contact
+	DirectoryURL string   // ACME directory, defaults to Let's Encrypt, e.g., a local Pebble for tests
+	DirectoryCA  string   // PEM file of an extra root CA trusted when talking to the ACME directory
+	CertFile     string   // static certificate file, used instead of ACME and reloaded when it changes
+	KeyFile      string   // static certificate key file
+	RedirectAddr string   // plain http address (usually :80) which answers ACME challenges and redirects to https
+}
+
+// Profile settings
+type Profile struct {
+	Publish bool // maintain a signed ipns record of the account profile and web published threads
+}
+
+// Blockstore settings
+type Blockstore struct {
+	S3 S3 // back the ipfs blockstore of a server or cafe with S3-compatible object storage
+}
+
+// S3 settings, the blockstore stays local when Bucket is empty
+type S3 struct {
+	Bucket      string
+	Prefix      string // key prefix of blocks in the bucket
+	Region      string
+	Endpoint    string // for non-AWS providers, e.g., http://127.0.0.1:9000
+	AccessKey   string // if empty, credentials are taken from the AWS_ environment variables
+	SecretKey   string
+	CacheBlocks int    // number of recently used blocks kept in the local blockstore
+	GCPeriod    string // how often unpinned blocks are removed from the bucket, 0 disables
 }
 
 // Init returns the default textile config
@@ -92,6 +154,7 @@ func Init() (*Config, error) {
 			CafeAPI:   "0.0.0.0:40601",
 			Gateway:   "127.0.0.1:5050",
 			Profiling: "127.0.0.1:6060",
+			Metrics:   "127.0.0.1:40602",
 		},
 		API: API{
 			HTTPHeaders: HTTPHeaders{
@@ -160,10 +223,22 @@ func Init() (*Config, error) {
 				URL:         "",
 				NeighborURL: "",
 				SizeLimit:   0,
+				Limits:      DefaultCafeLimits(),
+			},
+		},
+		Profile: Profile{
+			Publish: true,
+		},
+		Blockstore: Blockstore{
+			S3: S3{
+				Prefix:      "blocks",
+				CacheBlocks: 65536,
+				GCPeriod:    "24h",
 			},
 		},
 		IsMobile: false,
 		IsServer: false,
+		IsLAN:    false,
 		Bots:     []EnabledBot{},
 	}, nil
 }
@@ -175,13 +250,30 @@ func Read(repoPath string) (*Config, error) {
 		return nil, err
 	}
 
-	var conf *Config
-	if err := json.Unmarshal(data, &conf); err != nil {
+	// configs written before cafe limits existed get the defaults,
+	// limits which are present, including zeros, are kept
+	conf := &Config{}
+	conf.Cafe.Host.Limits = DefaultCafeLimits()
+	if err := json.Unmarshal(data, conf); err != nil {
 		return nil, err
 	}
 	return conf, nil
 }
 
+// DefaultCafeLimits returns the rate limits and caps of a new cafe
+func DefaultCafeLimits() CafeLimits {
+	return CafeLimits{
+		RegistrationRate:  6,
+		RegistrationBurst: 10,
+		MessageRate:       600,
+		MessageBurst:      100,
+		MaxInboxMessages:  10000,
+		QueryRate:         60,
+		QueryBurst:        20,
+		NonceTTL:          "5m",
+	}
+}
+
 // Write replaces the on-disk version of config with the given one
 func Write(repoPath string, conf *Config) error {
 	f, err := os.Create(path.Join(repoPath, "textile"))
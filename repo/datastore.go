@@ -26,7 +26,14 @@ type Datastore interface {
 	CafeTokens() CafeTokenStore
 	CafeClientThreads() CafeClientThreadStore
 	CafeClientMessages() CafeClientMessageStore
+	CafeClientObjects() CafeClientObjectStore
+	CafeReplicas() CafeReplicaStore
+	CafeBans() CafeBanStore
 	Bots() Botstore
+	ContactVerifications() ContactVerificationStore
+	BlockedAccounts() BlockedAccountStore
+	ThreadReads() ThreadReadStore
+	PeerHealth() PeerHealthStore
 	Ping() error
 	Close()
 }
@@ -59,11 +66,44 @@ type PeerStore interface {
 	Count(query string) int
 	UpdateName(id string, name string) error
 	UpdateAvatar(id string, avatar string) error
+	UpdateProfile(id string, profile string) error
 	UpdateInboxes(id string, inboxes []*pb.Cafe) error
 	Delete(id string) error
 	DeleteByAddress(address string) error
 }
 
+type ContactVerificationStore interface {
+	Queryable
+	AddOrUpdate(verification *pb.ContactVerification) error
+	Get(address string) *pb.ContactVerification
+	Delete(address string) error
+}
+
+type ThreadReadStore interface {
+	Queryable
+	AddOrUpdate(read *pb.ThreadRead) error
+	Get(thread string, peer string) *pb.ThreadRead
+	ListByThread(thread string) *pb.ThreadReadList
+	DeleteByThread(thread string) error
+}
+
+type PeerHealthStore interface {
+	Queryable
+	AddOrUpdate(health *pb.PeerHealth) error
+	Get(peer string) *pb.PeerHealth
+	List() *pb.PeerHealthList
+	Delete(peer string) error
+}
+
+type BlockedAccountStore interface {
+	Queryable
+	Add(account *pb.BlockedAccount) error
+	Get(address string) *pb.BlockedAccount
+	List() *pb.BlockedAccountList
+	Delete(address string) error
+	DeleteAll() error
+}
+
 type Botstore interface {
 	Queryable
 	AddOrUpdate(key string, value []byte) error
@@ -125,7 +165,13 @@ type BlockStore interface {
 type BlockMessageStore interface {
 	Queryable
 	Add(msg *pb.BlockMessage) error
+	Get(id string) *pb.BlockMessage
 	List(offset string, limit int) []pb.BlockMessage
+	ListByPeer(peerId string) []pb.BlockMessage
+	ListQueued() []pb.BlockMessage
+	AddAttempt(id string, lastError string) error
+	Fail(id string, lastError string) error
+	Retry(id string) error
 	Delete(id string) error
 }
 
@@ -174,7 +220,10 @@ type CafeRequestStore interface {
 	UpdateStatus(id string, status pb.CafeRequest_Status) error
 	UpdateGroupStatus(group string, status pb.CafeRequest_Status) error
 	UpdateGroupProgress(group string, transferred int64, total int64) error
-	AddAttempt(id string) error
+	ListQueued() *pb.CafeRequestList
+	AddAttempt(id string, lastError string) error
+	Fail(id string, lastError string) error
+	Retry(id string) error
 	Delete(id string) error
 	DeleteByGroup(groupId string) error
 	DeleteBySyncGroup(syncGroupId string) error
@@ -185,8 +234,12 @@ type CafeRequestStore interface {
 type CafeMessageStore interface {
 	Queryable
 	Add(msg *pb.CafeMessage) error
+	Get(id string) *pb.CafeMessage
 	List(offset string, limit int) []pb.CafeMessage
-	AddAttempt(id string) error
+	ListQueued() []pb.CafeMessage
+	AddAttempt(id string, lastError string) error
+	Fail(id string, lastError string) error
+	Retry(id string) error
 	Delete(id string) error
 }
 
@@ -196,6 +249,7 @@ type CafeClientNonceStore interface {
 	Add(nonce *pb.CafeClientNonce) error
 	Get(value string) *pb.CafeClientNonce
 	Delete(value string) error
+	DeleteBefore(date time.Time) error
 }
 
 type CafeClientStore interface {
@@ -204,7 +258,13 @@ type CafeClientStore interface {
 	Count() int
 	List() []pb.CafeClient
 	ListByAddress(address string) []pb.CafeClient
+	ListByToken(tokenId string) []pb.CafeClient
+	CountByToken(tokenId string) int
+	Search(query string, limit int) []pb.CafeClient
 	UpdateLastSeen(id string, date time.Time) error
+	Expire(id string, date time.Time) error
+	UpdateToken(id string, tokenId string, storageQuota int64, threadQuota int32) error
+	AddStored(id string, size int64) error
 	Delete(id string) error
 }
 
@@ -223,6 +283,27 @@ type CafeClientMessageStore interface {
 	DeleteByClient(clientId string, limit int) error
 }
 
+type CafeClientObjectStore interface {
+	Add(obj *pb.CafeClientObject) error
+	Get(id string, clientId string) *pb.CafeClientObject
+	ListByClient(clientId string) []pb.CafeClientObject
+	Count(id string) int
+	Delete(id string, clientId string) error
+}
+
+type CafeReplicaStore interface {
+	Add(rep *pb.CafeReplica) error
+	ListByNeighbor(neighbor string, limit int) []pb.CafeReplica
+	Delete(id string) error
+}
+
+type CafeBanStore interface {
+	Add(ban *pb.CafeBan) error
+	Get(address string) *pb.CafeBan
+	List() *pb.CafeBanList
+	Delete(address string) error
+}
+
 type CafeTokenStore interface {
 	Add(token *pb.CafeToken) error
 	Get(id string) *pb.CafeToken
@@ -26,7 +26,7 @@ func (c *BlockMessageDB) Add(msg *pb.BlockMessage) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into block_messages(id, peerId, envelope, date) values(?,?,?,?)`
+	stm := `insert into block_messages(id, peerId, envelope, date, attempts, lastError, failed) values(?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -44,6 +44,9 @@ func (c *BlockMessageDB) Add(msg *pb.BlockMessage) error {
 		msg.Peer,
 		env,
 		util.ProtoNanos(msg.Date),
+		msg.Attempts,
+		msg.LastError,
+		msg.Failed,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -53,10 +56,20 @@ func (c *BlockMessageDB) Add(msg *pb.BlockMessage) error {
 	return tx.Commit()
 }
 
+func (c *BlockMessageDB) Get(id string) *pb.BlockMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from block_messages where id='" + id + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
 func (c *BlockMessageDB) List(offset string, limit int) []pb.BlockMessage {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var q string
+	q := "failed=0 "
 	if offset != "" {
 		if q != "" {
 			q += "and "
@@ -70,6 +83,39 @@ func (c *BlockMessageDB) List(offset string, limit int) []pb.BlockMessage {
 	return c.handleQuery("select * from block_messages " + q + "order by date asc limit " + limits + ";")
 }
 
+func (c *BlockMessageDB) ListByPeer(peerId string) []pb.BlockMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from block_messages where peerId='" + peerId + "' and failed=0 order by date asc;")
+}
+
+func (c *BlockMessageDB) ListQueued() []pb.BlockMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from block_messages order by date asc;")
+}
+
+func (c *BlockMessageDB) AddAttempt(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update block_messages set attempts=attempts+1, lastError=? where id=?", lastError, id)
+	return err
+}
+
+func (c *BlockMessageDB) Fail(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update block_messages set attempts=attempts+1, lastError=?, failed=1 where id=?", lastError, id)
+	return err
+}
+
+func (c *BlockMessageDB) Retry(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update block_messages set attempts=0, lastError='', failed=0 where id=?", id)
+	return err
+}
+
 func (c *BlockMessageDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -85,10 +131,11 @@ func (c *BlockMessageDB) handleQuery(stm string) []pb.BlockMessage {
 		return nil
 	}
 	for rows.Next() {
-		var id, peerId string
+		var id, peerId, lastError string
 		var dateInt int64
+		var attempts, failedInt int
 		var envelopeb []byte
-		if err := rows.Scan(&id, &peerId, &envelopeb, &dateInt); err != nil {
+		if err := rows.Scan(&id, &peerId, &envelopeb, &dateInt, &attempts, &lastError, &failedInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -100,10 +147,13 @@ func (c *BlockMessageDB) handleQuery(stm string) []pb.BlockMessage {
 		}
 
 		list = append(list, pb.BlockMessage{
-			Id:   id,
-			Peer: peerId,
-			Env:  env,
-			Date: util.ProtoTs(dateInt),
+			Id:        id,
+			Peer:      peerId,
+			Env:       env,
+			Date:      util.ProtoTs(dateInt),
+			Attempts:  int32(attempts),
+			LastError: lastError,
+			Failed:    failedInt == 1,
 		})
 	}
 	return list
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type BlockedAccountDB struct {
+	modelStore
+}
+
+func NewBlockedAccountStore(db *sql.DB, lock *sync.Mutex) repo.BlockedAccountStore {
+	return &BlockedAccountDB{modelStore{db, lock}}
+}
+
+func (c *BlockedAccountDB) Add(account *pb.BlockedAccount) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into blocked_accounts(address, date) values(?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		account.Address,
+		util.ProtoNanos(account.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *BlockedAccountDB) Get(address string) *pb.BlockedAccount {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from blocked_accounts where address=?", address)
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *BlockedAccountDB) List() *pb.BlockedAccountList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from blocked_accounts order by date desc")
+}
+
+func (c *BlockedAccountDB) Delete(address string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from blocked_accounts where address=?", address)
+	return err
+}
+
+func (c *BlockedAccountDB) DeleteAll() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from blocked_accounts")
+	return err
+}
+
+func (c *BlockedAccountDB) handleQuery(stm string, args ...interface{}) *pb.BlockedAccountList {
+	list := &pb.BlockedAccountList{Items: make([]*pb.BlockedAccount, 0)}
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var address string
+		var dateInt int64
+		if err := rows.Scan(&address, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.BlockedAccount{
+			Address: address,
+			Date:    util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var blockedAccountStore repo.BlockedAccountStore
+
+func init() {
+	setupBlockedAccountDB()
+}
+
+func setupBlockedAccountDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	blockedAccountStore = NewBlockedAccountStore(conn, new(sync.Mutex))
+}
+
+func TestBlockedAccountDB_Add(t *testing.T) {
+	err := blockedAccountStore.Add(&pb.BlockedAccount{
+		Address: "address1",
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = blockedAccountStore.Add(&pb.BlockedAccount{
+		Address: "address2",
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBlockedAccountDB_Get(t *testing.T) {
+	if blockedAccountStore.Get("address1") == nil {
+		t.Error("could not get blocked account")
+	}
+}
+
+func TestBlockedAccountDB_List(t *testing.T) {
+	list := blockedAccountStore.List()
+	if len(list.Items) != 2 {
+		t.Error("wrong number of blocked accounts")
+	}
+}
+
+func TestBlockedAccountDB_Delete(t *testing.T) {
+	err := blockedAccountStore.Delete("address1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if blockedAccountStore.Get("address1") != nil {
+		t.Error("delete failed")
+	}
+}
+
+func TestBlockedAccountDB_DeleteAll(t *testing.T) {
+	err := blockedAccountStore.DeleteAll()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(blockedAccountStore.List().Items) != 0 {
+		t.Error("delete all failed")
+	}
+}
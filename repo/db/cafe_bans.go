@@ -0,0 +1,90 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type CafeBanDB struct {
+	modelStore
+}
+
+func NewCafeBanStore(db *sql.DB, lock *sync.Mutex) repo.CafeBanStore {
+	return &CafeBanDB{modelStore{db, lock}}
+}
+
+func (c *CafeBanDB) Add(ban *pb.CafeBan) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_bans(address, reason, date) values(?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		ban.Address,
+		ban.Reason,
+		util.ProtoNanos(ban.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeBanDB) Get(address string) *pb.CafeBan {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_bans where address=?", address)
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *CafeBanDB) List() *pb.CafeBanList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from cafe_bans order by date desc")
+}
+
+func (c *CafeBanDB) Delete(address string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_bans where address=?", address)
+	return err
+}
+
+func (c *CafeBanDB) handleQuery(stm string, args ...interface{}) *pb.CafeBanList {
+	list := &pb.CafeBanList{Items: make([]*pb.CafeBan, 0)}
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var address, reason string
+		var dateInt int64
+		if err := rows.Scan(&address, &reason, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.CafeBan{
+			Address: address,
+			Reason:  reason,
+			Date:    util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var cafeBanStore repo.CafeBanStore
+
+func init() {
+	setupCafeBanDB()
+}
+
+func setupCafeBanDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeBanStore = NewCafeBanStore(conn, new(sync.Mutex))
+}
+
+func TestCafeBanDB_Add(t *testing.T) {
+	err := cafeBanStore.Add(&pb.CafeBan{
+		Address: "address1",
+		Reason:  "spam",
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = cafeBanStore.Add(&pb.CafeBan{
+		Address: "address2",
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCafeBanDB_Get(t *testing.T) {
+	ban := cafeBanStore.Get("address1")
+	if ban == nil {
+		t.Error("could not get ban")
+		return
+	}
+	if ban.Reason != "spam" {
+		t.Error("wrong reason")
+	}
+}
+
+func TestCafeBanDB_List(t *testing.T) {
+	list := cafeBanStore.List()
+	if len(list.Items) != 2 {
+		t.Error("wrong number of bans")
+	}
+}
+
+func TestCafeBanDB_Delete(t *testing.T) {
+	err := cafeBanStore.Delete("address1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeBanStore.Get("address1") != nil {
+		t.Error("delete failed")
+	}
+}
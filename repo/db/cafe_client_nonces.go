@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/repo"
@@ -60,6 +61,13 @@ func (c *CafeClientNonceDB) Delete(value string) error {
 	return err
 }
 
+func (c *CafeClientNonceDB) DeleteBefore(date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_nonces where date<?", date.UnixNano())
+	return err
+}
+
 func (c *CafeClientNonceDB) handleQuery(stm string) []pb.CafeClientNonce {
 	var list []pb.CafeClientNonce
 	rows, err := c.db.Query(stm)
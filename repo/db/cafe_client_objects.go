@@ -0,0 +1,102 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type CafeClientObjectsDB struct {
+	modelStore
+}
+
+func NewCafeClientObjectStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientObjectStore {
+	return &CafeClientObjectsDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientObjectsDB) Add(obj *pb.CafeClientObject) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into cafe_client_objects(id, clientId, size, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		obj.Id,
+		obj.Client,
+		obj.Size,
+		util.ProtoNanos(obj.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeClientObjectsDB) Get(id string, clientId string) *pb.CafeClientObject {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_objects where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientObjectsDB) ListByClient(clientId string) []pb.CafeClientObject {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_objects where clientId='" + clientId + "' order by date asc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientObjectsDB) Count(id string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_objects where id='" + id + "';")
+	var count int
+	_ = row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientObjectsDB) Delete(id string, clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_objects where id=? and clientId=?", id, clientId)
+	return err
+}
+
+func (c *CafeClientObjectsDB) handleQuery(stm string) []pb.CafeClientObject {
+	var list []pb.CafeClientObject
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, clientId string
+		var size, dateInt int64
+		if err := rows.Scan(&id, &clientId, &size, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientObject{
+			Id:     id,
+			Client: clientId,
+			Size:   size,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
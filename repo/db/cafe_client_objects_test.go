@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var cafeClientObjectStore repo.CafeClientObjectStore
+
+func init() {
+	setupCafeClientObjectDB()
+}
+
+func setupCafeClientObjectDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeClientObjectStore = NewCafeClientObjectStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientObjectDB_Add(t *testing.T) {
+	for _, client := range []string{"client1", "client2"} {
+		err := cafeClientObjectStore.Add(&pb.CafeClientObject{
+			Id:     "object",
+			Client: client,
+			Size:   1024,
+			Date:   ptypes.TimestampNow(),
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	err := cafeClientObjectStore.Add(&pb.CafeClientObject{
+		Id:     "object",
+		Client: "client1",
+		Size:   1024,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err == nil {
+		t.Error("a client should store an object once")
+	}
+}
+
+func TestCafeClientObjectDB_Get(t *testing.T) {
+	obj := cafeClientObjectStore.Get("object", "client1")
+	if obj == nil {
+		t.Error("could not get object")
+		return
+	}
+	if obj.Size != 1024 {
+		t.Error("wrong size")
+	}
+	if cafeClientObjectStore.Get("object", "client3") != nil {
+		t.Error("object should not belong to client3")
+	}
+}
+
+func TestCafeClientObjectDB_ListByClient(t *testing.T) {
+	list := cafeClientObjectStore.ListByClient("client2")
+	if len(list) != 1 || list[0].Id != "object" {
+		t.Error("wrong objects for client2")
+	}
+}
+
+func TestCafeClientObjectDB_Count(t *testing.T) {
+	if cafeClientObjectStore.Count("object") != 2 {
+		t.Error("object should have two owners")
+	}
+}
+
+func TestCafeClientObjectDB_Delete(t *testing.T) {
+	if err := cafeClientObjectStore.Delete("object", "client1"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientObjectStore.Count("object") != 1 {
+		t.Error("delete failed")
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strconv"
 	"sync"
 	"time"
 
@@ -25,19 +26,27 @@ func (c *CafeClientDB) Add(client *pb.CafeClient) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId) values(?,?,?,?,?)`
+	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId, expired, stored, storageQuota, threadQuota) values(?,?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
 		return err
 	}
 	defer stmt.Close()
+	var expired int64
+	if client.Expired != nil {
+		expired = util.ProtoNanos(client.Expired)
+	}
 	_, err = stmt.Exec(
 		client.Id,
 		client.Address,
 		util.ProtoNanos(client.Created),
 		util.ProtoNanos(client.Seen),
 		client.Token,
+		expired,
+		client.Stored,
+		client.StorageQuota,
+		client.ThreadQuota,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -79,6 +88,33 @@ func (c *CafeClientDB) ListByAddress(address string) []pb.CafeClient {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeClientDB) ListByToken(tokenId string) []pb.CafeClient {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_clients where tokenId=? order by lastSeen desc;"
+	return c.handleQuery(stm, tokenId)
+}
+
+func (c *CafeClientDB) CountByToken(tokenId string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_clients where tokenId=?;", tokenId)
+	var count int
+	_ = row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientDB) Search(query string, limit int) []pb.CafeClient {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	like := "%" + query + "%"
+	stm := "select * from cafe_clients where id like ? or address like ? order by lastSeen desc"
+	if limit > 0 {
+		stm += " limit " + strconv.Itoa(limit)
+	}
+	return c.handleQuery(stm+";", like, like)
+}
+
 func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -86,6 +122,27 @@ func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	return err
 }
 
+func (c *CafeClientDB) Expire(id string, date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set expired=? where id=?", int64(date.UnixNano()), id)
+	return err
+}
+
+func (c *CafeClientDB) UpdateToken(id string, tokenId string, storageQuota int64, threadQuota int32) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set tokenId=?, storageQuota=?, threadQuota=? where id=?", tokenId, storageQuota, threadQuota, id)
+	return err
+}
+
+func (c *CafeClientDB) AddStored(id string, size int64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set stored=max(stored+?, 0) where id=?", size, id)
+	return err
+}
+
 func (c *CafeClientDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -93,27 +150,35 @@ func (c *CafeClientDB) Delete(id string) error {
 	return err
 }
 
-func (c *CafeClientDB) handleQuery(stm string) []pb.CafeClient {
+func (c *CafeClientDB) handleQuery(stm string, args ...interface{}) []pb.CafeClient {
 	var list []pb.CafeClient
-	rows, err := c.db.Query(stm)
+	rows, err := c.db.Query(stm, args...)
 	if err != nil {
 		log.Errorf("error in db query: %s", err)
 		return nil
 	}
 	for rows.Next() {
 		var id, address, tokenId string
-		var createdInt, lastSeenInt int64
-		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId); err != nil {
+		var createdInt, lastSeenInt, expiredInt, stored, storageQuota int64
+		var threadQuota int32
+		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId, &expiredInt, &stored, &storageQuota, &threadQuota); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		list = append(list, pb.CafeClient{
-			Id:      id,
-			Address: address,
-			Created: util.ProtoTs(createdInt),
-			Seen:    util.ProtoTs(lastSeenInt),
-			Token:   tokenId,
-		})
+		client := pb.CafeClient{
+			Id:           id,
+			Address:      address,
+			Created:      util.ProtoTs(createdInt),
+			Seen:         util.ProtoTs(lastSeenInt),
+			Token:        tokenId,
+			Stored:       stored,
+			StorageQuota: storageQuota,
+			ThreadQuota:  threadQuota,
+		}
+		if expiredInt > 0 {
+			client.Expired = util.ProtoTs(expiredInt)
+		}
+		list = append(list, client)
 	}
 	return list
 }
@@ -25,7 +25,7 @@ func (c *CafeMessageDB) Add(req *pb.CafeMessage) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_messages(id, peerId, date, attempts) values(?,?,?,?)`
+	stm := `insert into cafe_messages(id, peerId, date, attempts, lastError, failed) values(?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -37,6 +37,8 @@ func (c *CafeMessageDB) Add(req *pb.CafeMessage) error {
 		req.Peer,
 		util.ProtoNanos(req.Date),
 		req.Attempts,
+		req.LastError,
+		req.Failed,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -45,22 +47,52 @@ func (c *CafeMessageDB) Add(req *pb.CafeMessage) error {
 	return tx.Commit()
 }
 
+func (c *CafeMessageDB) Get(id string) *pb.CafeMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_messages where id='" + id + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
 func (c *CafeMessageDB) List(offset string, limit int) []pb.CafeMessage {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	var stm string
 	if offset != "" {
-		stm = "select * from cafe_messages where date>(select date from cafe_messages where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
+		stm = "select * from cafe_messages where failed=0 and date>(select date from cafe_messages where id='" + offset + "') order by date asc limit " + strconv.Itoa(limit) + ";"
 	} else {
-		stm = "select * from cafe_messages order by date asc limit " + strconv.Itoa(limit) + ";"
+		stm = "select * from cafe_messages where failed=0 order by date asc limit " + strconv.Itoa(limit) + ";"
 	}
 	return c.handleQuery(stm)
 }
 
-func (c *CafeMessageDB) AddAttempt(id string) error {
+func (c *CafeMessageDB) ListQueued() []pb.CafeMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from cafe_messages order by date asc;")
+}
+
+func (c *CafeMessageDB) AddAttempt(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_messages set attempts=attempts+1, lastError=? where id=?", lastError, id)
+	return err
+}
+
+func (c *CafeMessageDB) Fail(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_messages set attempts=attempts+1, lastError=?, failed=1 where id=?", lastError, id)
+	return err
+}
+
+func (c *CafeMessageDB) Retry(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	_, err := c.db.Exec("update cafe_messages set attempts=attempts+1 where id=?", id)
+	_, err := c.db.Exec("update cafe_messages set attempts=0, lastError='', failed=0 where id=?", id)
 	return err
 }
 
@@ -79,18 +111,20 @@ func (c *CafeMessageDB) handleQuery(stm string) []pb.CafeMessage {
 		return nil
 	}
 	for rows.Next() {
-		var id, peerId string
+		var id, peerId, lastError string
 		var dateInt int64
-		var attempts int
-		if err := rows.Scan(&id, &peerId, &dateInt, &attempts); err != nil {
+		var attempts, failedInt int
+		if err := rows.Scan(&id, &peerId, &dateInt, &attempts, &lastError, &failedInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
 		list = append(list, pb.CafeMessage{
-			Id:       id,
-			Peer:     peerId,
-			Date:     util.ProtoTs(dateInt),
-			Attempts: int32(attempts),
+			Id:        id,
+			Peer:      peerId,
+			Date:      util.ProtoTs(dateInt),
+			Attempts:  int32(attempts),
+			LastError: lastError,
+			Failed:    failedInt == 1,
 		})
 	}
 	return list
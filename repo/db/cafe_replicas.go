@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type CafeReplicaDB struct {
+	modelStore
+}
+
+func NewCafeReplicaStore(db *sql.DB, lock *sync.Mutex) repo.CafeReplicaStore {
+	return &CafeReplicaDB{modelStore{db, lock}}
+}
+
+func (c *CafeReplicaDB) Add(rep *pb.CafeReplica) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into cafe_replicas(id, neighbor, body, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		rep.Id,
+		rep.Neighbor,
+		rep.Body,
+		util.ProtoNanos(rep.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeReplicaDB) ListByNeighbor(neighbor string, limit int) []pb.CafeReplica {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_replicas where neighbor='" + neighbor + "' order by date asc, rowid asc limit " + strconv.Itoa(limit) + ";"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeReplicaDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_replicas where id=?", id)
+	return err
+}
+
+func (c *CafeReplicaDB) handleQuery(stm string) []pb.CafeReplica {
+	var list []pb.CafeReplica
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, neighbor string
+		var body []byte
+		var dateInt int64
+		if err := rows.Scan(&id, &neighbor, &body, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeReplica{
+			Id:       id,
+			Neighbor: neighbor,
+			Body:     body,
+			Date:     util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
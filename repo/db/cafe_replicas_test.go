@@ -0,0 +1,72 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var cafeReplicaStore repo.CafeReplicaStore
+
+func init() {
+	setupCafeReplicaDB()
+}
+
+func setupCafeReplicaDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeReplicaStore = NewCafeReplicaStore(conn, new(sync.Mutex))
+}
+
+func TestCafeReplicaDB_Add(t *testing.T) {
+	now := time.Now()
+	for i, id := range []string{"rep1", "rep2", "rep3"} {
+		date, _ := ptypes.TimestampProto(now.Add(time.Second * time.Duration(i)))
+		err := cafeReplicaStore.Add(&pb.CafeReplica{
+			Id:       id,
+			Neighbor: "neighbor",
+			Body:     []byte(id),
+			Date:     date,
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	err := cafeReplicaStore.Add(&pb.CafeReplica{
+		Id:       "other",
+		Neighbor: "other",
+		Body:     []byte("other"),
+		Date:     ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCafeReplicaDB_ListByNeighbor(t *testing.T) {
+	list := cafeReplicaStore.ListByNeighbor("neighbor", 2)
+	if len(list) != 2 {
+		t.Errorf("wrong number of replicas: %d", len(list))
+		return
+	}
+	if list[0].Id != "rep1" || list[1].Id != "rep2" || string(list[0].Body) != "rep1" {
+		t.Error("replicas are not in order")
+	}
+}
+
+func TestCafeReplicaDB_Delete(t *testing.T) {
+	if err := cafeReplicaStore.Delete("rep1"); err != nil {
+		t.Error(err)
+		return
+	}
+	list := cafeReplicaStore.ListByNeighbor("neighbor", 1)
+	if len(list) != 1 || list[0].Id != "rep2" {
+		t.Error("delete failed")
+	}
+}
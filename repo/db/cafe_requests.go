@@ -29,8 +29,8 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 	}
 	stmt, err := tx.Prepare(`
         INSERT INTO cafe_requests(
-    	    id, peerId, targetId, cafeId, cafe, groupId, syncGroupId, type, date, size, status, attempts, groupSize, groupTransferred
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)
+    	    id, peerId, targetId, cafeId, cafe, groupId, syncGroupId, type, date, size, status, attempts, groupSize, groupTransferred, lastError
+        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)
     `)
 	if err != nil {
 		return err
@@ -57,6 +57,7 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 		req.Attempts,
 		req.GroupSize,
 		req.GroupTransferred,
+		req.LastError,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -189,10 +190,10 @@ func (c *CafeRequestDB) SyncGroupComplete(syncGroupId string) bool {
 		        GROUP BY syncGroupId) a
 		JOIN   (SELECT syncGroupId, COUNT(*) as total_complete
 		        FROM   cafe_requests
-    		    WHERE  syncGroupId=? AND status=?
+    		    WHERE  syncGroupId=? AND (status=? OR status=?)
 	    	    GROUP BY syncGroupId) b
 		ON     a.syncGroupId = b.syncGroupId AND a.total = b.total_complete
-    `, syncGroupId, syncGroupId, pb.CafeRequest_COMPLETE)
+    `, syncGroupId, syncGroupId, pb.CafeRequest_COMPLETE, pb.CafeRequest_FAILED)
 	if err != nil {
 		log.Errorf("error in db query: %s", err)
 		return false
@@ -284,11 +285,41 @@ func (c *CafeRequestDB) UpdateGroupProgress(groupId string, transferred int64, t
 	return err
 }
 
-func (c *CafeRequestDB) AddAttempt(id string) error {
+func (c *CafeRequestDB) ListQueued() *pb.CafeRequestList {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	_, err := c.db.Exec("UPDATE cafe_requests SET attempts=attempts+1 WHERE id=?", id)
+	stm := "SELECT * FROM cafe_requests WHERE status!=" + strconv.Itoa(int(pb.CafeRequest_COMPLETE))
+	stm += " ORDER BY date ASC;"
+
+	return c.handleQuery(stm)
+}
+
+func (c *CafeRequestDB) AddAttempt(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec("UPDATE cafe_requests SET attempts=attempts+1, lastError=? WHERE id=?", lastError, id)
+	return err
+}
+
+func (c *CafeRequestDB) Fail(id string, lastError string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec(
+		"UPDATE cafe_requests SET attempts=attempts+1, lastError=?, status=? WHERE id=?",
+		lastError, int32(pb.CafeRequest_FAILED), id)
+	return err
+}
+
+func (c *CafeRequestDB) Retry(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec(
+		"UPDATE cafe_requests SET attempts=0, lastError='', status=? WHERE id=?",
+		int32(pb.CafeRequest_NEW), id)
 	return err
 }
 
@@ -358,7 +389,7 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 	}
 
 	for rows.Next() {
-		var id, peerId, targetId, cafeId, groupId, syncGroupId string
+		var id, peerId, targetId, cafeId, groupId, syncGroupId, lastError string
 		var typeInt, statusInt, attempts int
 		var dateInt, size, groupSize, groupTransferred int64
 		var cafe []byte
@@ -377,7 +408,8 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 			&statusInt,
 			&attempts,
 			&groupSize,
-			&groupTransferred)
+			&groupTransferred,
+			&lastError)
 		if err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
@@ -404,6 +436,7 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 			Attempts:         int32(attempts),
 			GroupSize:        groupSize,
 			GroupTransferred: groupTransferred,
+			LastError:        lastError,
 		})
 	}
 
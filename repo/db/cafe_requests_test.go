@@ -339,7 +339,7 @@ func TestCafeRequestDB_DeleteCompleteSyncGroupsAgain(t *testing.T) {
 }
 
 func TestCafeRequestDB_AddAttempt(t *testing.T) {
-	err := cafeRequestStore.AddAttempt("abcde")
+	err := cafeRequestStore.AddAttempt("abcde", "timeout")
 	if err != nil {
 		t.Error(err)
 		return
@@ -348,6 +348,47 @@ func TestCafeRequestDB_AddAttempt(t *testing.T) {
 	if req.Attempts != 1 {
 		t.Error("wrong attempts")
 	}
+	if req.LastError != "timeout" {
+		t.Error("wrong last error")
+	}
+}
+
+func TestCafeRequestDB_Fail(t *testing.T) {
+	err := cafeRequestStore.Fail("abcde", "refused")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	req := cafeRequestStore.Get("abcde")
+	if req.Status != pb.CafeRequest_FAILED || req.Attempts != 2 || req.LastError != "refused" {
+		t.Error("request should be failed")
+	}
+	for _, r := range cafeRequestStore.List("", -1).Items {
+		if r.Id == "abcde" {
+			t.Error("failed requests should not be listed as new")
+		}
+	}
+	var queued bool
+	for _, r := range cafeRequestStore.ListQueued().Items {
+		if r.Id == "abcde" {
+			queued = true
+		}
+	}
+	if !queued {
+		t.Error("failed requests should be listed as queued")
+	}
+}
+
+func TestCafeRequestDB_Retry(t *testing.T) {
+	err := cafeRequestStore.Retry("abcde")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	req := cafeRequestStore.Get("abcde")
+	if req.Status != pb.CafeRequest_NEW || req.Attempts != 0 || req.LastError != "" {
+		t.Error("request should be reset")
+	}
 }
 
 func TestCafeRequestDB_Delete(t *testing.T) {
@@ -24,17 +24,26 @@ func (c *CafeTokenDB) Add(token *pb.CafeToken) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_tokens(id, token, date) values(?,?,?)`
+	stm := `insert into cafe_tokens(id, token, date, label, expiry, maxClients, storageQuota, threadQuota) values(?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
 		return err
 	}
 	defer stmt.Close()
+	var expiry int64
+	if token.Expiry != nil {
+		expiry = util.ProtoNanos(token.Expiry)
+	}
 	_, err = stmt.Exec(
 		token.Id,
 		token.Value,
 		util.ProtoNanos(token.Date),
+		token.Label,
+		expiry,
+		token.MaxClients,
+		token.StorageQuota,
+		token.ThreadQuota,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -75,18 +84,27 @@ func (c *CafeTokenDB) handleQuery(stm string) []pb.CafeToken {
 		return nil
 	}
 	for rows.Next() {
-		var id string
+		var id, label string
 		var token []byte
-		var dateInt int64
-		if err := rows.Scan(&id, &token, &dateInt); err != nil {
+		var dateInt, expiryInt, storageQuota int64
+		var maxClients, threadQuota int32
+		if err := rows.Scan(&id, &token, &dateInt, &label, &expiryInt, &maxClients, &storageQuota, &threadQuota); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		list = append(list, pb.CafeToken{
-			Id:    id,
-			Value: token,
-			Date:  util.ProtoTs(dateInt),
-		})
+		tok := pb.CafeToken{
+			Id:           id,
+			Value:        token,
+			Date:         util.ProtoTs(dateInt),
+			Label:        label,
+			MaxClients:   maxClients,
+			StorageQuota: storageQuota,
+			ThreadQuota:  threadQuota,
+		}
+		if expiryInt > 0 {
+			tok.Expiry = util.ProtoTs(expiryInt)
+		}
+		list = append(list, tok)
 	}
 	return list
 }
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var cafeTokenStore repo.CafeTokenStore
+
+func init() {
+	setupCafeTokenDB()
+}
+
+func setupCafeTokenDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeTokenStore = NewCafeTokenStore(conn, new(sync.Mutex))
+}
+
+func TestCafeTokenDB_Add(t *testing.T) {
+	expiry, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	err := cafeTokenStore.Add(&pb.CafeToken{
+		Id:           "token1",
+		Value:        []byte("hash"),
+		Date:         ptypes.TimestampNow(),
+		Label:        "partner",
+		Expiry:       expiry,
+		MaxClients:   10,
+		StorageQuota: 1024,
+		ThreadQuota:  5,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = cafeTokenStore.Add(&pb.CafeToken{
+		Id:    "token2",
+		Value: []byte("hash"),
+		Date:  ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCafeTokenDB_Get(t *testing.T) {
+	token := cafeTokenStore.Get("token1")
+	if token == nil {
+		t.Error("could not get token")
+		return
+	}
+	if token.Label != "partner" || token.Expiry == nil {
+		t.Error("wrong label or expiry")
+	}
+	if token.MaxClients != 10 || token.StorageQuota != 1024 || token.ThreadQuota != 5 {
+		t.Error("wrong limits")
+	}
+	if cafeTokenStore.Get("token2").Expiry != nil {
+		t.Error("token without expiry should not expire")
+	}
+}
+
+func TestCafeTokenDB_List(t *testing.T) {
+	if len(cafeTokenStore.List()) != 2 {
+		t.Error("wrong number of tokens")
+	}
+}
+
+func TestCafeTokenDB_Delete(t *testing.T) {
+	err := cafeTokenStore.Delete("token2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeTokenStore.Get("token2") != nil {
+		t.Error("delete failed")
+	}
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type ContactVerificationDB struct {
+	modelStore
+}
+
+func NewContactVerificationStore(db *sql.DB, lock *sync.Mutex) repo.ContactVerificationStore {
+	return &ContactVerificationDB{modelStore{db, lock}}
+}
+
+func (c *ContactVerificationDB) AddOrUpdate(verification *pb.ContactVerification) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into contact_verifications(address, peers, date) values(?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		verification.Address,
+		strings.Join(verification.Peers, ","),
+		util.ProtoNanos(verification.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *ContactVerificationDB) Get(address string) *pb.ContactVerification {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from contact_verifications where address=?", address)
+	if len(res) == 0 {
+		return nil
+	}
+	return res[0]
+}
+
+func (c *ContactVerificationDB) Delete(address string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from contact_verifications where address=?", address)
+	return err
+}
+
+func (c *ContactVerificationDB) handleQuery(stm string, args ...interface{}) []*pb.ContactVerification {
+	list := make([]*pb.ContactVerification, 0)
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var address, peers string
+		var dateInt int64
+		if err := rows.Scan(&address, &peers, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, &pb.ContactVerification{
+			Address: address,
+			Peers:   util.SplitString(peers, ","),
+			Date:    util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var contactVerificationStore repo.ContactVerificationStore
+
+func init() {
+	setupContactVerificationDB()
+}
+
+func setupContactVerificationDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	contactVerificationStore = NewContactVerificationStore(conn, new(sync.Mutex))
+}
+
+func TestContactVerificationDB_AddOrUpdate(t *testing.T) {
+	err := contactVerificationStore.AddOrUpdate(&pb.ContactVerification{
+		Address: "address",
+		Peers:   []string{"peer1"},
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = contactVerificationStore.AddOrUpdate(&pb.ContactVerification{
+		Address: "address",
+		Peers:   []string{"peer1", "peer2"},
+		Date:    ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestContactVerificationDB_Get(t *testing.T) {
+	verification := contactVerificationStore.Get("address")
+	if verification == nil {
+		t.Error("could not get verification")
+		return
+	}
+	if len(verification.Peers) != 2 {
+		t.Error("wrong number of peers")
+	}
+}
+
+func TestContactVerificationDB_Delete(t *testing.T) {
+	err := contactVerificationStore.Delete("address")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if contactVerificationStore.Get("address") != nil {
+		t.Error("delete failed")
+	}
+}
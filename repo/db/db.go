@@ -23,26 +23,33 @@ var pbUnmarshaler = jsonpb.Unmarshaler{
 }
 
 type SQLiteDatastore struct {
-	config             repo.ConfigStore
-	peers              repo.PeerStore
-	files              repo.FileStore
-	threads            repo.ThreadStore
-	threadPeers        repo.ThreadPeerStore
-	blocks             repo.BlockStore
-	blockMessages      repo.BlockMessageStore
-	invites            repo.InviteStore
-	notifications      repo.NotificationStore
-	cafeSessions       repo.CafeSessionStore
-	cafeRequests       repo.CafeRequestStore
-	cafeMessages       repo.CafeMessageStore
-	cafeClientNonces   repo.CafeClientNonceStore
-	cafeClients        repo.CafeClientStore
-	cafeTokens         repo.CafeTokenStore
-	cafeClientThreads  repo.CafeClientThreadStore
-	cafeClientMessages repo.CafeClientMessageStore
-	botsStore          repo.Botstore
-	db                 *sql.DB
-	lock               *sync.Mutex
+	config               repo.ConfigStore
+	peers                repo.PeerStore
+	files                repo.FileStore
+	threads              repo.ThreadStore
+	threadPeers          repo.ThreadPeerStore
+	blocks               repo.BlockStore
+	blockMessages        repo.BlockMessageStore
+	invites              repo.InviteStore
+	notifications        repo.NotificationStore
+	cafeSessions         repo.CafeSessionStore
+	cafeRequests         repo.CafeRequestStore
+	cafeMessages         repo.CafeMessageStore
+	cafeClientNonces     repo.CafeClientNonceStore
+	cafeClients          repo.CafeClientStore
+	cafeTokens           repo.CafeTokenStore
+	cafeClientThreads    repo.CafeClientThreadStore
+	cafeClientMessages   repo.CafeClientMessageStore
+	cafeClientObjects    repo.CafeClientObjectStore
+	cafeReplicas         repo.CafeReplicaStore
+	cafeBans             repo.CafeBanStore
+	botsStore            repo.Botstore
+	contactVerifications repo.ContactVerificationStore
+	blockedAccounts      repo.BlockedAccountStore
+	threadReads          repo.ThreadReadStore
+	peerHealth           repo.PeerHealthStore
+	db                   *sql.DB
+	lock                 *sync.Mutex
 }
 
 func Create(repoPath, pin string) (*SQLiteDatastore, error) {
@@ -54,33 +61,40 @@ func Create(repoPath, pin string) (*SQLiteDatastore, error) {
 	conn.SetMaxIdleConns(2)
 	conn.SetMaxOpenConns(4)
 	if pin != "" {
-		p := "pragma key='" + strings.Replace(pin, "'", "''", -1) + "';"
+		p := "pragma key='" + escapePin(pin) + "';"
 		if _, err := conn.Exec(p); err != nil {
 			return nil, err
 		}
 	}
 	lock := new(sync.Mutex)
 	return &SQLiteDatastore{
-		config:             NewConfigStore(conn, lock, dbPath),
-		peers:              NewPeerStore(conn, lock),
-		files:              NewFileStore(conn, lock),
-		threads:            NewThreadStore(conn, lock),
-		threadPeers:        NewThreadPeerStore(conn, lock),
-		blocks:             NewBlockStore(conn, lock),
-		blockMessages:      NewBlockMessageStore(conn, lock),
-		invites:            NewInviteStore(conn, lock),
-		notifications:      NewNotificationStore(conn, lock),
-		cafeSessions:       NewCafeSessionStore(conn, lock),
-		cafeRequests:       NewCafeRequestStore(conn, lock),
-		cafeMessages:       NewCafeMessageStore(conn, lock),
-		cafeClientNonces:   NewCafeClientNonceStore(conn, lock),
-		cafeClients:        NewCafeClientStore(conn, lock),
-		cafeTokens:         NewCafeTokenStore(conn, lock),
-		cafeClientThreads:  NewCafeClientThreadStore(conn, lock),
-		cafeClientMessages: NewCafeClientMessageStore(conn, lock),
-		botsStore:          NewBotstore(conn, lock),
-		db:                 conn,
-		lock:               lock,
+		config:               NewConfigStore(conn, lock, dbPath),
+		peers:                NewPeerStore(conn, lock),
+		files:                NewFileStore(conn, lock),
+		threads:              NewThreadStore(conn, lock),
+		threadPeers:          NewThreadPeerStore(conn, lock),
+		blocks:               NewBlockStore(conn, lock),
+		blockMessages:        NewBlockMessageStore(conn, lock),
+		invites:              NewInviteStore(conn, lock),
+		notifications:        NewNotificationStore(conn, lock),
+		cafeSessions:         NewCafeSessionStore(conn, lock),
+		cafeRequests:         NewCafeRequestStore(conn, lock),
+		cafeMessages:         NewCafeMessageStore(conn, lock),
+		cafeClientNonces:     NewCafeClientNonceStore(conn, lock),
+		cafeClients:          NewCafeClientStore(conn, lock),
+		cafeTokens:           NewCafeTokenStore(conn, lock),
+		cafeClientThreads:    NewCafeClientThreadStore(conn, lock),
+		cafeClientMessages:   NewCafeClientMessageStore(conn, lock),
+		cafeClientObjects:    NewCafeClientObjectStore(conn, lock),
+		cafeReplicas:         NewCafeReplicaStore(conn, lock),
+		cafeBans:             NewCafeBanStore(conn, lock),
+		botsStore:            NewBotstore(conn, lock),
+		contactVerifications: NewContactVerificationStore(conn, lock),
+		blockedAccounts:      NewBlockedAccountStore(conn, lock),
+		threadReads:          NewThreadReadStore(conn, lock),
+		peerHealth:           NewPeerHealthStore(conn, lock),
+		db:                   conn,
+		lock:                 lock,
 	}, nil
 }
 
@@ -160,10 +174,38 @@ func (d *SQLiteDatastore) CafeClientMessages() repo.CafeClientMessageStore {
 	return d.cafeClientMessages
 }
 
+func (d *SQLiteDatastore) CafeClientObjects() repo.CafeClientObjectStore {
+	return d.cafeClientObjects
+}
+
+func (d *SQLiteDatastore) CafeReplicas() repo.CafeReplicaStore {
+	return d.cafeReplicas
+}
+
+func (d *SQLiteDatastore) CafeBans() repo.CafeBanStore {
+	return d.cafeBans
+}
+
 func (d *SQLiteDatastore) Bots() repo.Botstore {
 	return d.botsStore
 }
 
+func (d *SQLiteDatastore) ContactVerifications() repo.ContactVerificationStore {
+	return d.contactVerifications
+}
+
+func (d *SQLiteDatastore) BlockedAccounts() repo.BlockedAccountStore {
+	return d.blockedAccounts
+}
+
+func (d *SQLiteDatastore) ThreadReads() repo.ThreadReadStore {
+	return d.threadReads
+}
+
+func (d *SQLiteDatastore) PeerHealth() repo.PeerHealthStore {
+	return d.peerHealth
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, pin string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -216,7 +258,7 @@ func initDatabaseTables(db *sql.DB, pin string) error {
 	sqlStmt += `
     create table config (key text primary key not null, value blob);
 
-    create table peers (id text primary key not null, address text not null, username text not null, avatar text not null, inboxes blob not null, created integer not null, updated integer not null);
+    create table peers (id text primary key not null, address text not null, username text not null, avatar text not null, inboxes blob not null, created integer not null, updated integer not null, profile text not null default '');
     create index peer_address on peers (address);
     create index peer_username on peers (username);
     create index peer_updated on peers (updated);
@@ -241,8 +283,9 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index block_data on blocks (data);
     create index block_status on blocks (status);
 
-    create table block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
+    create table block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null, attempts integer not null default 0, lastError text not null default '', failed integer not null default 0);
     create index block_message_date on block_messages (date);
+    create index block_message_peerId on block_messages (peerId);
 
     create table invites (id text primary key not null, block blob not null, name text not null, inviter blob not null, date integer not null, parents text not null);
     create index invite_date on invites (date);
@@ -256,21 +299,22 @@ func initDatabaseTables(db *sql.DB, pin string) error {
 
     create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
 
-    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
+    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null, lastError text not null default '');
     create index cafe_request_cafeId on cafe_requests (cafeId);
     create index cafe_request_groupId on cafe_requests (groupId);
     create index cafe_request_syncGroupId on cafe_requests (syncGroupId);
     create index cafe_request_date on cafe_requests (date);
     create index cafe_request_status on cafe_requests (status);
 
-    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null, lastError text not null default '', failed integer not null default 0);
     create index cafe_message_date on cafe_messages (date);
 
     create table cafe_client_nonces (value text primary key not null, address text not null, date integer not null);
 
-    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, expired integer not null default 0, stored integer not null default 0, storageQuota integer not null default 0, threadQuota integer not null default 0);
     create index cafe_client_address on cafe_clients (address);
     create index cafe_client_lastSeen on cafe_clients (lastSeen);
+    create index cafe_client_tokenId on cafe_clients (tokenId);
 
     create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, primary key (id, clientId));
     create index cafe_client_thread_clientId on cafe_client_threads (clientId);
@@ -279,9 +323,28 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index cafe_client_message_clientId on cafe_client_messages (clientId);
     create index cafe_client_message_date on cafe_client_messages (date);
 
-		create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    create table cafe_client_objects (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+    create index cafe_client_object_clientId on cafe_client_objects (clientId);
+
+    create table cafe_replicas (id text primary key not null, neighbor text not null, body blob not null, date integer not null);
+    create index cafe_replica_neighbor on cafe_replicas (neighbor);
+    create index cafe_replica_date on cafe_replicas (date);
+
+    create table cafe_bans (address text primary key not null, reason text not null, date integer not null);
+
+		create table cafe_tokens (id text primary key not null, token text not null, date integer not null, label text not null default '', expiry integer not null default 0, maxClients integer not null default 0, storageQuota integer not null default 0, threadQuota integer not null default 0);
 		
 		create table bots_store (id text primary key not null, value blob, created integer not null, updated integer not null);
+
+    create table contact_verifications (address text primary key not null, peers text not null, date integer not null);
+
+    create table blocked_accounts (address text primary key not null, date integer not null);
+    create index blocked_account_date on blocked_accounts (date);
+
+    create table thread_reads (threadId text not null, peerId text not null, blockId text not null, date integer not null, primary key (threadId, peerId));
+    create index thread_read_threadId on thread_reads (threadId);
+
+    create table peer_health (peerId text primary key not null, failures integer not null, lastError text not null, lastAttempt integer not null, nextAttempt integer not null);
     `
 	if _, err := db.Exec(sqlStmt); err != nil {
 		return err
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type PeerHealthDB struct {
+	modelStore
+}
+
+func NewPeerHealthStore(db *sql.DB, lock *sync.Mutex) repo.PeerHealthStore {
+	return &PeerHealthDB{modelStore{db, lock}}
+}
+
+func (c *PeerHealthDB) AddOrUpdate(health *pb.PeerHealth) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into peer_health(peerId, failures, lastError, lastAttempt, nextAttempt) values(?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		health.Peer,
+		health.Failures,
+		health.LastError,
+		util.ProtoNanos(health.LastAttempt),
+		util.ProtoNanos(health.NextAttempt),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *PeerHealthDB) Get(peer string) *pb.PeerHealth {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from peer_health where peerId=?", peer)
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *PeerHealthDB) List() *pb.PeerHealthList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from peer_health order by nextAttempt asc")
+}
+
+func (c *PeerHealthDB) Delete(peer string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from peer_health where peerId=?", peer)
+	return err
+}
+
+func (c *PeerHealthDB) handleQuery(stm string, args ...interface{}) *pb.PeerHealthList {
+	list := &pb.PeerHealthList{Items: make([]*pb.PeerHealth, 0)}
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var peerId, lastError string
+		var failures int
+		var lastAttemptInt, nextAttemptInt int64
+		if err := rows.Scan(&peerId, &failures, &lastError, &lastAttemptInt, &nextAttemptInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.PeerHealth{
+			Peer:        peerId,
+			Failures:    int32(failures),
+			LastError:   lastError,
+			LastAttempt: util.ProtoTs(lastAttemptInt),
+			NextAttempt: util.ProtoTs(nextAttemptInt),
+		})
+	}
+	return list
+}
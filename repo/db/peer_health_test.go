@@ -0,0 +1,79 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var peerHealthStore repo.PeerHealthStore
+
+func init() {
+	setupPeerHealthDB()
+}
+
+func setupPeerHealthDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	peerHealthStore = NewPeerHealthStore(conn, new(sync.Mutex))
+}
+
+func TestPeerHealthDB_AddOrUpdate(t *testing.T) {
+	next, _ := ptypes.TimestampProto(time.Now().Add(time.Minute))
+	err := peerHealthStore.AddOrUpdate(&pb.PeerHealth{
+		Peer:        "peer",
+		Failures:    1,
+		LastError:   "error",
+		LastAttempt: ptypes.TimestampNow(),
+		NextAttempt: next,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	next, _ = ptypes.TimestampProto(time.Now().Add(time.Minute * 2))
+	err = peerHealthStore.AddOrUpdate(&pb.PeerHealth{
+		Peer:        "peer",
+		Failures:    2,
+		LastError:   "error",
+		LastAttempt: ptypes.TimestampNow(),
+		NextAttempt: next,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPeerHealthDB_Get(t *testing.T) {
+	health := peerHealthStore.Get("peer")
+	if health == nil {
+		t.Error("could not get health")
+		return
+	}
+	if health.Failures != 2 {
+		t.Error("health was not updated")
+	}
+}
+
+func TestPeerHealthDB_List(t *testing.T) {
+	list := peerHealthStore.List()
+	if len(list.Items) != 1 {
+		t.Error("wrong number of health records")
+	}
+}
+
+func TestPeerHealthDB_Delete(t *testing.T) {
+	err := peerHealthStore.Delete("peer")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if peerHealthStore.Get("peer") != nil {
+		t.Error("delete failed")
+	}
+}
@@ -26,7 +26,7 @@ func (c *PeerDB) Add(peer *pb.Peer) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into peers(id, address, username, avatar, inboxes, created, updated) values(?,?,?,?,?,?,?)`
+	stm := `insert into peers(id, address, username, avatar, inboxes, created, updated, profile) values(?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -59,6 +59,7 @@ func (c *PeerDB) Add(peer *pb.Peer) error {
 		inboxes,
 		created,
 		updated,
+		peer.Profile,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -74,7 +75,7 @@ func (c *PeerDB) AddOrUpdate(peer *pb.Peer) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into peers(id, address, username, avatar, inboxes, created, updated) values(?,?,?,?,?,coalesce((select created from peers where id=?),?),?)`
+	stm := `insert or replace into peers(id, address, username, avatar, inboxes, created, updated, profile) values(?,?,?,?,?,coalesce((select created from peers where id=?),?),?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -103,6 +104,7 @@ func (c *PeerDB) AddOrUpdate(peer *pb.Peer) error {
 		peer.Id,
 		created,
 		time.Now().UnixNano(),
+		peer.Profile,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -227,6 +229,13 @@ func (c *PeerDB) UpdateAvatar(id string, avatar string) error {
 	return err
 }
 
+func (c *PeerDB) UpdateProfile(id string, profile string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update peers set profile=?, updated=? where id=?", profile, time.Now().UnixNano(), id)
+	return err
+}
+
 func (c *PeerDB) UpdateInboxes(id string, inboxes []*pb.Cafe) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -260,14 +269,14 @@ func (c *PeerDB) handleQuery(stm string) []*pb.Peer {
 		return list
 	}
 	for rows.Next() {
-		var id, address, name, avatar string
+		var id, address, name, avatar, profile string
 		var inboxes []byte
 		var createdInt, updatedInt int64
-		if err := rows.Scan(&id, &address, &name, &avatar, &inboxes, &createdInt, &updatedInt); err != nil {
+		if err := rows.Scan(&id, &address, &name, &avatar, &inboxes, &createdInt, &updatedInt, &profile); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		row := c.handleRow(id, address, name, avatar, inboxes, createdInt, updatedInt)
+		row := c.handleRow(id, address, name, avatar, inboxes, createdInt, updatedInt, profile)
 		if row != nil {
 			list = append(list, row)
 		}
@@ -275,7 +284,7 @@ func (c *PeerDB) handleQuery(stm string) []*pb.Peer {
 	return list
 }
 
-func (c *PeerDB) handleRow(id string, address string, name string, avatar string, inboxes []byte, createdInt int64, updatedInt int64) *pb.Peer {
+func (c *PeerDB) handleRow(id string, address string, name string, avatar string, inboxes []byte, createdInt int64, updatedInt int64, profile string) *pb.Peer {
 	cafes := make([]*pb.Cafe, 0)
 	if err := json.Unmarshal(inboxes, &cafes); err != nil {
 		log.Errorf("error unmarshaling cafes: %s", err)
@@ -290,6 +299,7 @@ func (c *PeerDB) handleRow(id string, address string, name string, avatar string
 		Inboxes: cafes,
 		Created: util.ProtoTs(createdInt),
 		Updated: util.ProtoTs(updatedInt),
+		Profile: profile,
 	}
 }
 
@@ -231,6 +231,23 @@ func TestPeerDB_UpdateAvatar(t *testing.T) {
 	testPeer = updated
 }
 
+func TestPeerDB_UpdateProfile(t *testing.T) {
+	err := peerStore.UpdateProfile(testPeer.Id, "profile")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	updated := peerStore.Get(testPeer.Id)
+	if updated.Profile != "profile" {
+		t.Error("update profile failed")
+		return
+	}
+	if util.ProtoNanos(updated.Updated) <= util.ProtoNanos(testPeer.Updated) {
+		t.Error("update was not updated")
+	}
+	testPeer = updated
+}
+
 func TestPeerDB_UpdateInboxes(t *testing.T) {
 	testCafe.Peer = "newone"
 	err := peerStore.UpdateInboxes(testPeer.Id, []*pb.Cafe{testCafe})
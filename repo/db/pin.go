@@ -0,0 +1,112 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrInvalidPin indicates the datastore could not be opened with the given pin
+var ErrInvalidPin = fmt.Errorf("invalid pin")
+
+// ChangePin re-encrypts the datastore at repoPath with newPin. An empty oldPin
+// means the datastore is currently unencrypted, an empty newPin removes encryption.
+// The datastore is exported to a temporary database which atomically replaces
+// the original, so a crash leaves either the old or the new datastore in place.
+// The datastore must not be open elsewhere.
+func ChangePin(repoPath string, oldPin string, newPin string) error {
+	dbPath := path.Join(repoPath, "datastore", "mainnet.db")
+	tmpPath := dbPath + ".rekey"
+	_ = os.Remove(tmpPath)
+
+	err := exportDatabase(dbPath, oldPin, tmpPath, newPin)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	// ensure the new datastore is readable before replacing the old one
+	err = checkPin(tmpPath, newPin)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	err = syncFile(tmpPath)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	err = os.Rename(tmpPath, dbPath)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return syncFile(path.Dir(dbPath))
+}
+
+// exportDatabase copies the database at srcPath into a new database at dstPath
+// using sqlcipher_export, keyed w/ dstPin
+func exportDatabase(srcPath string, srcPin string, dstPath string, dstPin string) error {
+	conn, err := sql.Open("sqlite3", srcPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetMaxOpenConns(1) // attach is per connection
+
+	if srcPin != "" {
+		if _, err := conn.Exec("pragma key='" + escapePin(srcPin) + "';"); err != nil {
+			return err
+		}
+	}
+	if _, err := conn.Exec("select count(*) from sqlite_master;"); err != nil {
+		return ErrInvalidPin
+	}
+
+	attach := "attach database '" + escapePin(dstPath) + "' as rekeyed key '" + escapePin(dstPin) + "';"
+	if _, err := conn.Exec(attach); err != nil {
+		return err
+	}
+	if _, err := conn.Exec("select sqlcipher_export('rekeyed');"); err != nil {
+		return err
+	}
+	_, err = conn.Exec("detach database rekeyed;")
+	return err
+}
+
+// checkPin returns ErrInvalidPin if the database at dbPath cannot be read with pin
+func checkPin(dbPath string, pin string) error {
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetMaxOpenConns(1)
+
+	if pin != "" {
+		if _, err := conn.Exec("pragma key='" + escapePin(pin) + "';"); err != nil {
+			return err
+		}
+	}
+	if _, err := conn.Exec("select count(*) from sqlite_master;"); err != nil {
+		return ErrInvalidPin
+	}
+	return nil
+}
+
+// syncFile flushes a file or directory to disk
+func syncFile(pth string) error {
+	f, err := os.Open(pth)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
+
+func escapePin(pin string) string {
+	return strings.Replace(pin, "'", "''", -1)
+}
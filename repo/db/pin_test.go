@@ -0,0 +1,59 @@
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestChangePin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "textile_pin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Create(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Config().Init(""); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+	dbPath := path.Join(dir, "datastore", "mainnet.db")
+
+	// set
+	if err := ChangePin(dir, "", "1234"); err != nil {
+		t.Fatalf("set pin failed: %s", err)
+	}
+	if checkPin(dbPath, "") != ErrInvalidPin {
+		t.Fatal("datastore should be encrypted")
+	}
+
+	// change
+	if err := ChangePin(dir, "wrong", "5678"); err != ErrInvalidPin {
+		t.Fatal("change pin with wrong pin should fail")
+	}
+	if err := ChangePin(dir, "1234", "5'678"); err != nil {
+		t.Fatalf("change pin failed: %s", err)
+	}
+	if err := checkPin(dbPath, "5'678"); err != nil {
+		t.Fatalf("datastore should open with new pin: %s", err)
+	}
+
+	// remove
+	if err := ChangePin(dir, "5'678", ""); err != nil {
+		t.Fatalf("remove pin failed: %s", err)
+	}
+	if err := checkPin(dbPath, ""); err != nil {
+		t.Fatalf("datastore should not be encrypted: %s", err)
+	}
+	if _, err := os.Stat(dbPath + ".rekey"); !os.IsNotExist(err) {
+		t.Fatal("temporary datastore should be removed")
+	}
+}
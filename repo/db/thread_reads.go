@@ -0,0 +1,92 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/b582q9/go-textile-sapien/util"
+)
+
+type ThreadReadDB struct {
+	modelStore
+}
+
+func NewThreadReadStore(db *sql.DB, lock *sync.Mutex) repo.ThreadReadStore {
+	return &ThreadReadDB{modelStore{db, lock}}
+}
+
+func (c *ThreadReadDB) AddOrUpdate(read *pb.ThreadRead) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into thread_reads(threadId, peerId, blockId, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		read.Thread,
+		read.Peer,
+		read.Block,
+		util.ProtoNanos(read.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *ThreadReadDB) Get(thread string, peer string) *pb.ThreadRead {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_reads where threadId=? and peerId=?", thread, peer)
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadReadDB) ListByThread(thread string) *pb.ThreadReadList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from thread_reads where threadId=? order by date desc", thread)
+}
+
+func (c *ThreadReadDB) DeleteByThread(thread string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_reads where threadId=?", thread)
+	return err
+}
+
+func (c *ThreadReadDB) handleQuery(stm string, args ...interface{}) *pb.ThreadReadList {
+	list := &pb.ThreadReadList{Items: make([]*pb.ThreadRead, 0)}
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId, peerId, blockId string
+		var dateInt int64
+		if err := rows.Scan(&threadId, &peerId, &blockId, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.ThreadRead{
+			Thread: threadId,
+			Peer:   peerId,
+			Block:  blockId,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
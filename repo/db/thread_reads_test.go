@@ -0,0 +1,74 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/b582q9/go-textile-sapien/pb"
+	"github.com/b582q9/go-textile-sapien/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var threadReadStore repo.ThreadReadStore
+
+func init() {
+	setupThreadReadDB()
+}
+
+func setupThreadReadDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	threadReadStore = NewThreadReadStore(conn, new(sync.Mutex))
+}
+
+func TestThreadReadDB_AddOrUpdate(t *testing.T) {
+	err := threadReadStore.AddOrUpdate(&pb.ThreadRead{
+		Thread: "thread",
+		Peer:   "peer",
+		Block:  "block1",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = threadReadStore.AddOrUpdate(&pb.ThreadRead{
+		Thread: "thread",
+		Peer:   "peer",
+		Block:  "block2",
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestThreadReadDB_Get(t *testing.T) {
+	read := threadReadStore.Get("thread", "peer")
+	if read == nil {
+		t.Error("could not get read")
+		return
+	}
+	if read.Block != "block2" {
+		t.Error("read was not updated")
+	}
+}
+
+func TestThreadReadDB_ListByThread(t *testing.T) {
+	list := threadReadStore.ListByThread("thread")
+	if len(list.Items) != 1 {
+		t.Error("wrong number of reads")
+	}
+}
+
+func TestThreadReadDB_DeleteByThread(t *testing.T) {
+	err := threadReadStore.DeleteByThread("thread")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if threadReadStore.Get("thread", "peer") != nil {
+		t.Error("delete failed")
+	}
+}
@@ -1,9 +1,12 @@
 package repo
 
 import (
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"strconv"
 
 	m "github.com/b582q9/go-textile-sapien/repo/migrations"
@@ -36,6 +39,17 @@ var migrations = []Migration{
 	m.Minor015{},
 	m.Minor016{},
 	m.Minor017{},
+	m.Minor018{},
+	m.Minor019{},
+	m.Minor020{},
+	m.Minor021{},
+	m.Minor022{},
+	m.Minor023{},
+	m.Minor024{},
+	m.Minor025{},
+	m.Minor026{},
+	m.Minor027{},
+	m.Minor028{},
 }
 
 // Stat returns whether or not there's a major migration ahead of the current repover
@@ -55,7 +69,42 @@ func Stat(repoPath string) error {
 	return nil
 }
 
+// ErrNoMigrationSnapshot indicates there is no pre-migration snapshot to restore
+var ErrNoMigrationSnapshot = fmt.Errorf("no migration snapshot found")
+
+// snapshotDir is where the datastore and repover are copied before migrating
+const snapshotDir = "migration_snapshot"
+
+// MigrationStep describes a pending migration
+type MigrationStep struct {
+	Version int // repover after the step
+	Name    string
+	Major   bool
+}
+
+// PendingMigrations lists the migrations MigrateUp would apply
+func PendingMigrations(repoPath string) ([]MigrationStep, error) {
+	repover, err := version(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) < repover {
+		return nil, ErrRepoCorrupted
+	}
+	var steps []MigrationStep
+	for i, migration := range migrations[repover:] {
+		steps = append(steps, MigrationStep{
+			Version: repover + i + 1,
+			Name:    reflect.TypeOf(migration).Name(),
+			Major:   migration.Major(),
+		})
+	}
+	return steps, nil
+}
+
 // MigrateUp applies minor migrations all the way up to current
+// The datastore and repover are snapshotted first. If a step fails, the snapshot
+// is restored. Otherwise, it's kept around for RollbackMigrations.
 func MigrateUp(repoPath string, pinCode string, testnet bool) error {
 	repover, err := version(repoPath)
 	if err != nil {
@@ -64,12 +113,45 @@ func MigrateUp(repoPath string, pinCode string, testnet bool) error {
 	if len(migrations) < repover {
 		return ErrRepoCorrupted
 	}
+	return migrate(repoPath, pinCode, testnet, repover, migrations[repover:])
+}
+
+// RollbackMigrations restores the datastore and repover from the snapshot
+// taken before the last migration run
+func RollbackMigrations(repoPath string, testnet bool) error {
+	dir := path.Join(repoPath, snapshotDir)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return ErrNoMigrationSnapshot
+	}
+	err := restoreSnapshot(repoPath, testnet)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// migrate runs steps on a repo at repover, restoring a snapshot on failure
+func migrate(repoPath string, pinCode string, testnet bool, repover int, steps []Migration) error {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	err := takeSnapshot(repoPath, testnet)
+	if err != nil {
+		return fmt.Errorf("error taking migration snapshot: %s", err)
+	}
+
 	x := repover
-	for _, migration := range migrations[repover:] {
+	for _, migration := range steps {
 		log.Infof("migrating repo to version %d...", x+1)
 		err := migration.Up(repoPath, pinCode, testnet)
 		if err != nil {
 			log.Errorf("error migrating repo to version %d: %s", x+1, err)
+			if err := restoreSnapshot(repoPath, testnet); err != nil {
+				log.Errorf("error restoring migration snapshot: %s", err)
+			} else {
+				log.Infof("restored repo to version %d", repover)
+			}
 			return err
 		}
 		x++
@@ -77,6 +159,91 @@ func MigrateUp(repoPath string, pinCode string, testnet bool) error {
 	return nil
 }
 
+// takeSnapshot copies the datastore and repover into the snapshot dir,
+// replacing any existing snapshot only once the copy is complete
+func takeSnapshot(repoPath string, testnet bool) error {
+	dir := path.Join(repoPath, snapshotDir)
+	tmp := dir + ".tmp"
+	_ = os.RemoveAll(tmp)
+	err := os.MkdirAll(tmp, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range snapshotFiles(testnet) {
+		err = copyFile(path.Join(repoPath, name), path.Join(tmp, path.Base(name)))
+		if err != nil && !os.IsNotExist(err) {
+			_ = os.RemoveAll(tmp)
+			return err
+		}
+	}
+
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, dir)
+}
+
+// restoreSnapshot copies the snapshot back into the repo
+// Files missing from the snapshot are removed from the repo.
+func restoreSnapshot(repoPath string, testnet bool) error {
+	dir := path.Join(repoPath, snapshotDir)
+	for _, name := range snapshotFiles(testnet) {
+		dst := path.Join(repoPath, name)
+		err := copyFile(path.Join(dir, path.Base(name)), dst+".restore")
+		if os.IsNotExist(err) {
+			err = os.Remove(dst)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+		err = os.Rename(dst+".restore", dst)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotFiles returns the repo-relative paths that make up a snapshot
+// The datastore's journal and wal sidecars are included, since a copy of the db
+// without them may be missing committed pages or restore alongside a stale journal.
+func snapshotFiles(testnet bool) []string {
+	db := "mainnet.db"
+	if testnet {
+		db = "testnet.db"
+	}
+	db = path.Join("datastore", db)
+	return []string{db, db + "-journal", db + "-wal", db + "-shm", "repover"}
+}
+
+// copyFile copies src to dst and flushes dst to disk
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 // version returns repo at path's version int
 func version(repoPath string) (int, error) {
 	version, err := ioutil.ReadFile(path.Join(repoPath, "repover"))
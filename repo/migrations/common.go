@@ -1,8 +1,10 @@
 package migrations
 
 import (
+	"database/sql"
 	"fmt"
 	"os"
+	"path"
 	"strings"
 )
 
@@ -34,3 +36,109 @@ func blastRepo(repoPath string) error {
 func conflictError(err error) bool {
 	return strings.Contains(err.Error(), "UNIQUE constraint failed")
 }
+
+// migrateTx opens the datastore and runs the schema changes in fn within a single
+// transaction, writing the new repo version once the transaction has committed
+func migrateTx(repoPath string, pinCode string, testnet bool, repover string, fn func(tx *sql.Tx) error) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// update version
+	f, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write([]byte(repover))
+	return err
+}
+
+// dropColumns rebuilds a table without columns that were added with alter table,
+// which sqlite can't drop. defs are the column definitions exactly as they were added.
+// The table's indexes are recreated, so any on dropped columns must be dropped first.
+func dropColumns(tx *sql.Tx, table string, defs ...string) error {
+	var stmt string
+	err := tx.QueryRow("select sql from sqlite_master where type='table' and name=?", table).Scan(&stmt)
+	if err != nil {
+		return err
+	}
+	dropped := make(map[string]struct{})
+	for _, def := range defs {
+		if !strings.Contains(stmt, ", "+def) {
+			return fmt.Errorf("column %s not found in table %s", def, table)
+		}
+		stmt = strings.Replace(stmt, ", "+def, "", 1)
+		dropped[strings.Fields(def)[0]] = struct{}{}
+	}
+
+	rows, err := tx.Query("pragma table_info(" + table + ")")
+	if err != nil {
+		return err
+	}
+	var cols []string
+	for rows.Next() {
+		var cid, notnull, pk int
+		var name, ctype string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &name, &ctype, &notnull, &dflt, &pk); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if _, ok := dropped[name]; !ok {
+			cols = append(cols, name)
+		}
+	}
+	_ = rows.Close()
+
+	rows, err = tx.Query("select sql from sqlite_master where type='index' and tbl_name=? and sql is not null", table)
+	if err != nil {
+		return err
+	}
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		indexes = append(indexes, index)
+	}
+	_ = rows.Close()
+
+	list := strings.Join(cols, ", ")
+	query := "alter table " + table + " rename to " + table + "_old;" +
+		stmt + ";" +
+		"insert into " + table + " (" + list + ") select " + list + " from " + table + "_old;" +
+		"drop table " + table + "_old;"
+	for _, index := range indexes {
+		query += index + ";"
+	}
+	_, err = tx.Exec(query)
+	return err
+}
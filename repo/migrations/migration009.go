@@ -2,8 +2,6 @@ package migrations
 
 import (
 	"database/sql"
-	"os"
-	"path"
 
 	_ "github.com/mutecomm/go-sqlcipher"
 )
@@ -11,40 +9,16 @@ import (
 type Minor009 struct{}
 
 func (Minor009) Up(repoPath string, pinCode string, testnet bool) error {
-	var dbPath string
-	if testnet {
-		dbPath = path.Join(repoPath, "datastore", "testnet.db")
-	} else {
-		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
-	}
-	db, err := sql.Open("sqlite3", dbPath)
-	if err != nil {
-		return err
-	}
-	if pinCode != "" {
-		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+	return migrateTx(repoPath, pinCode, testnet, "10", func(tx *sql.Tx) error {
+		query := `
+        create table cafe_tokens (id text primary key not null, token blob not null, date integer not null);
+        alter table cafe_clients add column tokenId text not null default '';
+        `
+		if _, err := tx.Exec(query); err != nil {
 			return err
 		}
-	}
-
-	query := `
-    create table cafe_tokens (id text primary key not null, token blob not null, date integer not null);
-    alter table cafe_clients add column tokenId text not null default '';
-    `
-	if _, err := db.Exec(query); err != nil {
-		return err
-	}
-
-	// update version
-	f10, err := os.Create(path.Join(repoPath, "repover"))
-	if err != nil {
-		return err
-	}
-	defer f10.Close()
-	if _, err = f10.Write([]byte("10")); err != nil {
-		return err
-	}
-	return nil
+		return nil
+	})
 }
 
 // Down is for a migration downgrade (not implemented)
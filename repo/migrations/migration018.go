@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor018 struct{}
+
+func (Minor018) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "19", func(tx *sql.Tx) error {
+		query := `
+			create table contact_verifications (address text primary key not null, peers text not null, date integer not null);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor018) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "18", func(tx *sql.Tx) error {
+		query := `
+			drop table contact_verifications;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor018) Major() bool {
+	return false
+}
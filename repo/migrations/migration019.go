@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor019 struct{}
+
+func (Minor019) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "20", func(tx *sql.Tx) error {
+		query := `
+			create table blocked_accounts (address text primary key not null, date integer not null);
+			create index blocked_account_date on blocked_accounts (date);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor019) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "19", func(tx *sql.Tx) error {
+		query := `
+			drop index blocked_account_date;
+			drop table blocked_accounts;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor019) Major() bool {
+	return false
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor020 struct{}
+
+func (Minor020) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "21", func(tx *sql.Tx) error {
+		query := `
+			create table thread_reads (threadId text not null, peerId text not null, blockId text not null, date integer not null, primary key (threadId, peerId));
+			create index thread_read_threadId on thread_reads (threadId);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor020) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "20", func(tx *sql.Tx) error {
+		query := `
+			drop index thread_read_threadId;
+			drop table thread_reads;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor020) Major() bool {
+	return false
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor021 struct{}
+
+func (Minor021) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "22", func(tx *sql.Tx) error {
+		query := `
+			alter table block_messages add column attempts integer not null default 0;
+			alter table block_messages add column lastError text not null default '';
+			alter table block_messages add column failed integer not null default 0;
+			create index block_message_peerId on block_messages (peerId);
+			alter table cafe_requests add column lastError text not null default '';
+			alter table cafe_messages add column lastError text not null default '';
+			alter table cafe_messages add column failed integer not null default 0;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor021) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "21", func(tx *sql.Tx) error {
+		query := `
+			drop index block_message_peerId;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "block_messages",
+			"attempts integer not null default 0",
+			"lastError text not null default ''",
+			"failed integer not null default 0",
+		); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "cafe_requests", "lastError text not null default ''"); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "cafe_messages",
+			"lastError text not null default ''",
+			"failed integer not null default 0",
+		); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor021) Major() bool {
+	return false
+}
@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func initAt020(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
+    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
+    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+    insert into block_messages(id, peerId, envelope, date) values('msg', 'peer', x'00', 0);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test021(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt020(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor021
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test existing rows get defaults
+	var attempts, failed int
+	var lastError string
+	row := db.QueryRow("select attempts, lastError, failed from block_messages where id='msg';")
+	if err := row.Scan(&attempts, &lastError, &failed); err != nil {
+		t.Error(err)
+		return
+	}
+	if attempts != 0 || lastError != "" || failed != 0 {
+		t.Error("existing block messages should not be failed")
+		return
+	}
+
+	// test new columns
+	_, err = db.Exec("insert into cafe_messages(id, peerId, date, attempts, lastError, failed) values(?,?,?,?,?,?)", "id", "peer", time.Now().UnixNano(), 5, "error", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("update cafe_requests set lastError=? where id=?", "error", "id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "22" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
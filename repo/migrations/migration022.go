@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor022 struct{}
+
+func (Minor022) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "23", func(tx *sql.Tx) error {
+		query := `
+			create table peer_health (peerId text primary key not null, failures integer not null, lastError text not null, lastAttempt integer not null, nextAttempt integer not null);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor022) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "22", func(tx *sql.Tx) error {
+		query := `
+			drop table peer_health;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor022) Major() bool {
+	return false
+}
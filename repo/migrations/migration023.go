@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor023 struct{}
+
+func (Minor023) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "24", func(tx *sql.Tx) error {
+		query := `
+			alter table peers add column profile text not null default '';
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor023) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "23", func(tx *sql.Tx) error {
+		if err := dropColumns(tx, "peers", "profile text not null default ''"); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor023) Major() bool {
+	return false
+}
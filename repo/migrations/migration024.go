@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor024 struct{}
+
+func (Minor024) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "25", func(tx *sql.Tx) error {
+		query := `
+			alter table cafe_clients add column expired integer not null default 0;
+			create table cafe_bans (address text primary key not null, reason text not null, date integer not null);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor024) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "24", func(tx *sql.Tx) error {
+		query := `
+			drop table cafe_bans;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "cafe_clients", "expired integer not null default 0"); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor024) Major() bool {
+	return false
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor025 struct{}
+
+func (Minor025) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "26", func(tx *sql.Tx) error {
+		query := `
+			alter table cafe_tokens add column label text not null default '';
+			alter table cafe_tokens add column expiry integer not null default 0;
+			alter table cafe_tokens add column maxClients integer not null default 0;
+			alter table cafe_tokens add column storageQuota integer not null default 0;
+			alter table cafe_tokens add column threadQuota integer not null default 0;
+			alter table cafe_clients add column stored integer not null default 0;
+			create index cafe_client_tokenId on cafe_clients (tokenId);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor025) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "25", func(tx *sql.Tx) error {
+		query := `
+			drop index cafe_client_tokenId;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "cafe_tokens",
+			"label text not null default ''",
+			"expiry integer not null default 0",
+			"maxClients integer not null default 0",
+			"storageQuota integer not null default 0",
+			"threadQuota integer not null default 0",
+		); err != nil {
+			return err
+		}
+		if err := dropColumns(tx, "cafe_clients", "stored integer not null default 0"); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor025) Major() bool {
+	return false
+}
@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt024(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, expired integer not null default 0);
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    insert into cafe_clients(id, address, created, lastSeen, tokenId) values('client', 'address', 0, 0, 'token');
+    insert into cafe_tokens(id, token, date) values('token', 'hash', 0);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test025(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt024(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor025
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test existing rows get defaults
+	var label string
+	var expiry, maxClients, storageQuota, threadQuota int64
+	row := db.QueryRow("select label, expiry, maxClients, storageQuota, threadQuota from cafe_tokens where id='token';")
+	if err := row.Scan(&label, &expiry, &maxClients, &storageQuota, &threadQuota); err != nil {
+		t.Error(err)
+		return
+	}
+	if label != "" || expiry != 0 || maxClients != 0 || storageQuota != 0 || threadQuota != 0 {
+		t.Error("existing tokens should be unlimited")
+		return
+	}
+	var stored int64
+	row = db.QueryRow("select stored from cafe_clients where id='client';")
+	if err := row.Scan(&stored); err != nil {
+		t.Error(err)
+		return
+	}
+	if stored != 0 {
+		t.Error("existing clients should have nothing stored")
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "26" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor026 struct{}
+
+func (Minor026) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "27", func(tx *sql.Tx) error {
+		query := `
+			alter table cafe_clients add column storageQuota integer not null default 0;
+			alter table cafe_clients add column threadQuota integer not null default 0;
+			update cafe_clients set
+				storageQuota=(select storageQuota from cafe_tokens where cafe_tokens.id=cafe_clients.tokenId),
+				threadQuota=(select threadQuota from cafe_tokens where cafe_tokens.id=cafe_clients.tokenId)
+				where tokenId in (select id from cafe_tokens);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor026) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "26", func(tx *sql.Tx) error {
+		if err := dropColumns(tx, "cafe_clients",
+			"storageQuota integer not null default 0",
+			"threadQuota integer not null default 0",
+		); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor026) Major() bool {
+	return false
+}
@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt025(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, expired integer not null default 0, stored integer not null default 0);
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null, label text not null default '', expiry integer not null default 0, maxClients integer not null default 0, storageQuota integer not null default 0, threadQuota integer not null default 0);
+    insert into cafe_clients(id, address, created, lastSeen, tokenId) values('client', 'address', 0, 0, 'token');
+    insert into cafe_clients(id, address, created, lastSeen, tokenId) values('orphan', 'address', 0, 0, 'revoked');
+    insert into cafe_tokens(id, token, date, storageQuota, threadQuota) values('token', 'hash', 0, 1024, 2);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test026(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt025(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor026
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test clients take the quotas of their token
+	var storageQuota, threadQuota int64
+	row := db.QueryRow("select storageQuota, threadQuota from cafe_clients where id='client';")
+	if err := row.Scan(&storageQuota, &threadQuota); err != nil {
+		t.Error(err)
+		return
+	}
+	if storageQuota != 1024 || threadQuota != 2 {
+		t.Error("existing clients should take the quotas of their token")
+		return
+	}
+	row = db.QueryRow("select storageQuota, threadQuota from cafe_clients where id='orphan';")
+	if err := row.Scan(&storageQuota, &threadQuota); err != nil {
+		t.Error(err)
+		return
+	}
+	if storageQuota != 0 || threadQuota != 0 {
+		t.Error("clients of removed tokens should be unlimited")
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "27" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
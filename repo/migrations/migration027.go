@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor027 struct{}
+
+func (Minor027) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "28", func(tx *sql.Tx) error {
+		query := `
+			create table cafe_replicas (id text primary key not null, neighbor text not null, body blob not null, date integer not null);
+			create index cafe_replica_neighbor on cafe_replicas (neighbor);
+			create index cafe_replica_date on cafe_replicas (date);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor027) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "27", func(tx *sql.Tx) error {
+		query := `
+			drop index if exists cafe_replica_neighbor;
+			drop index if exists cafe_replica_date;
+			drop table if exists cafe_replicas;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor027) Major() bool {
+	return false
+}
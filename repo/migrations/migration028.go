@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor028 struct{}
+
+func (Minor028) Up(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "29", func(tx *sql.Tx) error {
+		query := `
+			create table cafe_client_objects (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+			create index cafe_client_object_clientId on cafe_client_objects (clientId);
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor028) Down(repoPath string, pinCode string, testnet bool) error {
+	return migrateTx(repoPath, pinCode, testnet, "28", func(tx *sql.Tx) error {
+		query := `
+			drop index if exists cafe_client_object_clientId;
+			drop table if exists cafe_client_objects;
+        `
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (Minor028) Major() bool {
+	return false
+}
@@ -0,0 +1,263 @@
+package repo
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fixtureVersion is the oldest repover with a fixture datastore,
+// earlier migrations require a full IPFS repo
+const fixtureVersion = 7
+
+// reversibleVersion is the oldest repover down migrations restore,
+// the down migrations below it are no-ops
+const reversibleVersion = 18
+
+// fixtureDir holds a datastore dump for each repover since fixtureVersion
+const fixtureDir = "testdata/migrations"
+
+type failingMigration struct{}
+
+func (failingMigration) Up(repoPath string, pinCode string, testnet bool) error {
+	return fmt.Errorf("failed")
+}
+
+func (failingMigration) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (failingMigration) Major() bool {
+	return false
+}
+
+// fixtureRepo creates a repo with the fixture datastore for the given version
+func fixtureRepo(t *testing.T, repover int) string {
+	fixture, err := ioutil.ReadFile(path.Join(fixtureDir, strconv.Itoa(repover)+".sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoPath, err := ioutil.TempDir("", "textile_migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.MkdirAll(path.Join(repoPath, "datastore"), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", path.Join(repoPath, "datastore", "mainnet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(string(fixture))
+	_ = db.Close()
+	if err != nil {
+		t.Fatalf("error loading fixture for version %d: %s", repover, err)
+	}
+	err = ioutil.WriteFile(path.Join(repoPath, "repover"), []byte(strconv.Itoa(repover)), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repoPath
+}
+
+func readDatastore(t *testing.T, repoPath string) []byte {
+	data, err := ioutil.ReadFile(path.Join(repoPath, "datastore", "mainnet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// dumpDatastore returns the schema and rows of a repo's datastore
+func dumpDatastore(t *testing.T, repoPath string) string {
+	db, err := sql.Open("sqlite3", path.Join(repoPath, "datastore", "mainnet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("select type, name, tbl_name, ifnull(sql, '') from sqlite_master order by name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dump []string
+	var tables []string
+	for rows.Next() {
+		var typ, name, tbl, stmt string
+		if err := rows.Scan(&typ, &name, &tbl, &stmt); err != nil {
+			t.Fatal(err)
+		}
+		dump = append(dump, strings.Join([]string{typ, name, tbl, stmt}, "|"))
+		if typ == "table" {
+			tables = append(tables, name)
+		}
+	}
+	_ = rows.Close()
+
+	for _, table := range tables {
+		rows, err := db.Query("select * from " + table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cols, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lines []string
+		for rows.Next() {
+			values := make([]interface{}, len(cols))
+			ptrs := make([]interface{}, len(cols))
+			for i := range values {
+				ptrs[i] = &values[i]
+			}
+			if err := rows.Scan(ptrs...); err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, fmt.Sprintf("%s%q", table, values))
+		}
+		_ = rows.Close()
+		sort.Strings(lines)
+		dump = append(dump, lines...)
+	}
+	return strings.Join(dump, "\n")
+}
+
+// TestMigrateUp_RoundTrips migrates each fixture up, back down with the down migrations,
+// and finally rolls back to the pre-migration snapshot
+func TestMigrateUp_RoundTrips(t *testing.T) {
+	for v := fixtureVersion; v <= len(migrations); v++ {
+		repoPath := fixtureRepo(t, v)
+		before := readDatastore(t, repoPath)
+		dump := dumpDatastore(t, repoPath)
+
+		steps, err := PendingMigrations(repoPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(steps) != len(migrations)-v {
+			t.Fatalf("wrong number of pending migrations at version %d", v)
+		}
+
+		// up
+		if err := MigrateUp(repoPath, "", false); err != nil {
+			t.Fatalf("error migrating up from version %d: %s", v, err)
+		}
+		repover, err := version(repoPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repover != len(migrations) {
+			t.Fatalf("wrong version after migrating up from version %d: %d", v, repover)
+		}
+
+		// down
+		for i := len(migrations) - 1; i >= v; i-- {
+			if err := migrations[i].Down(repoPath, "", false); err != nil {
+				t.Fatalf("error migrating down from version %d: %s", i+1, err)
+			}
+		}
+		repover, err = version(repoPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v >= reversibleVersion {
+			if repover != v {
+				t.Fatalf("wrong version after migrating down to version %d: %d", v, repover)
+			}
+			if dumpDatastore(t, repoPath) != dump {
+				t.Fatalf("datastore was not migrated down to version %d", v)
+			}
+		} else if repover != reversibleVersion {
+			t.Fatalf("wrong version after migrating down to version %d: %d", reversibleVersion, repover)
+		}
+
+		// rollback
+		err = RollbackMigrations(repoPath, false)
+		if v == len(migrations) {
+			if err != ErrNoMigrationSnapshot {
+				t.Fatal("up to date repo should not have a snapshot")
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("error rolling back to version %d: %s", v, err)
+			}
+			repover, err = version(repoPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if repover != v {
+				t.Fatalf("wrong version after rollback: %d", repover)
+			}
+			if !bytes.Equal(before, readDatastore(t, repoPath)) {
+				t.Fatalf("datastore was not restored to version %d", v)
+			}
+		}
+
+		_ = os.RemoveAll(repoPath)
+	}
+}
+
+func TestMigrateUp_RestoresOnFailure(t *testing.T) {
+	repoPath := fixtureRepo(t, fixtureVersion)
+	defer os.RemoveAll(repoPath)
+	before := readDatastore(t, repoPath)
+
+	steps := []Migration{migrations[fixtureVersion], failingMigration{}}
+	if err := migrate(repoPath, "", false, fixtureVersion, steps); err == nil {
+		t.Fatal("migration should fail")
+	}
+
+	repover, err := version(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repover != fixtureVersion {
+		t.Fatalf("failed migration should restore version: %d", repover)
+	}
+	if !bytes.Equal(before, readDatastore(t, repoPath)) {
+		t.Fatal("failed migration should restore the datastore")
+	}
+}
+
+func TestRestoreSnapshot_Sidecars(t *testing.T) {
+	repoPath := fixtureRepo(t, fixtureVersion)
+	defer os.RemoveAll(repoPath)
+	wal := path.Join(repoPath, "datastore", "mainnet.db-wal")
+	shm := path.Join(repoPath, "datastore", "mainnet.db-shm")
+
+	err := ioutil.WriteFile(wal, []byte("before"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := takeSnapshot(repoPath, false); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(wal, []byte("after"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(shm, []byte("after"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := restoreSnapshot(repoPath, false); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(wal)
+	if err != nil || string(data) != "before" {
+		t.Fatal("wal should be restored with the datastore")
+	}
+	if _, err := os.Stat(shm); !os.IsNotExist(err) {
+		t.Fatal("sidecars missing from the snapshot should be removed")
+	}
+}
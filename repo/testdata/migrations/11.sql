@@ -0,0 +1,16 @@
+CREATE TABLE threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null default '', sharing integer not null default 0);
+CREATE TABLE contacts (id text primary key not null, address text not null, username text not null, avatar text not null, inboxes blob not null, created integer not null, updated integer not null);
+CREATE INDEX contact_address on contacts (address);
+CREATE INDEX contact_username on contacts (username);
+CREATE INDEX contact_updated on contacts (updated);
+CREATE TABLE cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null default '');
+CREATE TABLE cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, type integer not null, date integer not null);
+CREATE TABLE cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+CREATE TABLE blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null);
+CREATE TABLE cafe_tokens (id text primary key not null, token blob not null, date integer not null);
+CREATE TABLE block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
+CREATE INDEX block_message_date on block_messages (date);
+CREATE TABLE invites (id text primary key not null, block blob not null, name text not null, inviter blob not null, date integer not null);
+CREATE INDEX invite_date on invites (date);
+insert into threads (id, key, sk, name, schema, initiator, type, state, head, members, sharing) values ('thread', 'key', X'00', 'name', '', 'initiator', 3, 1, '', '', 2);
+insert into blocks (id, threadId, authorId, type, date, parents, target, body) values ('block', 'thread', 'author', 7, 0, '', 'data', '');
@@ -0,0 +1,23 @@
+CREATE TABLE threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null default '', sharing integer not null default 0);
+CREATE TABLE "peers" (id text primary key not null, address text not null, username text not null, avatar text not null, inboxes blob not null, created integer not null, updated integer not null);
+CREATE TABLE cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null default '');
+CREATE TABLE cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+CREATE TABLE blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null default '', status integer not null default 0, attempts integer not null default 0);
+CREATE TABLE cafe_tokens (id text primary key not null, token blob not null, date integer not null);
+CREATE TABLE block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
+CREATE INDEX block_message_date on block_messages (date);
+CREATE TABLE invites (id text primary key not null, block blob not null, name text not null, inviter blob not null, date integer not null, parents text not null default '');
+CREATE INDEX invite_date on invites (date);
+CREATE INDEX peer_address on peers (address);
+CREATE INDEX peer_username on peers (username);
+CREATE INDEX peer_updated on peers (updated);
+CREATE TABLE cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null default 0, groupTransferred integer not null default 0);
+CREATE INDEX cafe_request_cafeId on cafe_requests (cafeId);
+CREATE INDEX cafe_request_groupId on cafe_requests (groupId);
+CREATE INDEX cafe_request_syncGroupId on cafe_requests (syncGroupId);
+CREATE INDEX cafe_request_date on cafe_requests (date);
+CREATE INDEX cafe_request_status on cafe_requests (status);
+CREATE INDEX block_data on blocks (data);
+CREATE INDEX block_status on blocks (status);
+insert into threads (id, key, sk, name, schema, initiator, type, state, head, members, sharing) values ('thread', 'key', X'00', 'name', '', 'initiator', 3, 1, '', '', 2);
+insert into blocks (id, threadId, authorId, type, date, parents, target, body, data, status, attempts) values ('block', 'thread', 'author', 7, 0, '', '', '', 'data', 0, 0);
@@ -0,0 +1,60 @@
+CREATE TABLE config (key text primary key not null, value blob);
+CREATE TABLE peers (id text primary key not null, address text not null, username text not null, avatar text not null, inboxes blob not null, created integer not null, updated integer not null);
+CREATE INDEX peer_address on peers (address);
+CREATE INDEX peer_username on peers (username);
+CREATE INDEX peer_updated on peers (updated);
+CREATE TABLE files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, primary key (mill, checksum));
+CREATE INDEX file_hash on files (hash);
+CREATE UNIQUE INDEX file_mill_source_opts on files (mill, source, opts);
+CREATE TABLE threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null, sharing integer not null);
+CREATE UNIQUE INDEX thread_key on threads (key);
+CREATE TABLE thread_peers (id text not null, threadId text not null, welcomed integer not null, primary key (id, threadId));
+CREATE INDEX thread_peer_id on thread_peers (id);
+CREATE INDEX thread_peer_threadId on thread_peers (threadId);
+CREATE INDEX thread_peer_welcomed on thread_peers (welcomed);
+CREATE TABLE blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null);
+CREATE INDEX block_threadId on blocks (threadId);
+CREATE INDEX block_type on blocks (type);
+CREATE INDEX block_date on blocks (date);
+CREATE INDEX block_target on blocks (target);
+CREATE INDEX block_data on blocks (data);
+CREATE INDEX block_status on blocks (status);
+CREATE TABLE block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
+CREATE INDEX block_message_date on block_messages (date);
+CREATE TABLE invites (id text primary key not null, block blob not null, name text not null, inviter blob not null, date integer not null, parents text not null);
+CREATE INDEX invite_date on invites (date);
+CREATE TABLE notifications (id text primary key not null, date integer not null, actorId text not null, subject text not null, subjectId text not null, blockId text, target text, type integer not null, body text not null, read integer not null);
+CREATE INDEX notification_date on notifications (date);
+CREATE INDEX notification_actorId on notifications (actorId);
+CREATE INDEX notification_subjectId on notifications (subjectId);
+CREATE INDEX notification_blockId on notifications (blockId);
+CREATE INDEX notification_read on notifications (read);
+CREATE TABLE cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
+CREATE TABLE cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
+CREATE INDEX cafe_request_cafeId on cafe_requests (cafeId);
+CREATE INDEX cafe_request_groupId on cafe_requests (groupId);
+CREATE INDEX cafe_request_syncGroupId on cafe_requests (syncGroupId);
+CREATE INDEX cafe_request_date on cafe_requests (date);
+CREATE INDEX cafe_request_status on cafe_requests (status);
+CREATE TABLE cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+CREATE INDEX cafe_message_date on cafe_messages (date);
+CREATE TABLE cafe_client_nonces (value text primary key not null, address text not null, date integer not null);
+CREATE TABLE cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+CREATE INDEX cafe_client_address on cafe_clients (address);
+CREATE INDEX cafe_client_lastSeen on cafe_clients (lastSeen);
+CREATE TABLE cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, primary key (id, clientId));
+CREATE INDEX cafe_client_thread_clientId on cafe_client_threads (clientId);
+CREATE TABLE cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, primary key (id, clientId));
+CREATE INDEX cafe_client_message_clientId on cafe_client_messages (clientId);
+CREATE INDEX cafe_client_message_date on cafe_client_messages (date);
+CREATE TABLE cafe_tokens (id text primary key not null, token text not null, date integer not null);
+CREATE TABLE bots_store (id text primary key not null, value blob, created integer not null, updated integer not null);
+insert into peers (id, address, username, avatar, inboxes, created, updated) values ('peer', 'address', 'username', 'avatar', X'', 1, 2);
+insert into threads (id, key, sk, name, schema, initiator, type, state, head, members, sharing) values ('thread', 'key', X'00', 'name', '', 'address', 3, 1, 'block', '', 2);
+insert into thread_peers (id, threadId, welcomed) values ('peer', 'thread', 1);
+insert into blocks (id, threadId, authorId, type, date, parents, target, body, data, status, attempts) values ('block', 'thread', 'peer', 7, 3, '', '', 'body', '', 0, 0);
+insert into block_messages (id, peerId, envelope, date) values ('message', 'peer', X'00', 4);
+insert into cafe_requests (id, peerId, targetId, cafeId, cafe, groupId, syncGroupId, type, date, size, status, attempts, groupSize, groupTransferred) values ('request', 'peer', 'target', 'cafe', X'00', 'group', 'sync', 0, 5, 1, 0, 0, 1, 0);
+insert into cafe_messages (id, peerId, date, attempts) values ('message', 'peer', 6, 0);
+insert into cafe_clients (id, address, created, lastSeen, tokenId) values ('client', 'address', 8, 9, 'token');
+insert into cafe_tokens (id, token, date) values ('token', 'hash', 7);
@@ -0,0 +1,199 @@
+package s3ds
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// DefaultCacheSize is the number of values kept locally when no size is given
+const DefaultCacheSize = 65536
+
+// Cache writes values through to a remote datastore and keeps the most recently
+// used ones in a local datastore. The remote is authoritative, i.e., queries list it.
+type Cache struct {
+	remote ds.Datastore
+	local  ds.Datastore
+	recent *lru.Cache
+	warmed chan struct{}
+}
+
+var _ ds.Batching = (*Cache)(nil)
+
+// NewCache returns a cache keeping up to size values in local. Values already in local
+// are uploaded to remote if missing there, so an existing local store can be moved
+// behind a remote one.
+func NewCache(remote ds.Datastore, local ds.Datastore, size int) (*Cache, error) {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	c := &Cache{
+		remote: remote,
+		local:  local,
+		warmed: make(chan struct{}),
+	}
+	var err error
+	c.recent, err = lru.NewWithEvict(size, c.evict)
+	if err != nil {
+		return nil, err
+	}
+
+	go c.warm()
+	return c, nil
+}
+
+// Warmed is closed once values already in local are known to the cache
+func (c *Cache) Warmed() <-chan struct{} {
+	return c.warmed
+}
+
+// Put writes a value to remote, then to local
+func (c *Cache) Put(key ds.Key, value []byte) error {
+	err := c.remote.Put(key, value)
+	if err != nil {
+		return err
+	}
+	c.store(key, value)
+	return nil
+}
+
+// Get returns a value from local, falling back to remote
+func (c *Cache) Get(key ds.Key) ([]byte, error) {
+	value, err := c.local.Get(key)
+	if err == nil {
+		c.recent.Get(key)
+		return value, nil
+	}
+	if err != ds.ErrNotFound {
+		log.Warningf("error reading %s from cache: %s", key, err)
+	}
+
+	value, err = c.remote.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, value)
+	return value, nil
+}
+
+// Has returns whether key is in local or remote
+func (c *Cache) Has(key ds.Key) (bool, error) {
+	if ok, err := c.local.Has(key); err == nil && ok {
+		return true, nil
+	}
+	return c.remote.Has(key)
+}
+
+// GetSize returns the size of a value from local, falling back to remote
+func (c *Cache) GetSize(key ds.Key) (int, error) {
+	if size, err := c.local.GetSize(key); err == nil {
+		return size, nil
+	}
+	return c.remote.GetSize(key)
+}
+
+// Delete removes a value from remote and local
+func (c *Cache) Delete(key ds.Key) error {
+	err := c.remote.Delete(key)
+	if err != nil {
+		return err
+	}
+	if !c.recent.Remove(key) {
+		return c.deleteLocal(key)
+	}
+	return nil
+}
+
+// Query lists remote
+func (c *Cache) Query(q query.Query) (query.Results, error) {
+	return c.remote.Query(q)
+}
+
+// Sync syncs both datastores
+func (c *Cache) Sync(prefix ds.Key) error {
+	err := c.remote.Sync(prefix)
+	if err != nil {
+		return err
+	}
+	return c.local.Sync(prefix)
+}
+
+// Batch returns a batch which applies its operations one by one
+func (c *Cache) Batch() (ds.Batch, error) {
+	return ds.NewBasicBatch(c), nil
+}
+
+// Close closes remote, local is owned by the caller
+func (c *Cache) Close() error {
+	return c.remote.Close()
+}
+
+// store keeps a value in local, failures only cost a later remote read
+func (c *Cache) store(key ds.Key, value []byte) {
+	err := c.local.Put(key, value)
+	if err != nil {
+		log.Warningf("error caching %s: %s", key, err)
+		return
+	}
+	c.recent.Add(key, nil)
+}
+
+// evict removes a value which dropped out of the cache from local
+func (c *Cache) evict(key interface{}, _ interface{}) {
+	err := c.deleteLocal(key.(ds.Key))
+	if err != nil {
+		log.Warningf("error evicting %s from cache: %s", key, err)
+	}
+}
+
+// deleteLocal removes a value from local, missing values are ignored
+func (c *Cache) deleteLocal(key ds.Key) error {
+	err := c.local.Delete(key)
+	if err == ds.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// warm adds values already in local to the cache, uploading those missing from remote
+func (c *Cache) warm() {
+	defer close(c.warmed)
+
+	res, err := c.local.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		log.Errorf("error listing cached values: %s", err)
+		return
+	}
+	defer res.Close()
+
+	var uploaded int
+	for r := range res.Next() {
+		if r.Error != nil {
+			log.Errorf("error listing cached values: %s", r.Error)
+			return
+		}
+		key := ds.RawKey(r.Key)
+		ok, err := c.remote.Has(key)
+		if err != nil {
+			log.Errorf("error checking %s: %s", key, err)
+			continue
+		}
+		if !ok {
+			value, err := c.local.Get(key)
+			if err != nil {
+				log.Errorf("error reading %s: %s", key, err)
+				continue
+			}
+			err = c.remote.Put(key, value)
+			if err != nil {
+				log.Errorf("error uploading %s: %s", key, err)
+				continue
+			}
+			uploaded++
+		}
+		c.recent.Add(key, nil)
+	}
+	if uploaded > 0 {
+		log.Infof("uploaded %d locally stored values", uploaded)
+	}
+}
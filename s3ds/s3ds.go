@@ -0,0 +1,227 @@
+// Package s3ds provides a datastore backed by S3-compatible object storage,
+// and a write-through cache which keeps hot values in a local datastore
+package s3ds
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("tex-s3ds")
+
+// defaultRegion is used when none is configured, most S3-compatible providers ignore it
+const defaultRegion = "us-east-1"
+
+// listPageSize is the number of keys requested per list call
+const listPageSize = 1000
+
+// Config locates a bucket and the credentials to access it
+type Config struct {
+	Bucket    string
+	Prefix    string // key prefix of values in the bucket
+	Region    string
+	Endpoint  string // for non-AWS providers, which are addressed path-style
+	AccessKey string // if empty, credentials are taken from the environment
+	SecretKey string
+}
+
+// S3Datastore stores values as objects in a bucket
+type S3Datastore struct {
+	bucket string
+	root   string
+	s3     *s3.S3
+}
+
+var _ ds.Batching = (*S3Datastore)(nil)
+
+// NewS3Datastore returns a datastore for the configured bucket
+func NewS3Datastore(conf Config) (*S3Datastore, error) {
+	if conf.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	aconf := aws.NewConfig().WithRegion(defaultRegion)
+	if conf.Region != "" {
+		aconf = aconf.WithRegion(conf.Region)
+	}
+	if conf.Endpoint != "" {
+		aconf = aconf.WithEndpoint(conf.Endpoint).WithS3ForcePathStyle(true)
+	}
+	if conf.AccessKey != "" {
+		aconf = aconf.WithCredentials(credentials.NewStaticCredentials(conf.AccessKey, conf.SecretKey, ""))
+	}
+	sess, err := session.NewSession(aconf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Datastore{
+		bucket: conf.Bucket,
+		root:   strings.Trim(conf.Prefix, "/"),
+		s3:     s3.New(sess),
+	}, nil
+}
+
+// Put uploads a value
+func (d *S3Datastore) Put(key ds.Key, value []byte) error {
+	_, err := d.s3.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.s3Key(key)),
+		Body:   bytes.NewReader(value),
+	})
+	return err
+}
+
+// Get downloads a value
+func (d *S3Datastore) Get(key ds.Key) ([]byte, error) {
+	res, err := d.s3.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.s3Key(key)),
+	})
+	if err != nil {
+		return nil, parseError(err)
+	}
+	defer res.Body.Close()
+	return ioutil.ReadAll(res.Body)
+}
+
+// Has returns whether an object exists for key
+func (d *S3Datastore) Has(key ds.Key) (bool, error) {
+	_, err := d.GetSize(key)
+	if err == ds.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetSize returns the size of a value without downloading it
+func (d *S3Datastore) GetSize(key ds.Key) (int, error) {
+	res, err := d.s3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.s3Key(key)),
+	})
+	if err != nil {
+		return -1, parseError(err)
+	}
+	return int(aws.Int64Value(res.ContentLength)), nil
+}
+
+// Delete removes a value, deleting a missing value is not an error
+func (d *S3Datastore) Delete(key ds.Key) error {
+	_, err := d.s3.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.s3Key(key)),
+	})
+	err = parseError(err)
+	if err == ds.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// Query lists objects under the query prefix a page at a time.
+// Values are downloaded one by one unless the query is keys only.
+func (d *S3Datastore) Query(q query.Query) (query.Results, error) {
+	prefix := d.s3Key(ds.NewKey(q.Prefix))
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var page []*s3.Object
+	var token *string
+	done := false
+	iter := query.Iterator{
+		Next: func() (query.Result, bool) {
+			for len(page) == 0 {
+				if done {
+					return query.Result{}, false
+				}
+				res, err := d.s3.ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String(d.bucket),
+					Prefix:            aws.String(prefix),
+					ContinuationToken: token,
+					MaxKeys:           aws.Int64(listPageSize),
+				})
+				if err != nil {
+					done = true
+					return query.Result{Error: err}, true
+				}
+				page = res.Contents
+				token = res.NextContinuationToken
+				done = !aws.BoolValue(res.IsTruncated)
+			}
+
+			obj := page[0]
+			page = page[1:]
+			entry := query.Entry{
+				Key:  d.dsKey(aws.StringValue(obj.Key)).String(),
+				Size: int(aws.Int64Value(obj.Size)),
+			}
+			if !q.KeysOnly {
+				value, err := d.Get(ds.RawKey(entry.Key))
+				if err != nil {
+					return query.Result{Error: err}, true
+				}
+				entry.Value = value
+			}
+			return query.Result{Entry: entry}, true
+		},
+	}
+
+	// the bucket is already listed by prefix
+	naive := q
+	naive.Prefix = ""
+	return query.NaiveQueryApply(naive, query.ResultsFromIterator(q, iter)), nil
+}
+
+// Sync is a no-op, puts are durable once they return
+func (d *S3Datastore) Sync(prefix ds.Key) error {
+	return nil
+}
+
+// Batch returns a batch which applies its operations one by one
+func (d *S3Datastore) Batch() (ds.Batch, error) {
+	return ds.NewBasicBatch(d), nil
+}
+
+// Close is a no-op
+func (d *S3Datastore) Close() error {
+	return nil
+}
+
+// s3Key returns the object key of a datastore key
+func (d *S3Datastore) s3Key(key ds.Key) string {
+	return strings.TrimPrefix(path.Join(d.root, key.String()), "/")
+}
+
+// dsKey returns the datastore key of an object key
+func (d *S3Datastore) dsKey(key string) ds.Key {
+	return ds.NewKey(strings.TrimPrefix(key, d.root))
+}
+
+// parseError maps missing objects to ds.ErrNotFound
+func parseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == http.StatusNotFound {
+		return ds.ErrNotFound
+	}
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return ds.ErrNotFound
+	}
+	return err
+}
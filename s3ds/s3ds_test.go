@@ -0,0 +1,226 @@
+package s3ds
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	dssync "github.com/ipfs/go-datastore/sync"
+)
+
+// fakeS3 is an in-process stand-in for the few S3 calls the datastore makes,
+// addressed path-style and returning at most two keys per list page
+type fakeS3 struct {
+	objects map[string][]byte
+	lock    sync.Mutex
+}
+
+type listResult struct {
+	XMLName               xml.Name     `xml:"ListBucketResult"`
+	IsTruncated           bool         `xml:"IsTruncated"`
+	NextContinuationToken string       `xml:"NextContinuationToken,omitempty"`
+	Contents              []listObject `xml:"Contents"`
+}
+
+type listObject struct {
+	Key  string `xml:"Key"`
+	Size int    `xml:"Size"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	// path is /<bucket>/<key>
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	var key string
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) && k > r.URL.Query().Get("continuation-token") {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		res := listResult{}
+		if len(keys) > 2 {
+			keys = keys[:2]
+			res.IsTruncated = true
+			res.NextContinuationToken = keys[1]
+		}
+		for _, k := range keys {
+			res.Contents = append(res.Contents, listObject{Key: k, Size: len(f.objects[k])})
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		f.objects[key] = body
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		body, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				fmt.Fprint(w, "<Error><Code>NoSuchKey</Code></Error>")
+			}
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(body)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(body)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func setupS3(t *testing.T) (*S3Datastore, *fakeS3, func()) {
+	fake := &fakeS3{objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	d, err := NewS3Datastore(Config{
+		Bucket:    "blocks",
+		Prefix:    "/textile/",
+		Endpoint:  server.URL,
+		AccessKey: "key",
+		SecretKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, fake, server.Close
+}
+
+func TestS3Datastore(t *testing.T) {
+	d, fake, done := setupS3(t)
+	defer done()
+
+	for i := 0; i < 5; i++ {
+		err := d.Put(ds.NewKey(fmt.Sprintf("/blocks/%d", i)), []byte("value"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := fake.objects["textile/blocks/0"]; !ok {
+		t.Fatal("expected object keys to be prefixed")
+	}
+
+	value, err := d.Get(ds.NewKey("/blocks/0"))
+	if err != nil || string(value) != "value" {
+		t.Fatalf("get failed: %v", err)
+	}
+	if _, err := d.Get(ds.NewKey("/blocks/missing")); err != ds.ErrNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if ok, err := d.Has(ds.NewKey("/blocks/1")); err != nil || !ok {
+		t.Fatal("expected key to exist")
+	}
+	if ok, err := d.Has(ds.NewKey("/blocks/missing")); err != nil || ok {
+		t.Fatal("expected key to be missing")
+	}
+	if size, err := d.GetSize(ds.NewKey("/blocks/1")); err != nil || size != 5 {
+		t.Fatalf("expected size 5, got %d", size)
+	}
+
+	// listing spans pages
+	res, err := d.Query(query.Query{Prefix: "/blocks", KeysOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := res.Rest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 || entries[0].Key != "/blocks/0" {
+		t.Fatalf("expected 5 keys under /blocks, got %v", entries)
+	}
+
+	err = d.Delete(ds.NewKey("/blocks/0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := d.Has(ds.NewKey("/blocks/0")); ok {
+		t.Fatal("expected key to be deleted")
+	}
+}
+
+func TestCache(t *testing.T) {
+	remote, _, done := setupS3(t)
+	defer done()
+
+	// a value stored before the cache existed is uploaded
+	local := dssync.MutexWrap(ds.NewMapDatastore())
+	err := local.Put(ds.NewKey("/old"), []byte("old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCache(remote, local, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-c.Warmed()
+	if ok, _ := remote.Has(ds.NewKey("/old")); !ok {
+		t.Fatal("expected existing local value to be uploaded")
+	}
+
+	// only the most recent values stay local
+	for _, k := range []string{"/a", "/b"} {
+		err = c.Put(ds.NewKey(k), []byte(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ok, _ := local.Has(ds.NewKey("/old")); ok {
+		t.Fatal("expected least recently used value to be evicted")
+	}
+	if ok, _ := c.Has(ds.NewKey("/old")); !ok {
+		t.Fatal("expected evicted value to remain in remote")
+	}
+	value, err := c.Get(ds.NewKey("/old"))
+	if err != nil || string(value) != "old" {
+		t.Fatalf("get of evicted value failed: %v", err)
+	}
+	if ok, _ := local.Has(ds.NewKey("/old")); !ok {
+		t.Fatal("expected value read from remote to be cached")
+	}
+
+	// queries list remote
+	res, err := c.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := res.Rest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 keys, got %d", len(entries))
+	}
+
+	// deletes remove both copies
+	err = c.Delete(ds.NewKey("/old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := c.Has(ds.NewKey("/old")); ok {
+		t.Fatal("expected value to be deleted")
+	}
+	if ok, _ := local.Has(ds.NewKey("/old")); ok {
+		t.Fatal("expected cached value to be deleted")
+	}
+}
@@ -19,6 +19,12 @@ var ErrLinkOrderNotSolvable = fmt.Errorf("link order is not solvable")
 // ErrSchemaInvalidMill indicates a schema has an invalid mill entry
 var ErrSchemaInvalidMill = fmt.Errorf("schema contains an invalid mill")
 
+// ErrMillAndPipeline indicates a schema link has both a mill and a pipeline
+var ErrMillAndPipeline = fmt.Errorf("schema link cannot have both a mill and a pipeline")
+
+// ErrInvalidCondition indicates a pipeline step condition cannot be evaluated
+var ErrInvalidCondition = fmt.Errorf("pipeline condition is not valid")
+
 // ErrMissingJsonSchema indicates json schema is missing
 var ErrMissingJsonSchema = fmt.Errorf("json mill requires a json schema")
 
@@ -38,6 +44,7 @@ func ValidateMill(mill string) bool {
 		"/schema",
 		"/blob",
 		"/image/resize",
+		"/image/crop",
 		"/image/exif",
 		"/json":
 		return true
@@ -81,22 +88,46 @@ func Steps(links map[string]*pb.Link) ([]pb.Step, error) {
 func orderLinks(links map[string]*pb.Link, steps *[]pb.Step) map[string]*pb.Link {
 	unused := make(map[string]*pb.Link)
 	for name, link := range links {
-		if link.Use == FileTag {
+		deps := linkDeps(link)
+		if link.Use == FileTag && len(deps) == 0 {
 			*steps = append([]pb.Step{{Name: name, Link: link}}, *steps...)
+		} else if hasSteps(*steps, deps) {
+			*steps = append(*steps, pb.Step{Name: name, Link: link})
 		} else {
-			useAt := -1
-			for i, s := range *steps {
-				if link.Use == s.Name {
-					useAt = i
-					break
-				}
-			}
-			if useAt >= 0 {
-				*steps = append(*steps, pb.Step{Name: name, Link: link})
-			} else {
-				unused[name] = link
-			}
+			unused[name] = link
 		}
 	}
 	return unused
 }
+
+// linkDeps returns the names of links that must be processed before link,
+// its source and any links tested by its pipeline conditions
+func linkDeps(link *pb.Link) []string {
+	var deps []string
+	if link.Use != FileTag {
+		deps = append(deps, link.Use)
+	}
+	for _, s := range link.Pipeline {
+		if s.When != nil && s.When.Link != "" {
+			deps = append(deps, s.When.Link)
+		}
+	}
+	return deps
+}
+
+// hasSteps returns whether or not each name is already in steps
+func hasSteps(steps []pb.Step, names []string) bool {
+	for _, n := range names {
+		var found bool
+		for _, s := range steps {
+			if s.Name == n {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
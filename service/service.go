@@ -15,6 +15,7 @@ import (
 	"github.com/b582q9/go-textile-sapien/crypto"
 	"github.com/b582q9/go-textile-sapien/ipfs"
 	"github.com/b582q9/go-textile-sapien/keypair"
+	"github.com/b582q9/go-textile-sapien/metrics"
 	"github.com/b582q9/go-textile-sapien/pb"
 	"github.com/b582q9/go-textile-sapien/util"
 	ggio "github.com/gogo/protobuf/io"
@@ -305,6 +306,16 @@ func (srv *Service) VerifyEnvelope(env *pb.Envelope, pid peer.ID) error {
 	return crypto.Verify(pk, ser, env.Sig)
 }
 
+// RemoteError is an error response from a peer, as opposed to a failure to reach it
+type RemoteError struct {
+	Code    uint32
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
 // handleError receives an error response
 func (srv *Service) handleError(env *pb.Envelope) error {
 	if env.Message.Payload == nil && env.Message.Type != pb.Message_PONG {
@@ -319,7 +330,7 @@ func (srv *Service) handleError(env *pb.Envelope) error {
 		if err != nil {
 			return err
 		}
-		return fmt.Errorf(errMsg.Message)
+		return &RemoteError{Code: errMsg.Code, Message: errMsg.Message}
 	}
 }
 
@@ -433,6 +444,7 @@ func (srv *Service) handleNewMessage(s inet.Stream) bool {
 
 		log.Debugf("received %s from %s", req.Message.Type.String(), mPeer.Pretty())
 		rpmes, err := handler(&req, mPeer)
+		srv.observe(req.Message.Type, err)
 		if err != nil {
 			log.Warningf("error handling message %s: %s", req.Message.Type.String(), err)
 			return false
@@ -459,6 +471,13 @@ func (srv *Service) handleNewMessage(s inet.Stream) bool {
 	}
 }
 
+// observe counts a handled envelope
+func (srv *Service) observe(mtype pb.Message_Type, err error) {
+	metrics.EnvelopesHandled.
+		WithLabelValues(string(srv.handler.Protocol()), mtype.String(), metrics.Result(err)).
+		Inc()
+}
+
 // listen subscribes to a tag for network-wide requests
 func (srv *Service) listen(tag string) {
 	topic := string(srv.handler.Protocol())
@@ -516,6 +535,7 @@ func (srv *Service) listen(tag string) {
 
 			log.Debugf("received pubsub %s from %s", req.Message.Type.String(), mPeer.Pretty())
 			rpmes, err := handler(req, mPeer)
+			srv.observe(req.Message.Type, err)
 			if err != nil {
 				log.Warningf("error handling message %s: %s", req.Message.Type.String(), err)
 				continue
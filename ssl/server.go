@@ -0,0 +1,200 @@
+package ssl
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// ErrNoCertSource indicates neither acme hosts nor static cert files were configured
+var ErrNoCertSource = fmt.Errorf("tls requires acme hosts or a cert and key file")
+
+// reloadInterval is how often static cert files are checked for changes
+const reloadInterval = time.Minute
+
+// Options configure where a server gets its certificates
+type Options struct {
+	Hosts        []string // hostnames to get acme certificates for
+	Email        string   // acme account contact
+	DirectoryURL string   // acme directory, defaults to Let's Encrypt
+	DirectoryCA  string   // PEM file of a root CA trusted by the acme client, e.g., for a local Pebble
+	CacheDir     string   // where acme accounts and certificates are kept
+	CertFile     string   // static certificate, used instead of acme
+	KeyFile      string   // static certificate key
+}
+
+// Server provides certificates to https servers, either from an acme
+// directory, which are renewed automatically, or from static files,
+// which are reloaded when they change on disk
+type Server struct {
+	manager *autocert.Manager
+	static  *certReloader
+}
+
+// NewServer returns a certificate source for the given options
+func NewServer(opts Options) (*Server, error) {
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		static, err := newCertReloader(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &Server{static: static}, nil
+	}
+	if len(opts.Hosts) == 0 {
+		return nil, ErrNoCertSource
+	}
+
+	client := &acme.Client{DirectoryURL: opts.DirectoryURL}
+	if client.DirectoryURL == "" {
+		client.DirectoryURL = autocert.DefaultACMEDirectory
+	}
+	if opts.DirectoryCA != "" {
+		pem, err := ioutil.ReadFile(opts.DirectoryCA)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.DirectoryCA)
+		}
+		client.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+		}
+	}
+
+	return &Server{
+		manager: &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			Cache:      autocert.DirCache(opts.CacheDir),
+			HostPolicy: autocert.HostWhitelist(opts.Hosts...),
+			Client:     client,
+			Email:      opts.Email,
+		},
+	}, nil
+}
+
+// TLSConfig returns the tls config of an https server.
+// Acme certificates are requested on the first handshake for a host,
+// then renewed in the background ahead of their expiry.
+func (s *Server) TLSConfig() *tls.Config {
+	if s.static != nil {
+		return &tls.Config{
+			GetCertificate: s.static.GetCertificate,
+			NextProtos:     []string{"h2", "http/1.1"},
+			MinVersion:     tls.VersionTLS12,
+		}
+	}
+	conf := s.manager.TLSConfig()
+	conf.MinVersion = tls.VersionTLS12
+	return conf
+}
+
+// HTTPHandler answers acme http-01 challenges and redirects everything else to https
+func (s *Server) HTTPHandler() http.Handler {
+	if s.manager != nil {
+		return s.manager.HTTPHandler(nil)
+	}
+	return http.HandlerFunc(redirectHTTPS)
+}
+
+// redirectHTTPS redirects a request to the default https port of its host
+func redirectHTTPS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "use https", http.StatusBadRequest)
+		return
+	}
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusFound)
+}
+
+// certReloader serves a static certificate, reloading it when its files change
+type certReloader struct {
+	certFile string
+	keyFile  string
+	cert     *tls.Certificate
+	modified time.Time
+	checked  time.Time
+	lock     sync.Mutex
+}
+
+func newCertReloader(certFile string, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, checking for renewed files
+// at most once per reload interval
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if time.Since(r.checked) >= reloadInterval {
+		r.checked = time.Now()
+		if modified, err := r.lastModified(); err == nil && modified.After(r.modified) {
+			if err := r.loadLocked(); err != nil {
+				// keep serving the old certificate until the new one is complete
+				log.Warningf("error reloading certificate %s: %s", r.certFile, err)
+			} else {
+				log.Infof("reloaded certificate %s", r.certFile)
+			}
+		}
+	}
+	return r.cert, nil
+}
+
+func (r *certReloader) load() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.checked = time.Now()
+	return r.loadLocked()
+}
+
+func (r *certReloader) loadLocked() error {
+	modified, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert = &cert
+	r.modified = modified
+	return nil
+}
+
+// lastModified returns the latest modification time of the cert and key files
+func (r *certReloader) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, f := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(f)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,108 @@
+package ssl
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+func TestNewServer_Static(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	if err := Generate(certFile, keyFile, "one.example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := NewServer(Options{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := srv.TLSConfig()
+	if dnsName(t, conf) != "one.example.com" {
+		t.Fatal("wrong certificate")
+	}
+
+	// renewed files are picked up on the next check
+	if err := Generate(certFile, keyFile, "two.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	for _, f := range []string{certFile, keyFile} {
+		if err := os.Chtimes(f, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if dnsName(t, conf) != "one.example.com" {
+		t.Fatal("certificate was reloaded before the reload interval")
+	}
+	srv.static.checked = time.Time{}
+	if dnsName(t, conf) != "two.example.com" {
+		t.Fatal("certificate was not reloaded")
+	}
+
+	// plain http is redirected
+	rec := httptest.NewRecorder()
+	srv.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://one.example.com:8080/a?b=c", nil))
+	if loc := rec.Header().Get("Location"); loc != "https://one.example.com/a?b=c" {
+		t.Errorf("wrong redirect: %s", loc)
+	}
+}
+
+func TestNewServer_ACME(t *testing.T) {
+	if _, err := NewServer(Options{}); err != ErrNoCertSource {
+		t.Fatal("expected missing cert source to fail")
+	}
+
+	srv, err := NewServer(Options{
+		Hosts:        []string{"cafe.example.com"},
+		DirectoryURL: "https://localhost:14000/dir",
+		CacheDir:     t.TempDir(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.manager.Client.DirectoryURL != "https://localhost:14000/dir" {
+		t.Error("directory url was not applied")
+	}
+
+	// tls-alpn-01 challenges are answered on the https port
+	var alpn bool
+	for _, proto := range srv.TLSConfig().NextProtos {
+		if proto == acme.ALPNProto {
+			alpn = true
+		}
+	}
+	if !alpn {
+		t.Error("acme alpn protocol was not enabled")
+	}
+
+	// hosts outside the whitelist are refused
+	_, err = srv.TLSConfig().GetCertificate(&tls.ClientHelloInfo{ServerName: "other.example.com"})
+	if err == nil {
+		t.Error("certificate for an unknown host was requested")
+	}
+}
+
+// dnsName returns the first dns name of the certificate served by conf
+func dnsName(t *testing.T, conf *tls.Config) string {
+	cert, err := conf.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(x.DNSNames) == 0 {
+		return ""
+	}
+	return x.DNSNames[0]
+}
@@ -21,6 +21,9 @@ const (
 	// TextileAccountPathFormat is a path format used for Textile key pair
 	// derivation as described in SEP-00XX. Use with `fmt.Sprintf` and `DeriveForPath`.
 	TextileAccountPathFormat = "m/44'/406'/%d'"
+	// TextileProfilePath is the derivation path of an account's ipns profile key,
+	// relative to the account seed. Use with `DeriveForPath`.
+	TextileProfilePath = "m/406'/1'"
 	// FirstHardenedIndex is the index of the first hardened key (2^31).
 	// https://youtu.be/2HrMlVr1QX8?t=390
 	FirstHardenedIndex = uint32(0x80000000)